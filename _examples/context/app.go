@@ -0,0 +1,60 @@
+// An example of passing request scoped data to custom rules using typed context values.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// TenantKey is used to store the tenant of the current request.
+var TenantKey = rulecontext.Key[string]("tenant")
+
+// belongsToTenant is a custom rule that checks that a resource ID starts with the tenant of the current request.
+func belongsToTenant(ctx context.Context, id string) errors.ValidationErrorCollection {
+	tenant, ok := rulecontext.Value(ctx, TenantKey)
+	if !ok || !strings.HasPrefix(id, tenant+"/") {
+		return errors.Collection(errors.Errorf(errors.CodeForbidden, ctx, "resource belongs to another tenant"))
+	}
+	return nil
+}
+
+var ruleSet rules.RuleSet[string] = rules.String().
+	WithMinLen(3).
+	WithRuleFunc(belongsToTenant)
+
+// checkAll iterates over an array of resource IDs and calls the rule set for each one.
+func checkAll(w io.Writer, tenant string, ids ...string) {
+	if len(ids) == 0 {
+		fmt.Fprintf(w, "Enter 1 or more resource IDs on the command line.\n")
+		return
+	}
+
+	// The tenant would normally come from the authenticated request.
+	ctx := rulecontext.WithValue(context.TODO(), TenantKey, tenant)
+
+	for _, id := range ids {
+		var output string
+
+		err := ruleSet.Apply(ctx, id, &output)
+		if err == nil {
+			fmt.Fprintf(w, "'%s' is valid\n", id)
+		} else {
+			fmt.Fprintf(w, "'%s' is invalid: %s\n", id, err)
+		}
+	}
+}
+
+// Try changing the tenant to see different results.
+func main() {
+	tenant := flag.String("tenant", "acme", "tenant of the current request")
+	flag.Parse()
+	checkAll(os.Stdout, *tenant, flag.Args()...)
+}
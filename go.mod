@@ -3,6 +3,8 @@ module proto.zip/studio/validate
 go 1.22
 
 require (
+	github.com/rivo/uniseg v0.4.7
 	golang.org/x/net v0.15.0
 	golang.org/x/text v0.13.0
+	gopkg.in/yaml.v3 v3.0.1
 )
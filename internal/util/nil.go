@@ -0,0 +1,14 @@
+package util
+
+import (
+	"reflect"
+)
+
+// IsNil returns true if the value is nil or a nil pointer.
+func IsNil(value any) bool {
+	if value == nil {
+		return true
+	}
+	rv := reflect.ValueOf(value)
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
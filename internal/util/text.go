@@ -0,0 +1,31 @@
+package util
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+)
+
+// Text returns the text representation of a value that implements encoding.TextMarshaler or fmt.Stringer.
+// TextMarshaler is preferred since its output is meant to be parsed back into the value.
+//
+// The boolean is false if the value implements neither interface or is a nil pointer. The error is returned from
+// MarshalText.
+func Text(value any) (string, bool, error) {
+	if rv := reflect.ValueOf(value); !rv.IsValid() || (rv.Kind() == reflect.Ptr && rv.IsNil()) {
+		return "", false, nil
+	}
+
+	switch x := value.(type) {
+	case encoding.TextMarshaler:
+		b, err := x.MarshalText()
+		if err != nil {
+			return "", true, err
+		}
+		return string(b), true, nil
+	case fmt.Stringer:
+		return x.String(), true, nil
+	}
+
+	return "", false, nil
+}
@@ -0,0 +1,154 @@
+package bind
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// DefaultMaxBodySize is the largest request body Request will read.
+const DefaultMaxBodySize = 1 << 20
+
+// Request reads the body of the request, decodes it based on the Content-Type header and applies the rule set to it.
+// It returns the output of the rule set along with any validation errors. The context of the request is used for
+// validation.
+//
+// Bodies larger than DefaultMaxBodySize return an errors.CodeMax error. Use RequestWithLimit to change the limit.
+func Request[T any](r *http.Request, ruleSet rules.RuleSet[T]) (T, errors.ValidationErrorCollection) {
+	return RequestWithLimit(r, ruleSet, DefaultMaxBodySize)
+}
+
+// RequestWithLimit behaves like Request but reads at most maxBytes from the body.
+//
+// The following content types are supported:
+//   - application/json and any type ending in +json. The body is decoded the same way as json.Unmarshal into
+//     an any value so object rule sets get a map and slice rule sets get a slice.
+//   - application/x-www-form-urlencoded. The body is decoded into url.Values. Use WithQuerySemantics on the
+//     object rule set to validate single values with scalar rule sets.
+//
+// A missing Content-Type is treated as Json. Any other type returns an errors.CodeType error and a body that can not
+// be decoded returns an errors.CodeEncoding error.
+//
+// An empty body is treated as a missing value. If the rule set is required an errors.CodeRequired error is returned,
+// otherwise the zero value is returned with no errors.
+//
+// This function panics if maxBytes is less than 1.
+func RequestWithLimit[T any](r *http.Request, ruleSet rules.RuleSet[T], maxBytes int64) (T, errors.ValidationErrorCollection) {
+	var output T
+	ctx := r.Context()
+
+	if maxBytes < 1 {
+		panic(fmt.Errorf("max body size must be at least 1, got: %d", maxBytes))
+	}
+
+	mediaType, err := contentType(r)
+	if err != nil {
+		return output, errors.Collection(errors.Errorf(errors.CodeType, ctx, "unsupported content type"))
+	}
+
+	body, errs := readBody(ctx, r, maxBytes)
+	if errs != nil {
+		return output, errs
+	}
+
+	if len(bytes.TrimSpace(body)) == 0 {
+		if ruleSet.Required() {
+			return output, errors.Collection(errors.Errorf(errors.CodeRequired, ctx, "request body is required"))
+		}
+		return output, nil
+	}
+
+	var input any
+
+	switch mediaType {
+	case "application/x-www-form-urlencoded":
+		input, err = url.ParseQuery(string(body))
+	default:
+		input, err = decodeJson(body)
+	}
+
+	if err != nil {
+		return output, errors.Collection(errors.Errorf(errors.CodeEncoding, ctx, "request body could not be decoded"))
+	}
+
+	errs = ruleSet.Apply(ctx, input, &output)
+	return output, errs
+}
+
+// contentType returns the media type of the request body.
+// Json is assumed if there is no Content-Type header and an error is returned for unsupported types.
+func contentType(r *http.Request) (string, error) {
+	header := r.Header.Get("Content-Type")
+	if header == "" {
+		return "application/json", nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(header)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case mediaType == "application/json", strings.HasSuffix(mediaType, "+json"):
+		return "application/json", nil
+	case mediaType == "application/x-www-form-urlencoded":
+		return mediaType, nil
+	}
+	return "", stderrors.New("unsupported content type")
+}
+
+// readBody reads up to maxBytes from the request body.
+func readBody(ctx context.Context, r *http.Request, maxBytes int64) ([]byte, errors.ValidationErrorCollection) {
+	if r.Body == nil {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, maxBytes))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if stderrors.As(err, &tooLarge) {
+			return nil, errors.Collection(errors.Errorf(errors.CodeMax, ctx, "request body must be at most %d bytes", maxBytes))
+		}
+		return nil, errors.Collection(errors.Errorf(errors.CodeInternal, ctx, "request body could not be read"))
+	}
+	return body, nil
+}
+
+// decodeJson decodes a single Json value and returns an error if anything other than whitespace follows it.
+func decodeJson(body []byte) (any, error) {
+	var input any
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	if err := decoder.Decode(&input); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, stderrors.New("unexpected data after Json value")
+	}
+	return input, nil
+}
+
+// Status returns the HTTP status code that best describes the errors returned by Request.
+//
+// It returns http.StatusOK if there are no errors, ignoring warnings, and http.StatusForbidden if any of the errors
+// are permission errors. Otherwise http.StatusUnprocessableEntity is returned.
+func Status(errs errors.ValidationErrorCollection) int {
+	switch {
+	case !errs.HasErrors():
+		return http.StatusOK
+	case errs.PermissionErrors() != nil:
+		return http.StatusForbidden
+	default:
+		return http.StatusUnprocessableEntity
+	}
+}
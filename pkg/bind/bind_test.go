@@ -0,0 +1,158 @@
+package bind_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/bind"
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// signup is the output of the bind tests.
+type signup struct {
+	Email string `validate:"email"`
+	Age   int    `validate:"age"`
+}
+
+// signupRuleSet returns a rule set for signup requests.
+func signupRuleSet() *rules.ObjectRuleSet[signup, string, any] {
+	return rules.Struct[signup]().
+		WithKey("email", rules.String().WithRequired().Any()).
+		WithKey("age", rules.Int().WithMin(18).Any()).
+		WithQuerySemantics()
+}
+
+// newRequest returns a POST request with the body and content type.
+func newRequest(body, contentType string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/signup", strings.NewReader(body))
+	if contentType != "" {
+		r.Header.Set("Content-Type", contentType)
+	}
+	return r
+}
+
+// mustHaveCode fails the test if the errors do not contain the code.
+func mustHaveCode(t *testing.T, errs errors.ValidationErrorCollection, code errors.ErrorCode) {
+	t.Helper()
+
+	if errs == nil {
+		t.Fatalf("Expected errors to not be nil")
+	} else if !errs.ContainsCode(code) {
+		t.Errorf("Expected error code to be %s, got: %s", code, errs)
+	}
+}
+
+// Requirements:
+// - Json bodies are decoded and validated.
+// - A missing Content-Type is treated as Json.
+// - Types ending in +json are treated as Json.
+// - Form bodies are decoded and validated.
+func TestRequest(t *testing.T) {
+	expected := signup{Email: "a@example.com", Age: 21}
+	tests := map[string]*http.Request{
+		"json":    newRequest(`{"email": "a@example.com", "age": 21}`, "application/json; charset=utf-8"),
+		"missing": newRequest(`{"email": "a@example.com", "age": 21}`, ""),
+		"suffix":  newRequest(`{"email": "a@example.com", "age": 21}`, "application/vnd.api+json"),
+		"form":    newRequest("email=a%40example.com&age=21", "application/x-www-form-urlencoded"),
+	}
+
+	for name, r := range tests {
+		t.Run(name, func(t *testing.T) {
+			output, errs := bind.Request(r, signupRuleSet())
+			if errs != nil {
+				t.Fatalf("Expected errors to be nil, got: %s", errs)
+			}
+			if !reflect.DeepEqual(output, expected) {
+				t.Errorf("Expected output to be %v, got: %v", expected, output)
+			}
+		})
+	}
+}
+
+// Requirements:
+// - Validation errors are returned.
+// - Unsupported content types return a type error.
+// - Malformed bodies return an encoding error.
+// - Bodies over the limit return a max error.
+// - Empty bodies return a required error only if the rule set is required.
+func TestRequestErrors(t *testing.T) {
+	_, errs := bind.Request(newRequest(`{"email": "a@example.com", "age": 12}`, "application/json"), signupRuleSet())
+	mustHaveCode(t, errs, errors.CodeMin)
+
+	_, errs = bind.Request(newRequest("<signup/>", "application/xml"), signupRuleSet())
+	mustHaveCode(t, errs, errors.CodeType)
+
+	_, errs = bind.Request(newRequest(`{"email": `, "application/json"), signupRuleSet())
+	mustHaveCode(t, errs, errors.CodeEncoding)
+
+	_, errs = bind.Request(newRequest(`{} {}`, "application/json"), signupRuleSet())
+	mustHaveCode(t, errs, errors.CodeEncoding)
+
+	_, errs = bind.RequestWithLimit(newRequest(`{"email": "a@example.com"}`, "application/json"), signupRuleSet(), 10)
+	mustHaveCode(t, errs, errors.CodeMax)
+
+	_, errs = bind.Request(newRequest("  ", "application/json"), signupRuleSet().WithRequired())
+	mustHaveCode(t, errs, errors.CodeRequired)
+
+	if output, errs := bind.Request(newRequest("", "application/json"), signupRuleSet()); errs != nil {
+		t.Errorf("Expected errors to be nil, got: %s", errs)
+	} else if output != (signup{}) {
+		t.Errorf("Expected output to be empty, got: %v", output)
+	}
+}
+
+// Requirements:
+// - Top level Json arrays work with slice rule sets.
+// - The request context is used for validation.
+func TestRequestContext(t *testing.T) {
+	tenantKey := rulecontext.Key[string]("tenant")
+	ruleSet := rules.Slice[string]().WithItemRuleSet(rules.String().WithRule(rules.WithContextEqual[string](tenantKey)))
+
+	r := newRequest(`["acme", "acme"]`, "application/json")
+	r = r.WithContext(rulecontext.WithValue(context.Background(), tenantKey, "acme"))
+
+	if output, errs := bind.Request(r, ruleSet); errs != nil {
+		t.Errorf("Expected errors to be nil, got: %s", errs)
+	} else if !reflect.DeepEqual(output, []string{"acme", "acme"}) {
+		t.Errorf("Expected output to be [acme acme], got: %v", output)
+	}
+
+	r = newRequest(`["acme", "globex"]`, "application/json")
+	r = r.WithContext(rulecontext.WithValue(context.Background(), tenantKey, "acme"))
+
+	_, errs := bind.Request(r, ruleSet)
+	mustHaveCode(t, errs, errors.CodeForbidden)
+}
+
+// Requirements:
+// - Status is 200 without errors or with only warnings.
+// - Status is 403 for permission errors.
+// - Status is 422 for other errors.
+func TestStatus(t *testing.T) {
+	ctx := context.Background()
+	invalid := errors.Errorf(errors.CodeMin, ctx, "too small")
+	warning := errors.WithSeverity(invalid, errors.SeverityWarning)
+	denied := errors.NewPermissionError(ctx, "wrong tenant")
+
+	tests := []struct {
+		errs     errors.ValidationErrorCollection
+		expected int
+	}{
+		{nil, http.StatusOK},
+		{errors.Collection(warning), http.StatusOK},
+		{errors.Collection(invalid, denied), http.StatusForbidden},
+		{errors.Collection(invalid), http.StatusUnprocessableEntity},
+	}
+
+	for _, test := range tests {
+		if status := bind.Status(test.errs); status != test.expected {
+			t.Errorf("Expected status to be %d, got: %d for %v", test.expected, status, test.errs)
+		}
+	}
+}
@@ -0,0 +1,2 @@
+// Package bind reads, decodes and validates HTTP request bodies in a single call.
+package bind
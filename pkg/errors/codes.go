@@ -6,19 +6,23 @@ package errors
 type ErrorCode string
 
 const (
-	CodeUnknown    ErrorCode = "UNKNOWN"    // The cause of the validation error was not specified.
-	CodeInternal   ErrorCode = "INTERNAL"   // An internal error occurred. We may know the reason but should not convey that to the user.
-	CodeTimeout    ErrorCode = "TIMEOUT"    // The request timed out before validation could be completed.
-	CodeCancelled  ErrorCode = "CANCELED"   // The request was cancelled before it could be completed.
-	CodeType       ErrorCode = "TYPE"       // Unable to coerce a value to the correct type.
-	CodeRange      ErrorCode = "RANGE"      // The data falls outside the range allowed by the type.
-	CodeRequired   ErrorCode = "REQUIRED"   // Value is required to not be nil.
-	CodeUnexpected ErrorCode = "UNEXPECTED" // Value was not expected to be defined.
-	CodeMin        ErrorCode = "MIN"        // Value does not satisfy minimum constraints.
-	CodeMax        ErrorCode = "MAX"        // Value does not satisfy maximum constraints.
-	CodePattern    ErrorCode = "PATTERN"    // Value does not match an expected pattern or expression.
-	CodeExpired    ErrorCode = "EXPIRED"    // Value has expired
-	CodeForbidden  ErrorCode = "DENIED"     // Value is in a list of forbidden values.
-	CodeNotAllowed ErrorCode = "NOTALLOWED" // Value is not one of the allowed values.
-	CodeEncoding   ErrorCode = "ENCODING"   // Value is not encoded correctly.
+	CodeUnknown     ErrorCode = "UNKNOWN"     // The cause of the validation error was not specified.
+	CodeInternal    ErrorCode = "INTERNAL"    // An internal error occurred. We may know the reason but should not convey that to the user.
+	CodeTimeout     ErrorCode = "TIMEOUT"     // The request timed out before validation could be completed.
+	CodeCancelled   ErrorCode = "CANCELED"    // The request was cancelled before it could be completed.
+	CodeType        ErrorCode = "TYPE"        // Unable to coerce a value to the correct type.
+	CodeRange       ErrorCode = "RANGE"       // The data falls outside the range allowed by the type.
+	CodeRequired    ErrorCode = "REQUIRED"    // Value is required to not be nil.
+	CodeUnexpected  ErrorCode = "UNEXPECTED"  // Value was not expected to be defined.
+	CodeMin         ErrorCode = "MIN"         // Value does not satisfy minimum constraints.
+	CodeMax         ErrorCode = "MAX"         // Value does not satisfy maximum constraints.
+	CodePattern     ErrorCode = "PATTERN"     // Value does not match an expected pattern or expression.
+	CodeExpired     ErrorCode = "EXPIRED"     // Value has expired
+	CodeForbidden   ErrorCode = "DENIED"      // Value is in a list of forbidden values.
+	CodeNotAllowed  ErrorCode = "NOTALLOWED"  // Value is not one of the allowed values.
+	CodeEncoding    ErrorCode = "ENCODING"    // Value is not encoded correctly.
+	CodeStep        ErrorCode = "STEP"        // Value is not a valid step from the start value.
+	CodeChecksum    ErrorCode = "CHECKSUM"    // Value does not have a valid check digit or checksum.
+	CodeUnavailable ErrorCode = "UNAVAILABLE" // A service needed to validate the value is temporarily unavailable.
+	CodeNotFound    ErrorCode = "NOTFOUND"    // Value refers to something that does not exist.
 )
@@ -96,3 +96,156 @@ func (collection ValidationErrorCollection) For(path string) ValidationErrorColl
 
 	return Collection(filteredErrors...)
 }
+
+// Codes returns the error codes of every error in the collection in the same order as the errors.
+// Duplicate codes are not removed.
+func (collection ValidationErrorCollection) Codes() []ErrorCode {
+	if len(collection) == 0 {
+		return nil
+	}
+
+	codes := make([]ErrorCode, len(collection))
+	for i, err := range collection {
+		codes[i] = err.Code()
+	}
+	return codes
+}
+
+// CountByCode returns the number of errors in the collection for each error code. Every error is counted, including
+// errors with the same code and path. Nil is returned for an empty collection.
+//
+// Like FirstWithCode, the collection is not walked recursively.
+func (collection ValidationErrorCollection) CountByCode() map[ErrorCode]int {
+	if len(collection) == 0 {
+		return nil
+	}
+
+	counts := make(map[ErrorCode]int, min(len(collection), 8))
+	for _, err := range collection {
+		counts[err.Code()]++
+	}
+	return counts
+}
+
+// FirstWithCode returns the first error in the collection with the code, or nil if there is none.
+//
+// The collection is not walked recursively. Nested rule sets already add their errors to the same flat collection
+// and individual validation errors do not wrap other errors.
+func (collection ValidationErrorCollection) FirstWithCode(code ErrorCode) ValidationError {
+	for _, err := range collection {
+		if err.Code() == code {
+			return err
+		}
+	}
+	return nil
+}
+
+// ContainsCode returns true if any error in the collection has the code.
+// Like FirstWithCode, the collection is not walked recursively.
+func (collection ValidationErrorCollection) ContainsCode(code ErrorCode) bool {
+	return collection.FirstWithCode(code) != nil
+}
+
+// Filter returns a new collection containing only the errors for which fn returns true.
+// Like For, nil is returned if no errors match.
+func (collection ValidationErrorCollection) Filter(fn func(ValidationError) bool) ValidationErrorCollection {
+	var filteredErrors []ValidationError
+	for _, err := range collection {
+		if fn(err) {
+			filteredErrors = append(filteredErrors, err)
+		}
+	}
+
+	if len(filteredErrors) == 0 {
+		return nil
+	}
+
+	return Collection(filteredErrors...)
+}
+
+// MapPaths returns a new collection with the path of each error replaced by the result of fn.
+// This is useful for removing an internal prefix or renaming fields before returning errors to clients.
+//
+// The code, message, severity, metadata and permission flag of each error are kept. The receiver is not modified.
+func (collection ValidationErrorCollection) MapPaths(fn func(string) string) ValidationErrorCollection {
+	if len(collection) == 0 {
+		return nil
+	}
+
+	mappedErrors := make([]ValidationError, len(collection))
+	for i, err := range collection {
+		mapped := WithMeta(WithSeverity(New(err.Code(), fn(err.Path()), err.Error()), SeverityOf(err)), MetaOf(err))
+		mappedErrors[i] = WithPermission(mapped, IsPermission(err))
+	}
+
+	return Collection(mappedErrors...)
+}
+
+// FirstPerPath returns a new collection with only the first error for each distinct path. The order of the
+// remaining errors is kept. Errors at the root path "" are treated like any other path.
+//
+// This is useful for forms where each field only displays a single message.
+func (collection ValidationErrorCollection) FirstPerPath() ValidationErrorCollection {
+	if len(collection) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, len(collection))
+	filteredErrors := make([]ValidationError, 0, len(collection))
+
+	for _, err := range collection {
+		if _, ok := seen[err.Path()]; ok {
+			continue
+		}
+		seen[err.Path()] = struct{}{}
+		filteredErrors = append(filteredErrors, err)
+	}
+
+	return Collection(filteredErrors...)
+}
+
+// Errors returns a new collection containing only the errors with SeverityError.
+// Nil is returned if there are none.
+func (collection ValidationErrorCollection) Errors() ValidationErrorCollection {
+	return collection.Filter(func(err ValidationError) bool {
+		return SeverityOf(err) == SeverityError
+	})
+}
+
+// Warnings returns a new collection containing only the errors with SeverityWarning.
+// Nil is returned if there are none.
+func (collection ValidationErrorCollection) Warnings() ValidationErrorCollection {
+	return collection.Filter(func(err ValidationError) bool {
+		return SeverityOf(err) == SeverityWarning
+	})
+}
+
+// PermissionErrors returns a new collection containing only the permission errors.
+// Nil is returned if there are none.
+//
+// Permission errors mean the caller is not allowed to use the value, so they can be handled separately from
+// invalid values, for example by responding with 403 Forbidden instead of 422 Unprocessable Entity.
+func (collection ValidationErrorCollection) PermissionErrors() ValidationErrorCollection {
+	return collection.Filter(IsPermission)
+}
+
+// ValidationErrors returns a new collection containing only the errors that are not permission errors.
+// Nil is returned if there are none.
+func (collection ValidationErrorCollection) ValidationErrors() ValidationErrorCollection {
+	return collection.Filter(func(err ValidationError) bool {
+		return !IsPermission(err)
+	})
+}
+
+// HasErrors returns true if the collection contains at least one error that is not a warning.
+//
+// Rule sets return warnings in the same collection as errors so use this instead of comparing to nil when
+// warnings are in use.
+func (collection ValidationErrorCollection) HasErrors() bool {
+	for _, err := range collection {
+		if SeverityOf(err) == SeverityError {
+			return true
+		}
+	}
+	return false
+}
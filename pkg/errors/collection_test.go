@@ -213,3 +213,194 @@ func TestPanicCollectionMessageEmpty(t *testing.T) {
 
 	_ = errors.Collection().Error()
 }
+
+// Requirements:
+// - Codes are returned in order.
+// - Empty collections return nil.
+func TestCollectionCodes(t *testing.T) {
+	col := errors.Collection(
+		errors.New(errors.CodeMin, "/a", "min"),
+		errors.New(errors.CodeMax, "/b", "max"),
+		errors.New(errors.CodeMin, "/c", "min"),
+	)
+
+	codes := col.Codes()
+	expected := []errors.ErrorCode{errors.CodeMin, errors.CodeMax, errors.CodeMin}
+
+	if len(codes) != len(expected) {
+		t.Fatalf("Expected %d codes, got: %d", len(expected), len(codes))
+	}
+	for i := range expected {
+		if codes[i] != expected[i] {
+			t.Errorf("Expected code %d to be %s, got: %s", i, expected[i], codes[i])
+		}
+	}
+
+	if codes := errors.Collection().Codes(); codes != nil {
+		t.Errorf("Expected codes to be nil, got: %v", codes)
+	}
+}
+
+// Requirements:
+// - Every error is counted, including duplicates.
+// - Empty collections return nil.
+func TestCollectionCountByCode(t *testing.T) {
+	col := errors.Collection(
+		errors.New(errors.CodeMin, "/a", "min"),
+		errors.New(errors.CodeMax, "/b", "max"),
+		errors.New(errors.CodeMin, "/c", "min"),
+		errors.New(errors.CodeMin, "/c", "min"),
+		errors.New(errors.CodeType, "/d", "type"),
+	)
+
+	counts := col.CountByCode()
+	expected := map[errors.ErrorCode]int{
+		errors.CodeMin:  3,
+		errors.CodeMax:  1,
+		errors.CodeType: 1,
+	}
+
+	if len(counts) != len(expected) {
+		t.Errorf("Expected %d codes, got: %v", len(expected), counts)
+	}
+	for code, n := range expected {
+		if counts[code] != n {
+			t.Errorf("Expected %d errors for %s, got: %d", n, code, counts[code])
+		}
+	}
+
+	if counts := errors.Collection().CountByCode(); counts != nil {
+		t.Errorf("Expected counts to be nil, got: %v", counts)
+	}
+}
+
+// Requirements:
+// - FirstWithCode returns the first error with the code.
+// - ContainsCode returns true only if an error has the code.
+// - Missing codes and empty collections return nil and false.
+func TestCollectionFirstWithCode(t *testing.T) {
+	err1 := errors.New(errors.CodeMin, "/a", "min")
+	err2 := errors.New(errors.CodeMax, "/b", "max")
+	err3 := errors.New(errors.CodeMax, "/c", "max")
+	col := errors.Collection(err1, err2, err3)
+
+	if err := col.FirstWithCode(errors.CodeMax); err != err2 {
+		t.Errorf("Expected '%s' to be returned, got: '%s'", err2, err)
+	}
+	if err := col.FirstWithCode(errors.CodeType); err != nil {
+		t.Errorf("Expected nil, got: %s", err)
+	}
+	if !col.ContainsCode(errors.CodeMin) {
+		t.Error("Expected collection to contain the min code")
+	}
+	if col.ContainsCode(errors.CodeType) {
+		t.Error("Expected collection to not contain the type code")
+	}
+
+	var empty errors.ValidationErrorCollection
+	if empty.FirstWithCode(errors.CodeMin) != nil || empty.ContainsCode(errors.CodeMin) {
+		t.Error("Expected empty collection to not contain any codes")
+	}
+}
+
+// Requirements:
+// - Only matching errors are returned.
+// - Nil is returned when nothing matches.
+// - The receiver is not modified.
+func TestCollectionFilter(t *testing.T) {
+	err1 := errors.New(errors.CodeMin, "/a", "min")
+	err2 := errors.New(errors.CodeMax, "/b", "max")
+	col := errors.Collection(err1, err2)
+
+	filtered := col.Filter(func(err errors.ValidationError) bool {
+		return err.Code() == errors.CodeMax
+	})
+
+	if len(filtered) != 1 {
+		t.Fatalf("Expected 1 error, got: %d", len(filtered))
+	} else if filtered.First() != err2 {
+		t.Errorf("Expected '%s' to be returned, got: '%s'", err2, filtered.First())
+	}
+
+	if len(col) != 2 || col[0] != err1 || col[1] != err2 {
+		t.Error("Expected collection to not be modified")
+	}
+
+	none := col.Filter(func(err errors.ValidationError) bool {
+		return false
+	})
+	if none != nil {
+		t.Errorf("Expected collection to be nil, got: %s", none)
+	}
+}
+
+// Requirements:
+// - Paths are replaced.
+// - Codes and messages are kept.
+// - The receiver is not modified.
+// - Empty collections return nil.
+func TestCollectionMapPaths(t *testing.T) {
+	err1 := errors.New(errors.CodeMin, "/request/body/name", "min")
+	err2 := errors.New(errors.CodeMax, "/request/body/age", "max")
+	col := errors.Collection(err1, err2)
+
+	mapped := col.MapPaths(func(path string) string {
+		return strings.TrimPrefix(path, "/request/body")
+	})
+
+	if len(mapped) != 2 {
+		t.Fatalf("Expected 2 errors, got: %d", len(mapped))
+	}
+
+	if path := mapped[0].Path(); path != "/name" {
+		t.Errorf("Expected path to be %s, got: %s", "/name", path)
+	} else if mapped[0].Code() != errors.CodeMin || mapped[0].Error() != "min" {
+		t.Errorf("Expected code and message to be kept, got: %s %s", mapped[0].Code(), mapped[0])
+	}
+
+	if path := mapped[1].Path(); path != "/age" {
+		t.Errorf("Expected path to be %s, got: %s", "/age", path)
+	}
+
+	if col[0].Path() != "/request/body/name" || col[1].Path() != "/request/body/age" {
+		t.Error("Expected collection to not be modified")
+	}
+
+	if empty := errors.Collection().MapPaths(strings.ToUpper); empty != nil {
+		t.Errorf("Expected collection to be nil, got: %s", empty)
+	}
+}
+
+// Requirements:
+// - Only the first error for each path is kept.
+// - Order is kept.
+// - The root path is treated like other paths.
+// - Empty collections return nil.
+func TestCollectionFirstPerPath(t *testing.T) {
+	err1 := errors.New(errors.CodeMin, "/a", "min")
+	err2 := errors.New(errors.CodeMax, "", "root max")
+	err3 := errors.New(errors.CodePattern, "/a", "pattern")
+	err4 := errors.New(errors.CodeMin, "", "root min")
+	err5 := errors.New(errors.CodeType, "/b", "type")
+
+	col := errors.Collection(err1, err2, err3, err4, err5)
+	first := col.FirstPerPath()
+
+	expected := []errors.ValidationError{err1, err2, err5}
+	if len(first) != len(expected) {
+		t.Fatalf("Expected %d errors, got: %d", len(expected), len(first))
+	}
+	for i := range expected {
+		if first[i] != expected[i] {
+			t.Errorf("Expected error %d to be '%s', got: '%s'", i, expected[i], first[i])
+		}
+	}
+
+	if len(col) != 5 {
+		t.Error("Expected collection to not be modified")
+	}
+
+	if empty := errors.Collection().FirstPerPath(); empty != nil {
+		t.Errorf("Expected collection to be nil, got: %s", empty)
+	}
+}
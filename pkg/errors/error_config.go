@@ -0,0 +1,59 @@
+package errors
+
+import (
+	"context"
+
+	"proto.zip/studio/validate/pkg/rulecontext"
+)
+
+// ErrorConfig overrides the code and message of the validation errors returned by a rule set.
+// Empty fields keep the original value of the error.
+//
+// Messages are keyed by the original error code. When both are set, the message for the code is preferred over
+// Message since it is more specific.
+type ErrorConfig struct {
+	Code     ErrorCode            // Code replaces the error code.
+	Message  string               // Message replaces the error message. It is formatted using the printer from the context.
+	Messages map[ErrorCode]string // Messages replaces the error message for errors with a matching code.
+	Severity Severity             // Severity replaces the severity of the errors.
+}
+
+// WithErrorConfig returns a new collection with the errors updated using the config.
+// The path, metadata and permission flag of each error are kept. If the config is nil or the collection is empty, the collection is returned as is.
+func WithErrorConfig(ctx context.Context, collection ValidationErrorCollection, config *ErrorConfig) ValidationErrorCollection {
+	if config == nil || len(collection) == 0 {
+		return collection
+	}
+
+	printer := rulecontext.Printer(ctx)
+
+	var message string
+	if config.Message != "" {
+		message = printer.Sprintf(config.Message)
+	}
+
+	updated := make(ValidationErrorCollection, len(collection))
+
+	for i, err := range collection {
+		code := err.Code()
+		if config.Code != "" {
+			code = config.Code
+		}
+
+		msg := err.Error()
+		if specific, ok := config.Messages[err.Code()]; ok {
+			msg = printer.Sprintf(specific)
+		} else if message != "" {
+			msg = message
+		}
+
+		severity := SeverityOf(err)
+		if config.Severity != 0 {
+			severity = config.Severity
+		}
+
+		updated[i] = WithPermission(WithMeta(WithSeverity(New(code, err.Path(), msg), severity), MetaOf(err)), IsPermission(err))
+	}
+
+	return updated
+}
@@ -0,0 +1,58 @@
+package errors_test
+
+import (
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// Requirements:
+// - Nil configs return the collection unchanged.
+// - Empty fields keep the original values.
+// - The path is always kept.
+func TestWithErrorConfig(t *testing.T) {
+	ctx := context.Background()
+	collection := errors.Collection(errors.New(errors.CodeMin, "/a", "original"))
+
+	if errs := errors.WithErrorConfig(ctx, collection, nil); errs[0] != collection[0] {
+		t.Errorf("Expected collection to be unchanged")
+	}
+
+	errs := errors.WithErrorConfig(ctx, collection, &errors.ErrorConfig{Code: "CUSTOM"})
+	if errs[0].Code() != "CUSTOM" || errs[0].Error() != "original" || errs[0].Path() != "/a" {
+		t.Errorf("Expected only the code to change, got: %s %s %s", errs[0].Code(), errs[0].Error(), errs[0].Path())
+	}
+
+	errs = errors.WithErrorConfig(ctx, collection, &errors.ErrorConfig{Message: "custom"})
+	if errs[0].Code() != errors.CodeMin || errs[0].Error() != "custom" || errs[0].Path() != "/a" {
+		t.Errorf("Expected only the message to change, got: %s %s %s", errs[0].Code(), errs[0].Error(), errs[0].Path())
+	}
+}
+
+// Requirements:
+// - Messages are matched using the original code.
+// - Code specific messages are preferred over the general message.
+func TestWithErrorConfig_Messages(t *testing.T) {
+	ctx := context.Background()
+	collection := errors.Collection(
+		errors.New(errors.CodeMin, "/a", "min"),
+		errors.New(errors.CodeMax, "/a", "max"),
+	)
+
+	errs := errors.WithErrorConfig(ctx, collection, &errors.ErrorConfig{
+		Code:     "CUSTOM",
+		Message:  "general",
+		Messages: map[errors.ErrorCode]string{errors.CodeMin: "specific"},
+	})
+
+	if errs[0].Error() != "specific" {
+		t.Errorf("Expected message to be %s, got: %s", "specific", errs[0].Error())
+	}
+	if errs[1].Error() != "general" {
+		t.Errorf("Expected message to be %s, got: %s", "general", errs[1].Error())
+	}
+	if errs[0].Code() != "CUSTOM" {
+		t.Errorf("Expected code to be %s, got: %s", "CUSTOM", errs[0].Code())
+	}
+}
@@ -0,0 +1,32 @@
+package errors
+
+// metaError is implemented by validation errors that have metadata.
+type metaError interface {
+	Meta() map[string]any
+}
+
+// MetaOf returns the metadata of a validation error.
+// Nil is returned if the error has no metadata or does not implement a Meta method.
+//
+// The returned map must not be modified.
+func MetaOf(err ValidationError) map[string]any {
+	if m, ok := err.(metaError); ok {
+		return m.Meta()
+	}
+	return nil
+}
+
+// WithMeta returns a copy of the error with the metadata replaced.
+// Metadata gives clients structured details about the error, such as the list of keys an aggregate error refers to.
+//
+// The map is not copied and must not be modified after it is passed in.
+func WithMeta(err ValidationError, meta map[string]any) ValidationError {
+	return &validationError{
+		code:       err.Code(),
+		path:       err.Path(),
+		message:    err.Error(),
+		severity:   SeverityOf(err),
+		meta:       meta,
+		permission: IsPermission(err),
+	}
+}
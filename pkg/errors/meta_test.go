@@ -0,0 +1,53 @@
+package errors_test
+
+import (
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// Requirements:
+// - Errors have no metadata by default.
+// - WithMeta returns a copy with the metadata.
+// - Severity, path mapping and error config keep the metadata.
+// - Errors without a Meta method have no metadata.
+func TestMeta(t *testing.T) {
+	err := errors.Errorf(errors.CodeUnexpected, context.Background(), "unexpected fields")
+
+	if m := errors.MetaOf(err); m != nil {
+		t.Errorf("Expected meta to be nil, got: %v", m)
+	}
+
+	withMeta := errors.WithMeta(errors.WithSeverity(err, errors.SeverityWarning), map[string]any{"keys": []string{"a"}})
+
+	if m := errors.MetaOf(withMeta); len(m) != 1 {
+		t.Errorf("Expected meta to be set, got: %v", m)
+	} else if withMeta.Code() != err.Code() || withMeta.Path() != err.Path() || withMeta.Error() != err.Error() {
+		t.Error("Expected code, path and message to be kept")
+	} else if errors.SeverityOf(withMeta) != errors.SeverityWarning {
+		t.Error("Expected severity to be kept")
+	}
+
+	if m := errors.MetaOf(err); m != nil {
+		t.Error("Expected original error to not be modified")
+	}
+
+	if m := errors.MetaOf(errors.WithSeverity(withMeta, errors.SeverityError)); len(m) != 1 {
+		t.Errorf("Expected WithSeverity to keep meta, got: %v", m)
+	}
+
+	mapped := errors.Collection(withMeta).MapPaths(func(path string) string { return "/x" + path })
+	if m := errors.MetaOf(mapped[0]); len(m) != 1 {
+		t.Errorf("Expected MapPaths to keep meta, got: %v", m)
+	}
+
+	configured := errors.WithErrorConfig(context.Background(), errors.Collection(withMeta), &errors.ErrorConfig{Message: "custom"})
+	if m := errors.MetaOf(configured[0]); len(m) != 1 {
+		t.Errorf("Expected WithErrorConfig to keep meta, got: %v", m)
+	}
+
+	if m := errors.MetaOf(&customError{}); m != nil {
+		t.Errorf("Expected meta to be nil, got: %v", m)
+	}
+}
@@ -0,0 +1,40 @@
+package errors
+
+import "context"
+
+// permissionError is implemented by validation errors that can be flagged as permission errors.
+type permissionError interface {
+	Permission() bool
+}
+
+// IsPermission returns true if the validation error was caused by the caller not being allowed to use the value,
+// rather than the value being invalid.
+// Errors that do not implement a Permission method are treated as validation errors.
+func IsPermission(err ValidationError) bool {
+	if p, ok := err.(permissionError); ok {
+		return p.Permission()
+	}
+	return false
+}
+
+// WithPermission returns a copy of the error with the permission flag changed.
+// Custom rules can use this to report authorization failures so they can be handled separately from invalid values.
+func WithPermission(err ValidationError, permission bool) ValidationError {
+	return &validationError{
+		code:       err.Code(),
+		path:       err.Path(),
+		message:    err.Error(),
+		severity:   SeverityOf(err),
+		meta:       MetaOf(err),
+		permission: permission,
+	}
+}
+
+// NewPermissionError instantiates a new CodeForbidden permission error given context and a format string.
+// Like Errorf, this uses message.Sprintf to format the message.
+//
+// Permission errors fail validation like any other error. Use PermissionErrors and ValidationErrors on the
+// collection to tell them apart.
+func NewPermissionError(ctx context.Context, key string, args ...interface{}) ValidationError {
+	return WithPermission(Errorf(CodeForbidden, ctx, key, args...), true)
+}
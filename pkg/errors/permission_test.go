@@ -0,0 +1,79 @@
+package errors_test
+
+import (
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// Requirements:
+// - Errors are not permission errors by default.
+// - WithPermission returns a copy with the flag changed.
+// - The flag is kept by WithSeverity, WithMeta and MapPaths.
+func TestPermission(t *testing.T) {
+	err := errors.Errorf(errors.CodeForbidden, context.Background(), "wrong tenant")
+
+	if errors.IsPermission(err) {
+		t.Error("Expected error to not be a permission error")
+	}
+
+	denied := errors.WithPermission(err, true)
+	if !errors.IsPermission(denied) {
+		t.Error("Expected error to be a permission error")
+	} else if denied.Code() != err.Code() || denied.Path() != err.Path() || denied.Error() != err.Error() {
+		t.Error("Expected code, path and message to be kept")
+	}
+
+	if errors.IsPermission(err) {
+		t.Error("Expected original error to not be modified")
+	}
+
+	if !errors.IsPermission(errors.WithSeverity(denied, errors.SeverityWarning)) {
+		t.Error("Expected WithSeverity to keep the permission flag")
+	}
+	if !errors.IsPermission(errors.WithMeta(denied, map[string]any{"tenant": "acme"})) {
+		t.Error("Expected WithMeta to keep the permission flag")
+	}
+
+	mapped := errors.Collection(denied).MapPaths(func(path string) string { return "/tenant" + path })
+	if !errors.IsPermission(mapped.First()) {
+		t.Error("Expected MapPaths to keep the permission flag")
+	}
+
+	if errors.IsPermission(&customError{}) {
+		t.Error("Expected custom error to not be a permission error")
+	}
+}
+
+// Requirements:
+// - NewPermissionError returns a CodeForbidden permission error.
+// - PermissionErrors and ValidationErrors split the collection.
+// - Both return nil when there are no matching errors.
+func TestPermissionErrors(t *testing.T) {
+	ctx := context.Background()
+	invalid := errors.Errorf(errors.CodePattern, ctx, "invalid tenant")
+	denied := errors.NewPermissionError(ctx, "wrong tenant")
+
+	if denied.Code() != errors.CodeForbidden {
+		t.Errorf("Expected code to be %s, got: %s", errors.CodeForbidden, denied.Code())
+	} else if !errors.IsPermission(denied) {
+		t.Error("Expected error to be a permission error")
+	}
+
+	collection := errors.Collection(invalid, denied)
+
+	if permissions := collection.PermissionErrors(); permissions.Size() != 1 || permissions.First() != denied {
+		t.Errorf("Expected only the permission error to be returned, got: %v", permissions)
+	}
+	if validations := collection.ValidationErrors(); validations.Size() != 1 || validations.First() != invalid {
+		t.Errorf("Expected only the validation error to be returned, got: %v", validations)
+	}
+
+	if errors.Collection(invalid).PermissionErrors() != nil {
+		t.Error("Expected nil when there are no permission errors")
+	}
+	if errors.Collection(denied).ValidationErrors() != nil {
+		t.Error("Expected nil when there are no validation errors")
+	}
+}
@@ -0,0 +1,46 @@
+package errors
+
+// Severity indicates if a validation error causes validation to fail.
+type Severity int
+
+const (
+	SeverityError   Severity = iota + 1 // The value is invalid. This is the default for all errors.
+	SeverityWarning                     // The value is allowed but the user should be told about the problem.
+)
+
+// String returns the lowercase name of the severity.
+func (s Severity) String() string {
+	switch s {
+	case SeverityWarning:
+		return "warning"
+	default:
+		return "error"
+	}
+}
+
+// severityError is implemented by validation errors that have a severity.
+type severityError interface {
+	Severity() Severity
+}
+
+// SeverityOf returns the severity of a validation error.
+// Errors that do not implement a Severity method are treated as SeverityError.
+func SeverityOf(err ValidationError) Severity {
+	if s, ok := err.(severityError); ok && s.Severity() == SeverityWarning {
+		return SeverityWarning
+	}
+	return SeverityError
+}
+
+// WithSeverity returns a copy of the error with the severity changed.
+// Custom rules can use this to return warnings that do not cause validation to fail.
+func WithSeverity(err ValidationError, severity Severity) ValidationError {
+	return &validationError{
+		code:       err.Code(),
+		path:       err.Path(),
+		message:    err.Error(),
+		severity:   severity,
+		meta:       MetaOf(err),
+		permission: IsPermission(err),
+	}
+}
@@ -0,0 +1,107 @@
+package errors_test
+
+import (
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// Requirements:
+// - Errors default to SeverityError.
+// - WithSeverity returns a copy with the new severity.
+// - Errors without a Severity method are treated as SeverityError.
+func TestSeverity(t *testing.T) {
+	err := errors.Errorf(errors.CodePattern, context.Background(), "weak password")
+
+	if s := errors.SeverityOf(err); s != errors.SeverityError {
+		t.Errorf("Expected severity to be %s, got: %s", errors.SeverityError, s)
+	}
+
+	warning := errors.WithSeverity(err, errors.SeverityWarning)
+
+	if s := errors.SeverityOf(warning); s != errors.SeverityWarning {
+		t.Errorf("Expected severity to be %s, got: %s", errors.SeverityWarning, s)
+	} else if warning.Code() != err.Code() || warning.Path() != err.Path() || warning.Error() != err.Error() {
+		t.Error("Expected code, path and message to be kept")
+	}
+
+	if s := errors.SeverityOf(err); s != errors.SeverityError {
+		t.Error("Expected original error to not be modified")
+	}
+
+	if s := errors.SeverityOf(&customError{}); s != errors.SeverityError {
+		t.Errorf("Expected severity to be %s, got: %s", errors.SeverityError, s)
+	}
+}
+
+// Requirements:
+// - Errors and Warnings split the collection.
+// - HasErrors ignores warnings.
+func TestCollectionSeverity(t *testing.T) {
+	err1 := errors.New(errors.CodeMin, "/a", "min")
+	warn1 := errors.WithSeverity(errors.New(errors.CodePattern, "/b", "weak"), errors.SeverityWarning)
+
+	col := errors.Collection(err1, warn1)
+
+	if !col.HasErrors() {
+		t.Error("Expected collection to have errors")
+	}
+	if e := col.Errors(); len(e) != 1 || e[0] != err1 {
+		t.Errorf("Expected only the error to be returned, got: %v", e)
+	}
+	if w := col.Warnings(); len(w) != 1 || w[0] != warn1 {
+		t.Errorf("Expected only the warning to be returned, got: %v", w)
+	}
+
+	warnings := errors.Collection(warn1)
+	if warnings.HasErrors() {
+		t.Error("Expected collection to not have errors")
+	}
+	if e := warnings.Errors(); e != nil {
+		t.Errorf("Expected errors to be nil, got: %s", e)
+	}
+
+	var empty errors.ValidationErrorCollection
+	if empty.HasErrors() {
+		t.Error("Expected nil collection to not have errors")
+	}
+
+	if mapped := warnings.MapPaths(func(string) string { return "/c" }); errors.SeverityOf(mapped[0]) != errors.SeverityWarning {
+		t.Error("Expected MapPaths to keep the severity")
+	}
+}
+
+// Requirements:
+// - Error config can set the severity.
+// - The severity is kept when the config does not set it.
+func TestErrorConfigSeverity(t *testing.T) {
+	ctx := context.Background()
+	col := errors.Collection(errors.New(errors.CodeMin, "/a", "min"))
+
+	updated := errors.WithErrorConfig(ctx, col, &errors.ErrorConfig{Severity: errors.SeverityWarning})
+	if errors.SeverityOf(updated[0]) != errors.SeverityWarning {
+		t.Error("Expected severity to be warning")
+	}
+
+	updated = errors.WithErrorConfig(ctx, updated, &errors.ErrorConfig{Code: errors.CodeMax})
+	if errors.SeverityOf(updated[0]) != errors.SeverityWarning {
+		t.Error("Expected severity to be kept")
+	} else if updated[0].Code() != errors.CodeMax {
+		t.Errorf("Expected code to be %s, got: %s", errors.CodeMax, updated[0].Code())
+	}
+
+	if s := errors.SeverityWarning.String(); s != "warning" {
+		t.Errorf("Expected string to be warning, got: %s", s)
+	}
+	if s := errors.SeverityError.String(); s != "error" {
+		t.Errorf("Expected string to be error, got: %s", s)
+	}
+}
+
+// customError implements ValidationError without a severity.
+type customError struct{}
+
+func (e *customError) Code() errors.ErrorCode { return errors.CodeUnknown }
+func (e *customError) Path() string           { return "" }
+func (e *customError) Error() string          { return "custom" }
@@ -0,0 +1,78 @@
+package errors
+
+import (
+	"sort"
+	"strings"
+)
+
+// isDigits returns true if the string is not empty and only contains ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// compareSegments compares two path segments. Numeric segments are compared by value so "2" comes before "10"
+// and come before non-numeric segments. Other segments are compared as strings.
+func compareSegments(a, b string) int {
+	aNum, bNum := isDigits(a), isDigits(b)
+
+	switch {
+	case aNum && bNum:
+		// Compare without converting so that large indexes can not overflow
+		a = strings.TrimLeft(a, "0")
+		b = strings.TrimLeft(b, "0")
+		if len(a) != len(b) {
+			return len(a) - len(b)
+		}
+		return strings.Compare(a, b)
+	case aNum:
+		return -1
+	case bNum:
+		return 1
+	}
+
+	return strings.Compare(a, b)
+}
+
+// comparePaths compares two error paths segment by segment. A path comes before any path it is a prefix of.
+func comparePaths(a, b string) int {
+	aSegments := strings.Split(a, "/")
+	bSegments := strings.Split(b, "/")
+
+	for i := 0; i < len(aSegments) && i < len(bSegments); i++ {
+		if c := compareSegments(aSegments[i], bSegments[i]); c != 0 {
+			return c
+		}
+	}
+
+	return len(aSegments) - len(bSegments)
+}
+
+// Sorted returns a new collection with the errors sorted by path and then by code.
+// Numeric path segments such as array indexes are compared by value so "/a/2" comes before "/a/10".
+//
+// The sort is stable so errors with the same path and code keep their relative order. The receiver is not modified.
+func (collection ValidationErrorCollection) Sorted() ValidationErrorCollection {
+	if len(collection) == 0 {
+		return nil
+	}
+
+	sorted := make(ValidationErrorCollection, len(collection))
+	copy(sorted, collection)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if c := comparePaths(sorted[i].Path(), sorted[j].Path()); c != 0 {
+			return c < 0
+		}
+		return sorted[i].Code() < sorted[j].Code()
+	})
+
+	return sorted
+}
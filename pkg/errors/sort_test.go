@@ -0,0 +1,48 @@
+package errors_test
+
+import (
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// Requirements:
+// - Errors are sorted by path and then code.
+// - Numeric segments are sorted by value.
+// - Parent paths come before child paths.
+// - The sort is stable.
+// - The receiver is not modified.
+func TestCollectionSorted(t *testing.T) {
+	col := errors.Collection(
+		errors.New(errors.CodePattern, "/b", "1"),
+		errors.New(errors.CodeMin, "/a/10", "2"),
+		errors.New(errors.CodeMax, "/a/2", "3"),
+		errors.New(errors.CodeMax, "/a", "4"),
+		errors.New(errors.CodeMin, "/a/2", "5"),
+		errors.New(errors.CodeMax, "/a/2", "6"),
+		errors.New(errors.CodeType, "", "7"),
+		errors.New(errors.CodeType, "/a/x", "8"),
+		errors.New(errors.CodeType, "/a/02", "9"),
+	)
+
+	sorted := col.Sorted()
+
+	expected := []string{"7", "4", "3", "6", "5", "9", "2", "8", "1"}
+	if len(sorted) != len(expected) {
+		t.Fatalf("Expected %d errors, got: %d", len(expected), len(sorted))
+	}
+
+	for i, msg := range expected {
+		if sorted[i].Error() != msg {
+			t.Errorf("Expected error %d to be %s, got: %s at %s", i, msg, sorted[i].Error(), sorted[i].Path())
+		}
+	}
+
+	if col[0].Error() != "1" {
+		t.Error("Expected collection to not be modified")
+	}
+
+	if empty := errors.Collection().Sorted(); empty != nil {
+		t.Errorf("Expected collection to be nil, got: %s", empty)
+	}
+}
@@ -17,9 +17,12 @@ type ValidationError interface {
 // validationError implements a standard Error interface and also ValidationError interface
 // while preserving the validation data.
 type validationError struct {
-	code    ErrorCode // Error code helps identify the error without string comparisons.
-	path    string    // The full path to the error separated by dots.
-	message string    // The error message converted to the context locale.
+	code       ErrorCode      // Error code helps identify the error without string comparisons.
+	path       string         // The full path to the error separated by dots.
+	message    string         // The error message converted to the context locale.
+	severity   Severity       // Warnings do not cause validation to fail. The zero value is treated as an error.
+	meta       map[string]any // Optional structured details about the error.
+	permission bool           // Permission errors are authorization failures rather than invalid values.
 }
 
 // New instantiates a validator error given a code, path, and message.
@@ -59,3 +62,21 @@ func (err *validationError) Code() ErrorCode {
 func (err *validationError) Path() string {
 	return err.path
 }
+
+// Severity returns the severity of the error.
+func (err *validationError) Severity() Severity {
+	if err.severity == SeverityWarning {
+		return SeverityWarning
+	}
+	return SeverityError
+}
+
+// Meta returns the metadata of the error or nil if there is none.
+func (err *validationError) Meta() map[string]any {
+	return err.meta
+}
+
+// Permission returns true if the error is an authorization failure rather than an invalid value.
+func (err *validationError) Permission() bool {
+	return err.permission
+}
@@ -16,6 +16,7 @@ var defaultPrinter *message.Printer
 var printerContextKey int
 var pathContextKey int
 var RuleSetContextKey int
+var failFastContextKey int
 
 // init initialize any global variables needed
 func init() {
@@ -67,3 +68,19 @@ func RuleSet(ctx context.Context) any {
 
 	return ctx.Value(&RuleSetContextKey)
 }
+
+// WithFailFast returns a context that tells rule sets to stop evaluating at the first error.
+// Rule sets nested under the context inherit the mode.
+func WithFailFast(parent context.Context) context.Context {
+	return context.WithValue(parent, &failFastContextKey, true)
+}
+
+// FailFast returns true if rule sets should stop evaluating at the first error.
+func FailFast(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+
+	failFast, _ := ctx.Value(&failFastContextKey).(bool)
+	return failFast
+}
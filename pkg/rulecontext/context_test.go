@@ -131,3 +131,20 @@ func TestPathRuleSet(t *testing.T) {
 		t.Errorf("Expected full path to be `%s` got `%s`", expectedFullPath, p.FullString())
 	}
 }
+
+func TestFailFast(t *testing.T) {
+	if rulecontext.FailFast(nil) {
+		t.Error("Expected fail fast to be false")
+	}
+
+	ctx := context.Background()
+	if rulecontext.FailFast(ctx) {
+		t.Error("Expected fail fast to be false")
+	}
+
+	ctx = rulecontext.WithFailFast(ctx)
+	ctx = rulecontext.WithPathString(ctx, "a")
+	if !rulecontext.FailFast(ctx) {
+		t.Error("Expected fail fast to be true")
+	}
+}
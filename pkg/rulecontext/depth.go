@@ -0,0 +1,55 @@
+package rulecontext
+
+import "context"
+
+// Context key to lookup the depth while avoiding conflicting keys
+var depthContextKey int
+
+// depth stores the current nesting depth and the maximum allowed depth.
+type depth struct {
+	current int
+	max     int // max is 0 if there is no limit.
+}
+
+// WithDepth returns a new Context with the nesting depth increased by one.
+// Rule sets for nested types such as objects and slices should call this before evaluating their children.
+func WithDepth(parent context.Context) context.Context {
+	d := currentDepth(parent)
+	d.current++
+	return context.WithValue(parent, &depthContextKey, d)
+}
+
+// WithMaxDepth returns a new Context that allows at most n more levels of nesting below the current depth.
+// If the context already has a lower limit, that limit is kept.
+//
+// This function panics if n is less than 1.
+func WithMaxDepth(parent context.Context, n int) context.Context {
+	if n < 1 {
+		panic("expected max depth to be at least 1")
+	}
+
+	d := currentDepth(parent)
+	if limit := d.current + n; d.max == 0 || limit < d.max {
+		d.max = limit
+	}
+	return context.WithValue(parent, &depthContextKey, d)
+}
+
+// Depth returns the current nesting depth and the maximum allowed depth.
+// The maximum is 0 if there is no limit.
+func Depth(ctx context.Context) (current, max int) {
+	d := currentDepth(ctx)
+	return d.current, d.max
+}
+
+// currentDepth returns the most recent depth from the context or an empty depth if there is none.
+func currentDepth(ctx context.Context) depth {
+	if ctx == nil {
+		return depth{}
+	}
+
+	if d, ok := ctx.Value(&depthContextKey).(depth); ok {
+		return d
+	}
+	return depth{}
+}
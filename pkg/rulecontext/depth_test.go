@@ -0,0 +1,46 @@
+package rulecontext_test
+
+import (
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/rulecontext"
+)
+
+func TestDepth(t *testing.T) {
+	if current, max := rulecontext.Depth(nil); current != 0 || max != 0 {
+		t.Errorf("Expected depth to be 0/0, got: %d/%d", current, max)
+	}
+
+	ctx := rulecontext.WithDepth(context.Background())
+	ctx = rulecontext.WithDepth(ctx)
+	if current, max := rulecontext.Depth(ctx); current != 2 || max != 0 {
+		t.Errorf("Expected depth to be 2/0, got: %d/%d", current, max)
+	}
+
+	ctx = rulecontext.WithMaxDepth(ctx, 3)
+	if current, max := rulecontext.Depth(ctx); current != 2 || max != 5 {
+		t.Errorf("Expected depth to be 2/5, got: %d/%d", current, max)
+	}
+
+	// A higher limit does not override a lower one
+	higher := rulecontext.WithMaxDepth(ctx, 10)
+	if _, max := rulecontext.Depth(higher); max != 5 {
+		t.Errorf("Expected max depth to be 5, got: %d", max)
+	}
+
+	lower := rulecontext.WithMaxDepth(ctx, 1)
+	if _, max := rulecontext.Depth(lower); max != 3 {
+		t.Errorf("Expected max depth to be 3, got: %d", max)
+	}
+}
+
+func TestWithMaxDepthPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+
+	rulecontext.WithMaxDepth(context.Background(), 0)
+}
@@ -0,0 +1,30 @@
+package rulecontext
+
+import (
+	"context"
+	"time"
+)
+
+// Context key to lookup the current time function
+var nowContextKey int
+
+// WithNow returns a context that uses the provided function to get the current time.
+// This is mostly useful in tests so rules relative to the current time are deterministic.
+func WithNow(parent context.Context, now func() time.Time) context.Context {
+	if now == nil {
+		panic("expected now to not be nil")
+	}
+	return context.WithValue(parent, &nowContextKey, now)
+}
+
+// Now returns the current time using the function from the context.
+// If none is found it returns time.Now().
+func Now(ctx context.Context) time.Time {
+	if ctx != nil {
+		if now, ok := ctx.Value(&nowContextKey).(func() time.Time); ok {
+			return now()
+		}
+	}
+
+	return time.Now()
+}
@@ -0,0 +1,36 @@
+package rulecontext_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"proto.zip/studio/validate/pkg/rulecontext"
+)
+
+// Requirements:
+// - Defaults to the current time.
+// - Uses the function from the context when set.
+// - Panics on nil function.
+func TestNow(t *testing.T) {
+	before := time.Now()
+	if now := rulecontext.Now(nil); now.Before(before) {
+		t.Errorf("Expected now to be on or after %s, got: %s", before, now)
+	}
+
+	fixed := time.Date(2020, time.January, 2, 3, 4, 5, 0, time.UTC)
+	ctx := rulecontext.WithNow(context.Background(), func() time.Time { return fixed })
+	ctx = rulecontext.WithPathString(ctx, "a")
+
+	if now := rulecontext.Now(ctx); !now.Equal(fixed) {
+		t.Errorf("Expected now to be %s, got: %s", fixed, now)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic")
+		}
+	}()
+
+	rulecontext.WithNow(context.Background(), nil)
+}
@@ -56,7 +56,7 @@ func (s *pathSegmentIndex) FullString() string {
 	if s.parent != nil {
 		return s.parent.FullString() + "/" + s.String()
 	}
-	return s.String()
+	return "/" + s.String()
 }
 
 // WithPathString returns a new Context with the path segment added.
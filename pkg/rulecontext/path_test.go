@@ -104,3 +104,11 @@ func TestWithPathCombined(t *testing.T) {
 	ctx = rulecontext.WithPathString(ctx, "pathc")
 	fullPathHelper(t, ctx, "/patha/pathb/1/2/pathc")
 }
+
+func TestWithPathIndexRoot(t *testing.T) {
+	ctx := rulecontext.WithPathIndex(context.Background(), 1)
+	fullPathHelper(t, ctx, "/1")
+
+	ctx = rulecontext.WithPathString(ctx, "patha")
+	fullPathHelper(t, ctx, "/1/patha")
+}
@@ -0,0 +1,37 @@
+package rulecontext
+
+import (
+	"context"
+	"net"
+)
+
+// Context key to lookup the DNS resolver
+var resolverContextKey int
+
+// DNSResolver is the subset of net.Resolver used by rules that perform DNS lookups.
+// It is satisfied by *net.Resolver.
+type DNSResolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// WithResolver returns a context that uses the provided resolver for DNS lookups.
+// This can be used to configure the resolver or to replace it in tests.
+func WithResolver(parent context.Context, resolver DNSResolver) context.Context {
+	if resolver == nil {
+		panic("expected resolver to not be nil")
+	}
+	return context.WithValue(parent, &resolverContextKey, resolver)
+}
+
+// Resolver returns the DNS resolver from the context.
+// If none is found it returns net.DefaultResolver.
+func Resolver(ctx context.Context) DNSResolver {
+	if ctx != nil {
+		if resolver, ok := ctx.Value(&resolverContextKey).(DNSResolver); ok {
+			return resolver
+		}
+	}
+
+	return net.DefaultResolver
+}
@@ -0,0 +1,44 @@
+package rulecontext_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/rulecontext"
+)
+
+type testResolver struct{}
+
+func (testResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	return nil, nil
+}
+
+func (testResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	return nil, nil
+}
+
+// Requirements:
+// - Defaults to net.DefaultResolver.
+// - Uses the resolver from the context when set.
+// - Panics on nil resolver.
+func TestResolver(t *testing.T) {
+	if resolver := rulecontext.Resolver(nil); resolver != net.DefaultResolver {
+		t.Errorf("Expected the default resolver, got: %v", resolver)
+	}
+
+	ctx := rulecontext.WithResolver(context.Background(), testResolver{})
+	ctx = rulecontext.WithPathString(ctx, "a")
+
+	if _, ok := rulecontext.Resolver(ctx).(testResolver); !ok {
+		t.Errorf("Expected the resolver from the context, got: %v", rulecontext.Resolver(ctx))
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic")
+		}
+	}()
+
+	rulecontext.WithResolver(context.Background(), nil)
+}
@@ -0,0 +1,63 @@
+package rulecontext
+
+import "context"
+
+// ValueKey is a typed key for request scoped values that custom rules need, such as the current user or tenant.
+//
+// Each call to Key returns a unique key so values can not conflict with keys from other packages, even if they
+// have the same name.
+type ValueKey[T any] struct {
+	name string
+}
+
+// Key returns a new typed key. The name is only used for debugging.
+//
+// Keys are usually declared once as package variables:
+//
+//	var TenantKey = rulecontext.Key[string]("tenant")
+func Key[T any](name string) *ValueKey[T] {
+	return &ValueKey[T]{name: name}
+}
+
+// String returns the name of the key.
+func (k *ValueKey[T]) String() string {
+	return k.name
+}
+
+// WithValue returns a new Context with the value stored for the key.
+//
+// The context can then be passed to Apply or Evaluate and the value is available to every rule, including rules
+// in nested rule sets:
+//
+//	ctx := rulecontext.WithValue(context.Background(), TenantKey, "acme")
+//	errs := ruleSet.Apply(ctx, input, &output)
+func WithValue[T any](parent context.Context, key *ValueKey[T], value T) context.Context {
+	if key == nil {
+		panic("expected key to not be nil")
+	}
+	return context.WithValue(parent, key, value)
+}
+
+// Value returns the most recent value stored for the key and a boolean indicating if one was found.
+//
+// Custom rules can use it to read request scoped data:
+//
+//	rules.String().WithRuleFunc(func(ctx context.Context, id string) errors.ValidationErrorCollection {
+//		tenant, ok := rulecontext.Value(ctx, TenantKey)
+//		if !ok || !strings.HasPrefix(id, tenant+"/") {
+//			return errors.Collection(errors.Errorf(errors.CodeForbidden, ctx, "resource belongs to another tenant"))
+//		}
+//		return nil
+//	})
+func Value[T any](ctx context.Context, key *ValueKey[T]) (T, bool) {
+	var empty T
+	if ctx == nil || key == nil {
+		return empty, false
+	}
+
+	value, ok := ctx.Value(key).(T)
+	if !ok {
+		return empty, false
+	}
+	return value, true
+}
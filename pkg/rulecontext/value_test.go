@@ -0,0 +1,43 @@
+package rulecontext_test
+
+import (
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/rulecontext"
+)
+
+func TestValue(t *testing.T) {
+	tenantKey := rulecontext.Key[string]("tenant")
+	otherKey := rulecontext.Key[string]("tenant")
+
+	if _, ok := rulecontext.Value(nil, tenantKey); ok {
+		t.Error("Expected value to not be found")
+	}
+
+	ctx := rulecontext.WithValue(context.Background(), tenantKey, "acme")
+	ctx = rulecontext.WithPathString(ctx, "a")
+
+	if v, ok := rulecontext.Value(ctx, tenantKey); !ok || v != "acme" {
+		t.Errorf("Expected value to be acme, got: %s", v)
+	}
+
+	// Keys with the same name do not conflict
+	if _, ok := rulecontext.Value(ctx, otherKey); ok {
+		t.Error("Expected value to not be found")
+	}
+
+	if s := tenantKey.String(); s != "tenant" {
+		t.Errorf("Expected key name to be tenant, got: %s", s)
+	}
+}
+
+func TestWithValueNil(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+
+	rulecontext.WithValue[string](context.Background(), nil, "")
+}
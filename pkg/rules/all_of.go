@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// AllOfRuleSet implements RuleSet and passes only if every wrapped rule set passes.
+type AllOfRuleSet[T any] struct {
+	NoConflict[T]
+	ruleSets []RuleSet[T]
+}
+
+// AllOf returns a rule set that applies each rule set in order from left to right. This is useful when the
+// constraints come from rule sets created by different constructors which can't be combined with WithRule.
+//
+// The output of each rule set is used as the input of the next so a later rule set sees the value after it has
+// been coerced or transformed by an earlier one. If a rule set fails, the next rule set is passed the same input
+// that was passed to the failing rule set. The errors from every rule set are returned.
+//
+// This function panics if no rule sets are provided.
+func AllOf[T any](ruleSets ...RuleSet[T]) *AllOfRuleSet[T] {
+	if len(ruleSets) == 0 {
+		panic(fmt.Errorf("at least one rule set is required"))
+	}
+
+	return &AllOfRuleSet[T]{
+		ruleSets: append([]RuleSet[T](nil), ruleSets...),
+	}
+}
+
+// Required returns true if any of the rule sets are required.
+func (v *AllOfRuleSet[T]) Required() bool {
+	for _, ruleSet := range v.ruleSets {
+		if ruleSet.Required() {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply applies each rule set in order, passing the output of one rule set to the next, and assigns the output
+// of the last rule set.
+func (v *AllOfRuleSet[T]) Apply(ctx context.Context, input, output any) errors.ValidationErrorCollection {
+	// Ensure output is a non-nil pointer
+	rv := reflect.ValueOf(output)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.Collection(
+			errors.Errorf(errors.CodeInternal, ctx, "Output must be a non-nil pointer"),
+		)
+	}
+
+	allErrors := errors.Collection()
+	value := input
+
+	for _, ruleSet := range v.ruleSets {
+		var out T
+		errs := ruleSet.Apply(ctx, value, &out)
+		allErrors = append(allErrors, errs...)
+		if errs.HasErrors() {
+			continue
+		}
+		value = out
+	}
+
+	if allErrors.HasErrors() {
+		return allErrors
+	}
+
+	if errs := assignValue(ctx, value, output); errs != nil {
+		return errs
+	}
+
+	// Only warnings remain at this point
+	if len(allErrors) > 0 {
+		return allErrors
+	}
+	return nil
+}
+
+// Evaluate evaluates every rule set in order and returns all the errors.
+func (v *AllOfRuleSet[T]) Evaluate(ctx context.Context, value T) errors.ValidationErrorCollection {
+	allErrors := errors.Collection()
+
+	for _, ruleSet := range v.ruleSets {
+		allErrors = append(allErrors, ruleSet.Evaluate(ctx, value)...)
+	}
+
+	if len(allErrors) > 0 {
+		return allErrors
+	}
+	return nil
+}
+
+// Any returns a new RuleSet that wraps the AllOf RuleSet in any Any rule set
+// which can then be used in nested validation.
+func (v *AllOfRuleSet[T]) Any() RuleSet[any] {
+	return WrapAny[T](v)
+}
+
+// String returns a string representation of the rule set suitable for debugging.
+func (v *AllOfRuleSet[T]) String() string {
+	return "AllOf(" + joinRuleSets(v.ruleSets) + ")"
+}
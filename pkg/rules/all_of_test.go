@@ -0,0 +1,97 @@
+package rules_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Passes only if every rule set passes.
+// - Errors from every rule set are returned.
+func TestAllOf(t *testing.T) {
+	ruleSet := rules.AllOf[string](
+		rules.String().WithMinLen(3),
+		rules.String().WithRegexpString(`^[a-z]+$`, "letters only"),
+	)
+
+	testhelpers.MustApply(t, ruleSet.Any(), "abc")
+	testhelpers.MustNotApply(t, ruleSet.Any(), "ab", errors.CodeMin)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "abc1", errors.CodePattern)
+
+	if err := ruleSet.Evaluate(context.Background(), "A"); len(err) != 2 {
+		t.Errorf("Expected 2 errors, got: %d", len(err))
+	}
+	if err := ruleSet.Evaluate(context.Background(), "abc"); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	}
+
+	var out string
+	if err := ruleSet.Apply(context.Background(), "A", &out); len(err) != 2 {
+		t.Errorf("Expected 2 errors, got: %d", len(err))
+	}
+}
+
+// Requirements:
+// - Rule sets are applied from left to right.
+// - A later rule set sees the output of an earlier one.
+func TestAllOf_Order(t *testing.T) {
+	trim := rules.WithTransform[string](rules.String(), func(_ context.Context, value any) (any, error) {
+		if s, ok := value.(string); ok {
+			return strings.TrimSpace(s), nil
+		}
+		return value, nil
+	})
+
+	ruleSet := rules.AllOf[string](trim, rules.String().WithMaxLen(3))
+
+	var out string
+	if err := ruleSet.Apply(context.Background(), "  abc  ", &out); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	} else if out != "abc" {
+		t.Errorf("Expected output to be abc, got: %s", out)
+	}
+
+	reversed := rules.AllOf[string](rules.String().WithMaxLen(3), trim)
+	testhelpers.MustNotApply(t, reversed.Any(), "  abc  ", errors.CodeMax)
+
+	coerced := rules.AllOf[int](rules.Int(), rules.Int().WithStrict().WithMin(10))
+
+	var intOut int
+	if err := coerced.Apply(context.Background(), "15", &intOut); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	} else if intOut != 15 {
+		t.Errorf("Expected output to be 15, got: %d", intOut)
+	}
+}
+
+// Requirements:
+// - Required if any rule set is required.
+// - Panics with no rule sets.
+// - Serializes to string.
+func TestAllOf_String(t *testing.T) {
+	ruleSet := rules.AllOf[int](rules.Int().WithRequired(), rules.Int().WithMin(1))
+
+	if !ruleSet.Required() {
+		t.Errorf("Expected rule set to be required")
+	}
+	if rules.AllOf[int](rules.Int()).Required() {
+		t.Errorf("Expected rule set to not be required")
+	}
+
+	expected := "AllOf(IntRuleSet[int].WithRequired(), IntRuleSet[int].WithMin(1))"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+	rules.AllOf[int]()
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"reflect"
 
+	"proto.zip/studio/validate/internal/util"
 	"proto.zip/studio/validate/pkg/errors"
 	"proto.zip/studio/validate/pkg/rulecontext"
 )
@@ -16,6 +17,7 @@ type AnyRuleSet struct {
 	NoConflict[any]
 	required  bool
 	forbidden bool
+	withNil   bool
 	rule      Rule[any]
 	parent    *AnyRuleSet
 	label     string
@@ -43,6 +45,7 @@ func (v *AnyRuleSet) WithRequired() *AnyRuleSet {
 	return &AnyRuleSet{
 		required:  true,
 		forbidden: v.forbidden,
+		withNil:   v.withNil,
 		parent:    v,
 		label:     "WithRequired()",
 	}
@@ -54,18 +57,41 @@ func (v *AnyRuleSet) WithForbidden() *AnyRuleSet {
 	return &AnyRuleSet{
 		required:  v.required,
 		forbidden: true,
+		withNil:   v.withNil,
 		parent:    v,
 		label:     "WithForbidden()",
 	}
 }
 
+// WithNil returns a new child rule set that skips the rules for a nil input, such as a JSON null or a nil pointer,
+// and leaves the output unchanged. Any accepts nil without WithNil, but the rules are still evaluated and a nil
+// pointer is assigned to the output as is.
+func (v *AnyRuleSet) WithNil() *AnyRuleSet {
+	if v.withNil {
+		return v
+	}
+
+	return &AnyRuleSet{
+		required:  v.required,
+		forbidden: v.forbidden,
+		withNil:   true,
+		parent:    v,
+		label:     "WithNil()",
+	}
+}
+
 // Apply performs a validation of a RuleSet against a value and assigns the value to the output
 // or a ValidationErrorCollection.
 func (v *AnyRuleSet) Apply(ctx context.Context, input, output any) errors.ValidationErrorCollection {
+	// WithNil skips the rules for nil
+	if v.withNil && util.IsNil(input) {
+		return nil
+	}
 
-	err := v.Evaluate(ctx, input)
-	if err != nil {
-		return err
+	// Warnings do not prevent the value from being set
+	errs := v.Evaluate(ctx, input)
+	if errs.HasErrors() {
+		return errs
 	}
 
 	// Ensure output is a pointer
@@ -82,10 +108,15 @@ func (v *AnyRuleSet) Apply(ctx context.Context, input, output any) errors.Valida
 	// Convert input to reflect.Value
 	inputValue := reflect.ValueOf(input)
 
+	// A nil input leaves the output unchanged
+	if !inputValue.IsValid() {
+		return errs
+	}
+
 	// Check if the input can be assigned to the output
 	if inputValue.Type().AssignableTo(elem.Type()) {
 		elem.Set(inputValue)
-		return nil
+		return errs
 	}
 
 	return errors.Collection(
@@ -107,12 +138,15 @@ func (v *AnyRuleSet) Evaluate(ctx context.Context, value any) errors.ValidationE
 
 	currentRuleSet := v
 	ctx = rulecontext.WithRuleSet(ctx, v)
+	failFast := rulecontext.FailFast(ctx)
 
 	for currentRuleSet != nil {
 		if currentRuleSet.rule != nil {
-			err := currentRuleSet.rule.Evaluate(ctx, value)
-			if err != nil {
-				allErrors = append(allErrors, err...)
+			if errs := currentRuleSet.rule.Evaluate(ctx, value); errs != nil {
+				allErrors = appendErrors(allErrors, errs)
+				if failFast && errs.HasErrors() {
+					break
+				}
 			}
 		}
 
@@ -135,6 +169,7 @@ func (v *AnyRuleSet) WithRule(rule Rule[any]) *AnyRuleSet {
 	return &AnyRuleSet{
 		required:  v.required,
 		forbidden: v.forbidden,
+		withNil:   v.withNil,
 		rule:      rule,
 		parent:    v,
 	}
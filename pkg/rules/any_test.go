@@ -1,9 +1,11 @@
 package rules_test
 
 import (
+	"context"
 	"testing"
 
 	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
 	"proto.zip/studio/validate/pkg/rules"
 	"proto.zip/studio/validate/pkg/testhelpers"
 )
@@ -127,3 +129,31 @@ func TestAnyComposition(t *testing.T) {
 
 	testhelpers.MustNotApply(t, ruleSet, 123, errors.CodeUnknown)
 }
+
+// Requirements:
+// - Rules that only return warnings do not prevent the output from being set.
+// - The warnings are returned.
+// - Warnings do not stop the other rules in fail fast mode.
+func TestAny_Warning(t *testing.T) {
+	warn := func(ctx context.Context, _ any) errors.ValidationErrorCollection {
+		return errors.Collection(errors.WithSeverity(errors.Errorf(errors.CodeUnknown, ctx, "just a warning"), errors.SeverityWarning))
+	}
+
+	var out any
+	errs := rules.Any().WithRuleFunc(warn).Apply(context.Background(), 123, &out)
+	if errs.HasErrors() || len(errs.Warnings()) != 1 {
+		t.Errorf("Expected 1 warning and no errors, got: %s", errs)
+	} else if out != 123 {
+		t.Errorf("Expected output to be set, got: %v", out)
+	}
+
+	fail := func(ctx context.Context, _ any) errors.ValidationErrorCollection {
+		return errors.Collection(errors.Errorf(errors.CodeForbidden, ctx, "value is not allowed"))
+	}
+
+	ctx := rulecontext.WithFailFast(context.Background())
+	errs = rules.Any().WithRuleFunc(fail).WithRuleFunc(warn).Evaluate(ctx, 123)
+	if len(errs.Errors()) != 1 || len(errs.Warnings()) != 1 {
+		t.Errorf("Expected 1 error and 1 warning, got: %s", errs)
+	}
+}
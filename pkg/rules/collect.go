@@ -0,0 +1,17 @@
+package rules
+
+import (
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// appendErrors returns the collection with the errors appended.
+//
+// Most rule sets return no errors or a single collection, so if the collection is empty the errors are returned
+// as is instead of being copied into a new slice. The capacity is capped so that a later append always copies
+// rather than writing into a slice that may still be referenced by the rule that returned it.
+func appendErrors(collection, errs errors.ValidationErrorCollection) errors.ValidationErrorCollection {
+	if len(collection) == 0 {
+		return errs[:len(errs):len(errs)]
+	}
+	return append(collection, errs...)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"proto.zip/studio/validate/internal/util"
 	"proto.zip/studio/validate/pkg/errors"
 )
 
@@ -19,7 +20,9 @@ var constCacheMap map[any]any
 // type it is usually best to use that type.
 type ConstantRuleSet[T comparable] struct {
 	required bool
+	withNil  bool
 	value    T
+	equal    func(a, b T) bool
 	empty    T // Leave this empty
 }
 
@@ -64,18 +67,69 @@ func (ruleSet *ConstantRuleSet[T]) WithRequired() *ConstantRuleSet[T] {
 
 	return &ConstantRuleSet[T]{
 		value:    ruleSet.value,
+		equal:    ruleSet.equal,
 		required: true,
+		withNil:  ruleSet.withNil,
 	}
 }
 
+// WithNil returns a new rule set that accepts nil as well as the constant. The output is left unchanged for a nil
+// input. Without WithNil nil returns an errors.CodeType error since it does not have the type of the constant.
+func (ruleSet *ConstantRuleSet[T]) WithNil() *ConstantRuleSet[T] {
+	if ruleSet.withNil {
+		return ruleSet
+	}
+
+	return &ConstantRuleSet[T]{
+		value:    ruleSet.value,
+		equal:    ruleSet.equal,
+		required: ruleSet.required,
+		withNil:  true,
+	}
+}
+
+// WithEqualFunc returns a new rule set that uses fn to compare values to the constant instead of ==.
+// The first argument is the value being validated and the second is the constant.
+//
+// For example, to match a string discriminator in any case:
+//
+//	rules.Constant("circle").WithEqualFunc(strings.EqualFold)
+//
+// Value still returns the constant as it was passed to Constant. Since other values can match, an ObjectRuleSet
+// treats a key rule with an equality function as a dynamic key. Pass it to WithDynamicKey to match keys in any case.
+// The rule is then returned by KeyRules instead of Keys and matching keys keep the spelling from the input.
+func (ruleSet *ConstantRuleSet[T]) WithEqualFunc(fn func(a, b T) bool) *ConstantRuleSet[T] {
+	return &ConstantRuleSet[T]{
+		value:    ruleSet.value,
+		equal:    fn,
+		required: ruleSet.required,
+		withNil:  ruleSet.withNil,
+	}
+}
+
+// exactConstant returns the constant rule set if the rule is a constant compared with ==.
+// Constants with an equality function are matched like any other dynamic key rule.
+func exactConstant[T comparable](rule Rule[T]) (*ConstantRuleSet[T], bool) {
+	c, ok := rule.(*ConstantRuleSet[T])
+	if !ok || c.equal != nil {
+		return nil, false
+	}
+	return c, true
+}
+
 // Apply validates a RuleSet against an input value and assigns the validated value to output.
 // It returns a ValidationErrorCollection.
 func (ruleSet *ConstantRuleSet[T]) Apply(ctx context.Context, input, output any) errors.ValidationErrorCollection {
+	// Nil is only allowed with WithNil
+	if ruleSet.withNil && util.IsNil(input) {
+		return nil
+	}
+
 	// Attempt to coerce input to type T.
 	v, ok := input.(T)
 	if !ok {
 		// Return a coercion error if input is not of type T.
-		return errors.Collection(errors.NewCoercionError(ctx, reflect.TypeOf(ruleSet.empty).String(), reflect.TypeOf(input).String()))
+		return errors.Collection(errors.NewCoercionError(ctx, reflect.TypeOf(ruleSet.empty).String(), fmt.Sprintf("%T", input)))
 	}
 
 	// Ensure the output is assignable to the coerced value.
@@ -94,6 +148,12 @@ func (ruleSet *ConstantRuleSet[T]) Apply(ctx context.Context, input, output any)
 
 // Evaluate performs a validation of a RuleSet against a value and returns any errors.
 func (ruleSet *ConstantRuleSet[T]) Evaluate(ctx context.Context, value T) errors.ValidationErrorCollection {
+	if ruleSet.equal != nil {
+		if !ruleSet.equal(value, ruleSet.value) {
+			return errors.Collection(errors.Errorf(errors.CodePattern, ctx, "value does not match"))
+		}
+		return nil
+	}
 	if value != ruleSet.value {
 		return errors.Collection(errors.Errorf(errors.CodePattern, ctx, "value does not match"))
 	}
@@ -113,13 +173,20 @@ func (ruleSet *ConstantRuleSet[T]) Any() RuleSet[any] {
 // String returns a string representation of the rule set suitable for debugging.
 func (ruleSet *ConstantRuleSet[T]) String() string {
 	str := fmt.Sprintf(`ConstantRuleSet(%v)`, ruleSet.value)
+	if ruleSet.equal != nil {
+		str += ".WithEqualFunc(<func>)"
+	}
 	if ruleSet.required {
-		return str + ".WithRequired()"
+		str += ".WithRequired()"
+	}
+	if ruleSet.withNil {
+		str += ".WithNil()"
 	}
 	return str
 }
 
 // Value returns the constant value in the correct type.
+// If an equality function is set this is one of the values that match and not necessarily the only one.
 func (ruleSet *ConstantRuleSet[T]) Value() T {
 	return ruleSet.value
 }
@@ -1,6 +1,8 @@
 package rules_test
 
 import (
+	"context"
+	"strings"
 	"testing"
 
 	"proto.zip/studio/validate/pkg/errors"
@@ -83,3 +85,55 @@ func TestConstantRuleSet_Conflict(t *testing.T) {
 		t.Error("Expected Conflict to be true for xyz -> abc")
 	}
 }
+
+// Requirements:
+// - Values are compared with the equality function.
+// - Value returns the original constant.
+// - The function is kept by WithRequired.
+// - Serializes to ConstantRuleSet(value).WithEqualFunc(<func>).
+func TestConstantWithEqualFunc(t *testing.T) {
+	ruleSet := rules.Constant("circle").WithEqualFunc(strings.EqualFold).WithRequired()
+
+	testhelpers.MustApply(t, ruleSet.Any(), "Circle")
+	testhelpers.MustApply(t, ruleSet.Any(), "circle")
+	testhelpers.MustNotApply(t, ruleSet.Any(), "square", errors.CodePattern)
+
+	if v := ruleSet.Value(); v != "circle" {
+		t.Errorf("Expected value to be circle, got: %s", v)
+	}
+
+	expected := "ConstantRuleSet(circle).WithEqualFunc(<func>).WithRequired()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+
+	// The cached constant is not modified
+	testhelpers.MustNotApply(t, rules.Constant("circle").Any(), "Circle", errors.CodePattern)
+}
+
+// Requirements:
+// - Constants with an equality function match object keys like dynamic keys.
+// - The input spelling of the key is kept.
+// - The key is returned by KeyRules and not Keys.
+func TestConstantWithEqualFunc_ObjectKey(t *testing.T) {
+	ruleSet := rules.StringMap[any]().
+		WithDynamicKey(rules.Constant("kind").WithEqualFunc(strings.EqualFold), rules.String().WithAllowedValues("circle").Any())
+
+	var out map[string]any
+	if errs := ruleSet.Apply(context.Background(), map[string]any{"Kind": "circle"}, &out); errs != nil {
+		t.Fatalf("Expected errors to be nil, got: %s", errs)
+	}
+	if out["Kind"] != "circle" {
+		t.Errorf("Expected the input key to be kept, got: %v", out)
+	}
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"KIND": "square"}, errors.CodeNotAllowed)
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"shape": "circle"}, errors.CodeUnexpected)
+
+	if keys := ruleSet.Keys(); len(keys) != 0 {
+		t.Errorf("Expected no constant keys, got: %v", keys)
+	}
+	if keyRules := ruleSet.KeyRules(); len(keyRules) != 1 {
+		t.Errorf("Expected 1 key rule, got: %d", len(keyRules))
+	}
+}
@@ -0,0 +1,22 @@
+package rules
+
+import (
+	"context"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+)
+
+// enterDepth returns a context with the nesting depth increased by one and returns an error if the maximum depth
+// has been exceeded. The maxDepth parameter is the limit set on the rule set, or 0 if there is none.
+func enterDepth(ctx context.Context, maxDepth int) (context.Context, errors.ValidationError) {
+	if maxDepth > 0 {
+		ctx = rulecontext.WithMaxDepth(ctx, maxDepth)
+	}
+	ctx = rulecontext.WithDepth(ctx)
+
+	if current, max := rulecontext.Depth(ctx); max > 0 && current > max {
+		return ctx, errors.Errorf(errors.CodeMax, ctx, "maximum depth of %d exceeded", max)
+	}
+	return ctx, nil
+}
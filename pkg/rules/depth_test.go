@@ -0,0 +1,83 @@
+package rules_test
+
+import (
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// nestedMap returns a map nested n levels deep.
+func nestedMap(n int) map[string]any {
+	m := map[string]any{}
+	for i := 1; i < n; i++ {
+		m = map[string]any{"n": m}
+	}
+	return m
+}
+
+// Requirements:
+// - Input within the limit is valid.
+// - Input deeper than the limit returns CodeMax at the path where the limit was exceeded.
+// - The limit is passed to nested rule sets through the context.
+func TestObjectRuleSet_WithMaxDepth(t *testing.T) {
+	// The rule set accepts much deeper input than the tests use so only the depth limit can fail
+	var nested rules.RuleSet[any] = rules.StringMap[any]().Any()
+	for i := 0; i < 20; i++ {
+		nested = rules.StringMap[any]().WithKey("n", nested).Any()
+	}
+	deep := rules.StringMap[any]().WithKey("n", nested)
+
+	ruleSet := deep.WithMaxDepth(3)
+
+	testhelpers.MustApplyAny(t, ruleSet.Any(), nestedMap(3))
+
+	var out map[string]any
+	err := ruleSet.Apply(context.Background(), nestedMap(4), &out)
+	if len(err) != 1 {
+		t.Fatalf("Expected 1 error, got: %d", len(err))
+	}
+	if err[0].Code() != errors.CodeMax {
+		t.Errorf("Expected error code to be %s, got: %s", errors.CodeMax, err[0].Code())
+	}
+	if err[0].Path() != "/n/n/n" {
+		t.Errorf("Expected error path to be /n/n/n, got: %s", err[0].Path())
+	}
+
+	// Without a limit any depth is allowed
+	testhelpers.MustApplyAny(t, deep.Any(), nestedMap(20))
+}
+
+// Requirements:
+// - Slices count towards the depth.
+// - Nested rule sets can lower the limit.
+// - Panics if n is less than 1.
+// - Serializes to string.
+func TestSliceRuleSet_WithMaxDepth(t *testing.T) {
+	inner := rules.Slice[int]()
+	outer := rules.Slice[[]int]().WithItemRuleSet(inner).WithMaxDepth(2)
+
+	testhelpers.MustApplyAny(t, outer.Any(), [][]int{{1}})
+
+	limited := rules.Slice[[]int]().WithItemRuleSet(rules.Slice[int]().WithMaxDepth(1)).WithMaxDepth(1)
+	testhelpers.MustNotApply(t, limited.Any(), [][]int{{1}}, errors.CodeMax)
+
+	object := rules.StringMap[any]().
+		WithKey("a", rules.Slice[int]().Any()).
+		WithMaxDepth(1)
+	testhelpers.MustNotApply(t, object.Any(), map[string]any{"a": []int{1}}, errors.CodeMax)
+
+	expected := "SliceRuleSet[slice].WithItemRuleSet(SliceRuleSet[int]).WithMaxDepth(2)"
+	if s := outer.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+	rules.Slice[int]().WithMaxDepth(0)
+}
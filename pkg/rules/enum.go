@@ -0,0 +1,269 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"proto.zip/studio/validate/internal/util"
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+)
+
+// EnumRuleSet implements RuleSet for a fixed set of values of a comparable type.
+//
+// Unlike WithAllowedValues on StringRuleSet, the output keeps the named type, which makes it useful for
+// types such as `type Status string` that have a set of constants.
+type EnumRuleSet[T comparable] struct {
+	NoConflict[T]
+	values   []T
+	display  []any // display holds the values with named string types converted to strings so they are quoted.
+	allowed  map[T]struct{}
+	required bool
+	withNil  bool
+	rule     Rule[T]
+	parent   *EnumRuleSet[T]
+	label    string
+}
+
+// Enum creates a new enum rule set that only allows the provided values.
+//
+// Input values are coerced from the underlying kind of T. For example a string can be used as input for
+// a named string type and an integer can be used as input for a named integer type.
+//
+// Values that are not allowed return an errors.CodeNotAllowed error. The allowed values are listed in the "values"
+// metadata of the error, which can be read with errors.MetaOf.
+//
+// This function panics if no values are provided.
+func Enum[T comparable](values ...T) *EnumRuleSet[T] {
+	if len(values) == 0 {
+		panic(fmt.Errorf("at least one enum value is required"))
+	}
+
+	allowed := make(map[T]struct{}, len(values))
+	display := make([]any, len(values))
+	for i, value := range values {
+		allowed[value] = struct{}{}
+
+		if rv := reflect.ValueOf(value); rv.Kind() == reflect.String {
+			display[i] = rv.String()
+		} else {
+			display[i] = value
+		}
+	}
+
+	var empty T
+
+	return &EnumRuleSet[T]{
+		values:  values,
+		display: display,
+		allowed: allowed,
+		label:   util.StringsToRuleOutput(fmt.Sprintf("EnumRuleSet[%T]", empty), display),
+	}
+}
+
+// Required returns a boolean indicating if the value is allowed to be omitted when included in a nested object.
+func (v *EnumRuleSet[T]) Required() bool {
+	return v.required
+}
+
+// WithRequired returns a new child rule set with the required flag set.
+// Use WithRequired when nesting a RuleSet and the a value is not allowed to be omitted.
+func (v *EnumRuleSet[T]) WithRequired() *EnumRuleSet[T] {
+	if v.required {
+		return v
+	}
+
+	return &EnumRuleSet[T]{
+		values:   v.values,
+		display:  v.display,
+		allowed:  v.allowed,
+		required: true,
+		withNil:  v.withNil,
+		parent:   v,
+		label:    "WithRequired()",
+	}
+}
+
+// WithNil returns a new child rule set that allows nil, such as a JSON null, in addition to the enum values. A nil
+// input is not checked against the allowed values and the output is left unchanged. Without WithNil a nil input
+// returns an errors.CodeType error.
+func (v *EnumRuleSet[T]) WithNil() *EnumRuleSet[T] {
+	if v.withNil {
+		return v
+	}
+
+	return &EnumRuleSet[T]{
+		values:   v.values,
+		display:  v.display,
+		allowed:  v.allowed,
+		required: v.required,
+		withNil:  true,
+		parent:   v,
+		label:    "WithNil()",
+	}
+}
+
+// Values returns the allowed values in the order they were provided.
+func (v *EnumRuleSet[T]) Values() []T {
+	return append([]T(nil), v.values...)
+}
+
+// coerce attempts to convert the input into the enum type.
+// Numeric inputs are only converted if no precision is lost.
+func (v *EnumRuleSet[T]) coerce(ctx context.Context, value any) (T, errors.ValidationError) {
+	if t, ok := value.(T); ok {
+		return t, nil
+	}
+
+	var empty T
+	outType := reflect.TypeOf(empty)
+	rv := reflect.ValueOf(value)
+
+	if !rv.IsValid() {
+		return empty, errors.NewCoercionError(ctx, outType.String(), "nil")
+	}
+
+	inType := rv.Type()
+
+	if inType.Kind() == outType.Kind() && inType.ConvertibleTo(outType) {
+		return rv.Convert(outType).Interface().(T), nil
+	}
+
+	if isNumericKind(inType.Kind()) && isNumericKind(outType.Kind()) {
+		converted := rv.Convert(outType)
+
+		// Negative values wrap around when converted to unsigned types.
+		negative := (rv.CanInt() && rv.Int() < 0) || (rv.CanFloat() && rv.Float() < 0)
+		if negative && converted.CanUint() {
+			return empty, errors.NewCoercionError(ctx, outType.String(), inType.String())
+		}
+
+		if converted.Convert(inType).Interface() == rv.Interface() {
+			return converted.Interface().(T), nil
+		}
+	}
+
+	return empty, errors.NewCoercionError(ctx, outType.String(), inType.String())
+}
+
+// isNumericKind returns true for integer and floating point kinds.
+func isNumericKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// Apply performs a validation of a RuleSet against a value and assigns the result to the output parameter.
+// It returns a ValidationErrorCollection if any validation errors occur.
+func (v *EnumRuleSet[T]) Apply(ctx context.Context, input, output any) errors.ValidationErrorCollection {
+	// Nil is only allowed with WithNil
+	if v.withNil && util.IsNil(input) {
+		return nil
+	}
+
+	// Ensure output is a non-nil pointer
+	rv := reflect.ValueOf(output)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.Collection(
+			errors.Errorf(errors.CodeInternal, ctx, "Output must be a non-nil pointer"),
+		)
+	}
+
+	value, validationErr := v.coerce(ctx, input)
+	if validationErr != nil {
+		return errors.Collection(validationErr)
+	}
+
+	errs := v.Evaluate(ctx, value)
+	if errs.HasErrors() {
+		return errs
+	}
+
+	elem := rv.Elem()
+	valueOf := reflect.ValueOf(value)
+
+	if elem.Kind() == reflect.Interface || valueOf.Type().AssignableTo(elem.Type()) {
+		elem.Set(valueOf)
+		return errs
+	}
+
+	return errors.Collection(
+		errors.Errorf(errors.CodeInternal, ctx, "Cannot assign %T to %T", value, output),
+	)
+}
+
+// Evaluate performs a validation of a RuleSet against a value and returns any errors.
+func (v *EnumRuleSet[T]) Evaluate(ctx context.Context, value T) errors.ValidationErrorCollection {
+	ctx = rulecontext.WithRuleSet(ctx, v)
+
+	if _, ok := v.allowed[value]; !ok {
+		err := errors.Errorf(errors.CodeNotAllowed, ctx, "value must be one of %s", util.StringsToRuleOutput("", v.display))
+		return errors.Collection(errors.WithMeta(err, map[string]any{"values": v.Values()}))
+	}
+
+	allErrors := errors.Collection()
+
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if currentRuleSet.rule != nil {
+			if errs := currentRuleSet.rule.Evaluate(ctx, value); errs != nil {
+				allErrors = append(allErrors, errs...)
+			}
+		}
+	}
+
+	if len(allErrors) > 0 {
+		return allErrors
+	}
+	return nil
+}
+
+// WithRule returns a new child rule set with a rule added to the list of
+// rules to evaluate. WithRule takes an implementation of the Rule interface
+// for the enum type.
+//
+// Use this when implementing custom rules.
+func (v *EnumRuleSet[T]) WithRule(rule Rule[T]) *EnumRuleSet[T] {
+	return &EnumRuleSet[T]{
+		values:   v.values,
+		display:  v.display,
+		allowed:  v.allowed,
+		required: v.required,
+		withNil:  v.withNil,
+		rule:     rule,
+		parent:   v,
+	}
+}
+
+// WithRuleFunc returns a new child rule set with a rule added to the list of
+// rules to evaluate. WithRuleFunc takes an implementation of the Rule function
+// for the enum type.
+//
+// Use this when implementing custom rules.
+func (v *EnumRuleSet[T]) WithRuleFunc(rule RuleFunc[T]) *EnumRuleSet[T] {
+	return v.WithRule(rule)
+}
+
+// Any returns a new RuleSet that wraps the enum RuleSet in any Any rule set
+// which can then be used in nested validation.
+func (v *EnumRuleSet[T]) Any() RuleSet[any] {
+	return WrapAny[T](v)
+}
+
+// String returns a string representation of the rule set suitable for debugging.
+func (v *EnumRuleSet[T]) String() string {
+	label := v.label
+
+	if label == "" && v.rule != nil {
+		label = v.rule.String()
+	}
+
+	if v.parent != nil {
+		return v.parent.String() + "." + label
+	}
+	return label
+}
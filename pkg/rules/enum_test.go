@@ -0,0 +1,153 @@
+package rules_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+type testEnumStatus string
+
+const (
+	testEnumStatusActive   testEnumStatus = "active"
+	testEnumStatusInactive testEnumStatus = "inactive"
+)
+
+type testEnumLevel uint8
+
+const (
+	testEnumLevelLow  testEnumLevel = 1
+	testEnumLevelHigh testEnumLevel = 2
+)
+
+// Requirements:
+// - Implements the RuleSet interface.
+// - Only the provided values are allowed.
+// - Returns the value with the named type.
+func TestEnumRuleSet(t *testing.T) {
+	ruleSet := rules.Enum(testEnumStatusActive, testEnumStatusInactive)
+
+	ok := testhelpers.CheckRuleSetInterface[testEnumStatus](ruleSet)
+	if !ok {
+		t.Error("Expected rule set to be implemented")
+	}
+
+	testhelpers.MustApply(t, ruleSet.Any(), testEnumStatusActive)
+	testhelpers.MustNotApply(t, ruleSet.Any(), testEnumStatus("deleted"), errors.CodeNotAllowed)
+
+	testhelpers.MustApplyTypes[testEnumStatus](t, ruleSet, testEnumStatusInactive)
+}
+
+// Requirements:
+// - Errors for values that are not allowed include the allowed values in the metadata.
+func TestEnumMeta(t *testing.T) {
+	ruleSet := rules.Enum(testEnumStatusActive, testEnumStatusInactive)
+
+	errs := ruleSet.Evaluate(context.Background(), testEnumStatus("deleted"))
+	if errs == nil {
+		t.Fatal("Expected errors to not be nil")
+	}
+
+	expected := []testEnumStatus{testEnumStatusActive, testEnumStatusInactive}
+	if values, _ := errors.MetaOf(errs.First())["values"].([]testEnumStatus); !reflect.DeepEqual(values, expected) {
+		t.Errorf("Expected values metadata to be %v, got: %v", expected, errors.MetaOf(errs.First()))
+	}
+}
+
+// Requirements:
+// - Values of the underlying kind are coerced to the named type.
+// - Numeric values are only coerced if no precision is lost.
+// - Values of other kinds return a coercion error.
+func TestEnumCoerce(t *testing.T) {
+	statusRuleSet := rules.Enum(testEnumStatusActive, testEnumStatusInactive)
+
+	var status testEnumStatus
+	if err := statusRuleSet.Apply(context.Background(), "active", &status); err != nil {
+		t.Errorf("Expected errors to be empty, got: %s", err)
+	} else if status != testEnumStatusActive {
+		t.Errorf("Expected status to be %s, got: %s", testEnumStatusActive, status)
+	}
+
+	testhelpers.MustNotApply(t, statusRuleSet.Any(), "deleted", errors.CodeNotAllowed)
+	testhelpers.MustNotApply(t, statusRuleSet.Any(), 1, errors.CodeType)
+	testhelpers.MustNotApply(t, statusRuleSet.Any(), nil, errors.CodeType)
+
+	levelRuleSet := rules.Enum(testEnumLevelLow, testEnumLevelHigh)
+
+	var level testEnumLevel
+	if err := levelRuleSet.Apply(context.Background(), 2.0, &level); err != nil {
+		t.Errorf("Expected errors to be empty, got: %s", err)
+	} else if level != testEnumLevelHigh {
+		t.Errorf("Expected level to be %d, got: %d", testEnumLevelHigh, level)
+	}
+
+	testhelpers.MustNotApply(t, levelRuleSet.Any(), 1.5, errors.CodeType)
+	testhelpers.MustNotApply(t, levelRuleSet.Any(), 257, errors.CodeType)
+	testhelpers.MustNotApply(t, levelRuleSet.Any(), -1, errors.CodeType)
+	testhelpers.MustNotApply(t, levelRuleSet.Any(), 3, errors.CodeNotAllowed)
+	testhelpers.MustNotApply(t, levelRuleSet.Any(), "1", errors.CodeType)
+}
+
+// Requirements:
+// - Custom rules are evaluated.
+// - Required flag is set by WithRequired.
+func TestEnumWithRule(t *testing.T) {
+	ruleSet := rules.Enum(testEnumStatusActive, testEnumStatusInactive).
+		WithRuleFunc(func(ctx context.Context, value testEnumStatus) errors.ValidationErrorCollection {
+			if value == testEnumStatusInactive {
+				return errors.Collection(errors.Errorf(errors.CodeForbidden, ctx, "inactive is not allowed here"))
+			}
+			return nil
+		})
+
+	testhelpers.MustApply(t, ruleSet.Any(), testEnumStatusActive)
+	testhelpers.MustNotApply(t, ruleSet.Any(), testEnumStatusInactive, errors.CodeForbidden)
+
+	if ruleSet.Required() {
+		t.Error("Expected rule set to not be required")
+	}
+	if !ruleSet.WithRequired().Required() {
+		t.Error("Expected rule set to be required")
+	}
+}
+
+// Requirements:
+// - Serializes to EnumRuleSet[T](...)
+// - Panics when no values are provided.
+func TestEnumString(t *testing.T) {
+	ruleSet := rules.Enum(testEnumStatusActive, testEnumStatusInactive).WithRequired()
+
+	expected := `EnumRuleSet[rules_test.testEnumStatus]("active", "inactive").WithRequired()`
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+
+	rules.Enum[testEnumStatus]()
+}
+
+// Requirements:
+// - Rules that only return warnings do not prevent the output from being set.
+// - The warnings are returned.
+func TestEnum_Warning(t *testing.T) {
+	warn := func(ctx context.Context, _ testEnumStatus) errors.ValidationErrorCollection {
+		return errors.Collection(errors.WithSeverity(errors.Errorf(errors.CodeUnknown, ctx, "just a warning"), errors.SeverityWarning))
+	}
+
+	var out testEnumStatus
+	errs := rules.Enum(testEnumStatusActive, testEnumStatusInactive).WithRuleFunc(warn).Apply(context.Background(), testEnumStatusActive, &out)
+	if errs.HasErrors() || len(errs.Warnings()) != 1 {
+		t.Errorf("Expected 1 warning and no errors, got: %s", errs)
+	} else if out != testEnumStatusActive {
+		t.Errorf("Expected output to be set, got: %v", out)
+	}
+}
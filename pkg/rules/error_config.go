@@ -0,0 +1,174 @@
+package rules
+
+import (
+	"fmt"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// mergeErrorConfig returns a new error config with the fields from the existing config and the fields
+// that are set on the update. The existing config is not modified.
+func mergeErrorConfig(existing *errors.ErrorConfig, update errors.ErrorConfig) *errors.ErrorConfig {
+	merged := errors.ErrorConfig{}
+	if existing != nil {
+		merged = *existing
+	}
+
+	if update.Code != "" {
+		merged.Code = update.Code
+	}
+	if update.Message != "" {
+		merged.Message = update.Message
+	}
+	if update.Severity != 0 {
+		merged.Severity = update.Severity
+	}
+	if len(update.Messages) > 0 {
+		messages := make(map[errors.ErrorCode]string, len(merged.Messages)+len(update.Messages))
+		for code, message := range merged.Messages {
+			messages[code] = message
+		}
+		for code, message := range update.Messages {
+			messages[code] = message
+		}
+		merged.Messages = messages
+	}
+
+	return &merged
+}
+
+// withErrorConfig returns a new child rule set with the error config updated.
+func (v *IntRuleSet[T]) withErrorConfig(update errors.ErrorConfig, label string) *IntRuleSet[T] {
+	return &IntRuleSet[T]{
+		strict:      v.strict,
+		strictType:  v.strictType,
+		parent:      v,
+		base:        v.base,
+		required:    v.required,
+		withNil:     v.withNil,
+		errorConfig: mergeErrorConfig(v.errorConfig, update),
+		rounding:    v.rounding,
+		label:       label,
+	}
+}
+
+// WithErrorCode returns a new child rule set that replaces the code of any errors returned by the rules
+// with the provided code.
+//
+// Coercion errors are not affected.
+func (v *IntRuleSet[T]) WithErrorCode(code errors.ErrorCode) *IntRuleSet[T] {
+	return v.withErrorConfig(errors.ErrorConfig{Code: code}, fmt.Sprintf("WithErrorCode(%q)", code))
+}
+
+// WithErrorMessage returns a new child rule set that replaces the message of any errors returned by the rules
+// with the provided message. The message is translated using the printer from the context.
+//
+// Coercion errors are not affected.
+func (v *IntRuleSet[T]) WithErrorMessage(message string) *IntRuleSet[T] {
+	return v.withErrorConfig(errors.ErrorConfig{Message: message}, fmt.Sprintf("WithErrorMessage(%q)", message))
+}
+
+// WithErrorMessages returns a new child rule set that replaces the message of errors returned by the rules
+// based on their error code. This allows each rule to have its own message.
+//
+// The messages are matched against the original error code, before any WithErrorCode override, and are
+// preferred over the message set with WithErrorMessage. Messages for other codes are kept from the parent.
+func (v *IntRuleSet[T]) WithErrorMessages(messages map[errors.ErrorCode]string) *IntRuleSet[T] {
+	return v.withErrorConfig(errors.ErrorConfig{Messages: messages}, fmt.Sprintf("WithErrorMessages(%v)", messages))
+}
+
+// WithSeverity returns a new child rule set that sets the severity of any errors returned by the rules.
+// Use SeverityWarning for advisory checks that should be reported without causing validation to fail.
+//
+// Coercion errors are not affected.
+func (v *IntRuleSet[T]) WithSeverity(severity errors.Severity) *IntRuleSet[T] {
+	return v.withErrorConfig(errors.ErrorConfig{Severity: severity}, fmt.Sprintf("WithSeverity(%s)", severity))
+}
+
+// withErrorConfig returns a new child rule set with the error config updated.
+func (v *FloatRuleSet[T]) withErrorConfig(update errors.ErrorConfig, label string) *FloatRuleSet[T] {
+	return &FloatRuleSet[T]{
+		strict:      v.strict,
+		parent:      v,
+		required:    v.required,
+		withNil:     v.withNil,
+		errorConfig: mergeErrorConfig(v.errorConfig, update),
+		rounding:    v.rounding,
+		precision:   v.precision,
+		label:       label,
+	}
+}
+
+// WithErrorCode returns a new child rule set that replaces the code of any errors returned by the rules
+// with the provided code.
+//
+// Coercion errors are not affected.
+func (v *FloatRuleSet[T]) WithErrorCode(code errors.ErrorCode) *FloatRuleSet[T] {
+	return v.withErrorConfig(errors.ErrorConfig{Code: code}, fmt.Sprintf("WithErrorCode(%q)", code))
+}
+
+// WithErrorMessage returns a new child rule set that replaces the message of any errors returned by the rules
+// with the provided message. The message is translated using the printer from the context.
+//
+// Coercion errors are not affected.
+func (v *FloatRuleSet[T]) WithErrorMessage(message string) *FloatRuleSet[T] {
+	return v.withErrorConfig(errors.ErrorConfig{Message: message}, fmt.Sprintf("WithErrorMessage(%q)", message))
+}
+
+// WithErrorMessages returns a new child rule set that replaces the message of errors returned by the rules
+// based on their error code. This allows each rule to have its own message.
+//
+// The messages are matched against the original error code, before any WithErrorCode override, and are
+// preferred over the message set with WithErrorMessage. Messages for other codes are kept from the parent.
+func (v *FloatRuleSet[T]) WithErrorMessages(messages map[errors.ErrorCode]string) *FloatRuleSet[T] {
+	return v.withErrorConfig(errors.ErrorConfig{Messages: messages}, fmt.Sprintf("WithErrorMessages(%v)", messages))
+}
+
+// WithSeverity returns a new child rule set that sets the severity of any errors returned by the rules.
+// Use SeverityWarning for advisory checks that should be reported without causing validation to fail.
+//
+// Coercion errors are not affected.
+func (v *FloatRuleSet[T]) WithSeverity(severity errors.Severity) *FloatRuleSet[T] {
+	return v.withErrorConfig(errors.ErrorConfig{Severity: severity}, fmt.Sprintf("WithSeverity(%s)", severity))
+}
+
+// withErrorConfig returns a new child rule set with the error config updated.
+func (v *StringRuleSet) withErrorConfig(update errors.ErrorConfig, label string) *StringRuleSet {
+	newRuleSet := v.clone()
+	newRuleSet.errorConfig = mergeErrorConfig(v.errorConfig, update)
+	newRuleSet.label = label
+	return newRuleSet
+}
+
+// WithErrorCode returns a new child rule set that replaces the code of any errors returned by the rules
+// with the provided code.
+//
+// Coercion errors are not affected.
+func (v *StringRuleSet) WithErrorCode(code errors.ErrorCode) *StringRuleSet {
+	return v.withErrorConfig(errors.ErrorConfig{Code: code}, fmt.Sprintf("WithErrorCode(%q)", code))
+}
+
+// WithErrorMessage returns a new child rule set that replaces the message of any errors returned by the rules
+// with the provided message. The message is translated using the printer from the context.
+//
+// Coercion errors are not affected.
+func (v *StringRuleSet) WithErrorMessage(message string) *StringRuleSet {
+	return v.withErrorConfig(errors.ErrorConfig{Message: message}, fmt.Sprintf("WithErrorMessage(%q)", message))
+}
+
+// WithErrorMessages returns a new child rule set that replaces the message of errors returned by the rules
+// based on their error code. This allows each rule to have its own message.
+//
+// The messages are matched against the original error code, before any WithErrorCode override, and are
+// preferred over the message set with WithErrorMessage. Messages for other codes are kept from the parent.
+func (v *StringRuleSet) WithErrorMessages(messages map[errors.ErrorCode]string) *StringRuleSet {
+	return v.withErrorConfig(errors.ErrorConfig{Messages: messages}, fmt.Sprintf("WithErrorMessages(%v)", messages))
+}
+
+// WithSeverity returns a new child rule set that sets the severity of any errors returned by the rules.
+// Use SeverityWarning for advisory checks that should be reported without causing validation to fail.
+//
+// Coercion errors are not affected.
+func (v *StringRuleSet) WithSeverity(severity errors.Severity) *StringRuleSet {
+	return v.withErrorConfig(errors.ErrorConfig{Severity: severity}, fmt.Sprintf("WithSeverity(%s)", severity))
+}
@@ -0,0 +1,216 @@
+package rules_test
+
+import (
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - WithErrorCode replaces the code of rule errors.
+// - The message and path are kept.
+func TestIntRuleSet_WithErrorCode(t *testing.T) {
+	ruleSet := rules.Int().WithMin(5).WithErrorCode("TOO_SMALL")
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), 2, "TOO_SMALL")
+	testhelpers.MustApply(t, ruleSet.Any(), 10)
+
+	errs := rules.Int().WithMin(5).Evaluate(context.Background(), 2)
+	customErrs := ruleSet.Evaluate(context.Background(), 2)
+
+	if len(customErrs) != 1 {
+		t.Fatalf("Expected 1 error, got: %d", len(customErrs))
+	}
+	if customErrs[0].Error() != errs[0].Error() {
+		t.Errorf("Expected message to be %s, got: %s", errs[0].Error(), customErrs[0].Error())
+	}
+}
+
+// Requirements:
+// - WithErrorMessage replaces the message of rule errors.
+// - Coercion errors are not affected.
+func TestFloatRuleSet_WithErrorMessage(t *testing.T) {
+	ruleSet := rules.Float64().WithMax(1.5).WithErrorMessage("too big")
+
+	var out float64
+	errs := ruleSet.Apply(context.Background(), 2.0, &out)
+	if len(errs) != 1 {
+		t.Fatalf("Expected 1 error, got: %d", len(errs))
+	}
+	if errs[0].Error() != "too big" {
+		t.Errorf("Expected message to be %s, got: %s", "too big", errs[0].Error())
+	}
+	if errs[0].Code() != errors.CodeMax {
+		t.Errorf("Expected code to be %s, got: %s", errors.CodeMax, errs[0].Code())
+	}
+
+	errs = ruleSet.Apply(context.Background(), "abc", &out)
+	if len(errs) != 1 || errs[0].Error() == "too big" {
+		t.Errorf("Expected coercion error to be unchanged, got: %s", errs)
+	}
+}
+
+// Requirements:
+// - Code and message can be combined.
+// - The config is kept when more rules are added.
+// - Serializes to string.
+func TestStringRuleSet_WithErrorConfig(t *testing.T) {
+	ruleSet := rules.String().
+		WithErrorCode("INVALID_NAME").
+		WithErrorMessage("name is invalid").
+		WithMinLen(3)
+
+	errs := ruleSet.Evaluate(context.Background(), "ab")
+	if len(errs) != 1 {
+		t.Fatalf("Expected 1 error, got: %d", len(errs))
+	}
+	if errs[0].Code() != "INVALID_NAME" {
+		t.Errorf("Expected code to be %s, got: %s", "INVALID_NAME", errs[0].Code())
+	}
+	if errs[0].Error() != "name is invalid" {
+		t.Errorf("Expected message to be %s, got: %s", "name is invalid", errs[0].Error())
+	}
+
+	expected := `StringRuleSet.WithErrorCode("INVALID_NAME").WithErrorMessage("name is invalid").WithMinLen(3)`
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Each rule can have its own message based on the error code.
+// - Code specific messages are preferred over the general message.
+// - Codes without a message keep the general message.
+// - Messages are merged with the parent.
+func TestStringRuleSet_WithErrorMessages(t *testing.T) {
+	ruleSet := rules.String().
+		WithErrorMessage("invalid").
+		WithErrorMessages(map[errors.ErrorCode]string{errors.CodeMin: "too short"}).
+		WithErrorMessages(map[errors.ErrorCode]string{errors.CodeMax: "too long"}).
+		WithMinLen(2).
+		WithMaxLen(4).
+		WithRejectedValues("bad")
+
+	tests := []struct {
+		value   string
+		message string
+	}{
+		{"a", "too short"},
+		{"abcde", "too long"},
+		{"bad", "invalid"},
+	}
+
+	for _, test := range tests {
+		errs := ruleSet.Evaluate(context.Background(), test.value)
+		if len(errs) != 1 {
+			t.Errorf("Expected 1 error for %s, got: %d", test.value, len(errs))
+			continue
+		}
+		if errs[0].Error() != test.message {
+			t.Errorf("Expected message for %s to be %s, got: %s", test.value, test.message, errs[0].Error())
+		}
+	}
+}
+
+// Requirements:
+// - WithSeverity changes the severity of rule errors.
+// - Output is assigned when only warnings are returned.
+// - Serializes to WithSeverity(warning).
+func TestWithSeverity(t *testing.T) {
+	ruleSet := rules.String().WithMinLen(12).WithSeverity(errors.SeverityWarning)
+
+	var output string
+	err := ruleSet.Apply(context.TODO(), "hunter2", &output)
+
+	if err == nil {
+		t.Fatal("Expected warnings to not be nil")
+	} else if err.HasErrors() {
+		t.Errorf("Expected only warnings, got: %s", err)
+	} else if len(err.Warnings()) != 1 {
+		t.Errorf("Expected 1 warning, got: %d", len(err.Warnings()))
+	}
+
+	if output != "hunter2" {
+		t.Errorf("Expected output to be %q, got: %q", "hunter2", output)
+	}
+
+	expected := "StringRuleSet.WithMinLen(12).WithSeverity(warning)"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+
+	intErr := rules.Int().WithMax(10).WithSeverity(errors.SeverityWarning).Apply(context.TODO(), 11, new(int))
+	if intErr == nil || intErr.HasErrors() {
+		t.Errorf("Expected only warnings, got: %v", intErr)
+	}
+
+	floatErr := rules.Float64().WithMax(10).WithSeverity(errors.SeverityWarning).Apply(context.TODO(), 11.0, new(float64))
+	if floatErr == nil || floatErr.HasErrors() {
+		t.Errorf("Expected only warnings, got: %v", floatErr)
+	}
+}
+
+// Requirements:
+// - Object validation does not fail on warnings.
+// - Values with warnings are set on the output.
+// - Warnings are returned along with errors from other keys.
+func TestWithSeverity_Object(t *testing.T) {
+	weak := func(ctx context.Context, value string) errors.ValidationErrorCollection {
+		if len(value) < 12 {
+			return errors.Collection(errors.WithSeverity(
+				errors.Errorf(errors.CodePattern, ctx, "password is weak"), errors.SeverityWarning,
+			))
+		}
+		return nil
+	}
+
+	ruleSet := rules.StringMap[any]().
+		WithKey("password", rules.String().WithMinLen(6).WithRuleFunc(weak).Any()).
+		WithKey("age", rules.Int().WithMin(18).Any())
+
+	var output map[string]any
+	err := ruleSet.Apply(context.TODO(), map[string]any{"password": "hunter2", "age": 20}, &output)
+
+	if err == nil {
+		t.Fatal("Expected warnings to not be nil")
+	} else if err.HasErrors() {
+		t.Fatalf("Expected only warnings, got: %s", err)
+	} else if path := err.First().Path(); path != "/password" {
+		t.Errorf("Expected warning path to be /password, got: %s", path)
+	}
+
+	if output["password"] != "hunter2" || output["age"] != 20 {
+		t.Errorf("Expected output to be set, got: %v", output)
+	}
+
+	err = ruleSet.Apply(context.TODO(), map[string]any{"password": "hunter2", "age": 16}, &output)
+	if !err.HasErrors() {
+		t.Fatal("Expected errors")
+	} else if len(err.Errors()) != 1 || len(err.Warnings()) != 1 {
+		t.Errorf("Expected 1 error and 1 warning, got: %d and %d", len(err.Errors()), len(err.Warnings()))
+	}
+}
+
+// Requirements:
+// - Combinators treat warnings as passing.
+func TestWithSeverity_Combinators(t *testing.T) {
+	warn := rules.String().WithMaxLen(3).WithSeverity(errors.SeverityWarning)
+
+	var output string
+	if err := rules.OneOf[string](warn).Apply(context.TODO(), "abcd", &output); err.HasErrors() {
+		t.Errorf("Expected OneOf to pass, got: %s", err)
+	} else if output != "abcd" {
+		t.Errorf("Expected output to be %q, got: %q", "abcd", output)
+	}
+
+	if err := rules.AllOf[string](warn, rules.String()).Apply(context.TODO(), "abcd", &output); err.HasErrors() {
+		t.Errorf("Expected AllOf to pass, got: %s", err)
+	} else if len(err.Warnings()) != 1 {
+		t.Errorf("Expected 1 warning, got: %d", len(err.Warnings()))
+	}
+
+	testhelpers.MustNotApply(t, rules.Not[string](warn, errors.CodeForbidden).Any(), "abcd", errors.CodeForbidden)
+}
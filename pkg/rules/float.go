@@ -5,7 +5,9 @@ import (
 	"math"
 	"reflect"
 
+	"proto.zip/studio/validate/internal/util"
 	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
 )
 
 var baseFloat32 FloatRuleSet[float32] = FloatRuleSet[float32]{
@@ -21,15 +23,25 @@ type floating interface {
 }
 
 // Implementation of RuleSet for floats.
+//
+// Unless the rule set is strict, string inputs such as query string and form values are parsed using
+// strconv.ParseFloat. Signs, leading zeros and exponents are allowed but whitespace is not. Values that are too
+// large for the type return a range error.
+//
+// A json.Number, such as from WithJsonOptions with UseNumber, is accepted even in strict mode.
 type FloatRuleSet[T floating] struct {
 	NoConflict[T]
-	strict    bool
-	rule      Rule[T]
-	required  bool
-	parent    *FloatRuleSet[T]
-	rounding  Rounding
-	precision int
-	label     string
+	strict      bool
+	rule        Rule[T]
+	required    bool
+	withNil     bool
+	errorConfig *errors.ErrorConfig
+	parent      *FloatRuleSet[T]
+	rounding    Rounding
+	precision   int
+	clampMin    *T
+	clampMax    *T
+	label       string
 }
 
 // Float32 creates a new float32 RuleSet.
@@ -49,12 +61,14 @@ func Float64() *FloatRuleSet[float64] {
 // deterministically and without loss.
 func (v *FloatRuleSet[T]) WithStrict() *FloatRuleSet[T] {
 	return &FloatRuleSet[T]{
-		strict:    true,
-		parent:    v,
-		required:  v.required,
-		rounding:  v.rounding,
-		precision: v.precision,
-		label:     "WithStrict()",
+		strict:      true,
+		parent:      v,
+		required:    v.required,
+		withNil:     v.withNil,
+		errorConfig: v.errorConfig,
+		rounding:    v.rounding,
+		precision:   v.precision,
+		label:       "WithStrict()",
 	}
 }
 
@@ -67,18 +81,45 @@ func (v *FloatRuleSet[T]) Required() bool {
 // Use WithRequired when nesting a RuleSet and the a value is not allowed to be omitted.
 func (v *FloatRuleSet[T]) WithRequired() *FloatRuleSet[T] {
 	return &FloatRuleSet[T]{
-		strict:    v.strict,
-		parent:    v,
-		required:  true,
-		rounding:  v.rounding,
-		precision: v.precision,
-		label:     "WithRequired()",
+		strict:      v.strict,
+		parent:      v,
+		required:    true,
+		withNil:     v.withNil,
+		errorConfig: v.errorConfig,
+		rounding:    v.rounding,
+		precision:   v.precision,
+		label:       "WithRequired()",
+	}
+}
+
+// WithNil returns a new child rule set that accepts a nil input, such as a JSON null or a nil pointer, for optional
+// numbers. The range rules are not evaluated and the output is left unchanged. Without WithNil a nil input returns
+// an errors.CodeType error.
+func (v *FloatRuleSet[T]) WithNil() *FloatRuleSet[T] {
+	if v.withNil {
+		return v
+	}
+
+	return &FloatRuleSet[T]{
+		strict:      v.strict,
+		parent:      v,
+		required:    v.required,
+		withNil:     true,
+		errorConfig: v.errorConfig,
+		rounding:    v.rounding,
+		precision:   v.precision,
+		label:       "WithNil()",
 	}
 }
 
 // Apply performs a validation of a RuleSet against a value and assigns the result to the output parameter.
 // It returns a ValidationErrorCollection if any validation errors occur.
 func (v *FloatRuleSet[T]) Apply(ctx context.Context, input any, output any) errors.ValidationErrorCollection {
+	// Nil is only allowed with WithNil
+	if v.withNil && util.IsNil(input) {
+		return nil
+	}
+
 	// Ensure output is a non-nil pointer
 	outputVal := reflect.ValueOf(output)
 	if outputVal.Kind() != reflect.Ptr || outputVal.IsNil() {
@@ -113,6 +154,8 @@ func (v *FloatRuleSet[T]) Apply(ctx context.Context, input any, output any) erro
 		floatval = T(tempFloatval)
 	}
 
+	floatval = v.clamp(floatval)
+
 	// Handle setting the value in output
 	outputElem := outputVal.Elem()
 
@@ -135,17 +178,21 @@ func (v *FloatRuleSet[T]) Apply(ctx context.Context, input any, output any) erro
 	}
 
 	allErrors := errors.Collection()
+	failFast := rulecontext.FailFast(ctx)
 
 	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
 		if currentRuleSet.rule != nil {
 			if err := currentRuleSet.rule.Evaluate(ctx, floatval); err != nil {
-				allErrors = append(allErrors, err...)
+				allErrors = appendErrors(allErrors, err)
+				if failFast && err.HasErrors() {
+					break
+				}
 			}
 		}
 	}
 
 	if len(allErrors) != 0 {
-		return allErrors
+		return errors.WithErrorConfig(ctx, allErrors, v.errorConfig)
 	}
 	return nil
 }
@@ -180,13 +227,17 @@ func (ruleSet *FloatRuleSet[T]) noConflict(rule Rule[T]) *FloatRuleSet[T] {
 	}
 
 	return &FloatRuleSet[T]{
-		strict:    ruleSet.strict,
-		rule:      ruleSet.rule,
-		required:  ruleSet.required,
-		parent:    newParent,
-		rounding:  ruleSet.rounding,
-		precision: ruleSet.precision,
-		label:     ruleSet.label,
+		strict:      ruleSet.strict,
+		rule:        ruleSet.rule,
+		required:    ruleSet.required,
+		withNil:     ruleSet.withNil,
+		errorConfig: ruleSet.errorConfig,
+		parent:      newParent,
+		rounding:    ruleSet.rounding,
+		precision:   ruleSet.precision,
+		label:       ruleSet.label,
+		clampMin:    ruleSet.clampMin,
+		clampMax:    ruleSet.clampMax,
 	}
 }
 
@@ -197,12 +248,14 @@ func (ruleSet *FloatRuleSet[T]) noConflict(rule Rule[T]) *FloatRuleSet[T] {
 // Use this when implementing custom rules.
 func (ruleSet *FloatRuleSet[T]) WithRule(rule Rule[T]) *FloatRuleSet[T] {
 	return &FloatRuleSet[T]{
-		strict:    ruleSet.strict,
-		parent:    ruleSet.noConflict(rule),
-		rule:      rule,
-		required:  true,
-		rounding:  ruleSet.rounding,
-		precision: ruleSet.precision,
+		strict:      ruleSet.strict,
+		parent:      ruleSet.noConflict(rule),
+		rule:        rule,
+		required:    true,
+		withNil:     ruleSet.withNil,
+		errorConfig: ruleSet.errorConfig,
+		rounding:    ruleSet.rounding,
+		precision:   ruleSet.precision,
 	}
 }
 
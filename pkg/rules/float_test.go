@@ -160,3 +160,26 @@ func TestFloat_Evaluate(t *testing.T) {
 	testhelpers.MustEvaluate[float64](t, ruleSet, 10)
 	testhelpers.MustNotEvaluate[float64](t, ruleSet, 1, errors.CodeMin)
 }
+
+// Requirements:
+// - Numeric strings are parsed.
+// - Signs, leading zeros and exponents are allowed.
+// - Whitespace and non-numeric strings are rejected.
+// - Overflow returns a range error rather than panicking.
+// - Strict rule sets do not parse strings.
+func TestFloatCoercionFromStringEdgeCases(t *testing.T) {
+	ruleSet := rules.Float32().Any()
+
+	testhelpers.MustApplyMutation(t, ruleSet, "007.5", float32(7.5))
+	testhelpers.MustApplyMutation(t, ruleSet, "+1.5", float32(1.5))
+	testhelpers.MustApplyMutation(t, ruleSet, "-1.5", float32(-1.5))
+	testhelpers.MustApplyMutation(t, ruleSet, "1e3", float32(1000))
+
+	testhelpers.MustNotApply(t, ruleSet, " 1.5", errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet, "1.5\t", errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet, "1.5abc", errors.CodeType)
+
+	testhelpers.MustNotApply(t, ruleSet, "1e39", errors.CodeRange)
+
+	testhelpers.MustNotApply(t, rules.Float32().WithStrict().Any(), "1.5", errors.CodeType)
+}
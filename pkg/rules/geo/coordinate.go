@@ -0,0 +1,349 @@
+package geo
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"proto.zip/studio/validate/internal/util"
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// LatLng is a latitude and longitude pair in decimal degrees.
+type LatLng struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// Path segments used for the latitude and longitude errors.
+const (
+	latKey = "lat"
+	lngKey = "lng"
+)
+
+// baseLat and baseLng are the component rule sets used by every coordinate rule set.
+// Rules are evaluated newest first so the finite rule is added last to report NaN and infinity before the range.
+var baseLat = rules.Float64().WithMin(-90).WithMax(90).WithRule(&finiteRule{})
+var baseLng = rules.Float64().WithMin(-180).WithMax(180).WithRule(&finiteRule{})
+
+// baseCoordinateRuleSet is the base coordinate rule set. Since rule sets are immutable.
+var baseCoordinateRuleSet CoordinateRuleSet = CoordinateRuleSet{
+	lat:   baseLat,
+	lng:   baseLng,
+	label: "CoordinateRuleSet",
+}
+
+// CoordinateRuleSet implements the RuleSet interface for latitude and longitude pairs.
+//
+// Latitude must be between -90 and 90 and longitude must be between -180 and 180. Errors are reported at the
+// "lat" or "lng" path below the coordinate, for example "/coord/lat".
+type CoordinateRuleSet struct {
+	rules.NoConflict[LatLng]
+	required  bool
+	withNil   bool
+	precision int
+	lat       *rules.FloatRuleSet[float64]
+	lng       *rules.FloatRuleSet[float64]
+	parent    *CoordinateRuleSet
+	rule      rules.Rule[LatLng]
+	label     string
+}
+
+// Coordinate returns the base coordinate RuleSet.
+func Coordinate() *CoordinateRuleSet {
+	return &baseCoordinateRuleSet
+}
+
+// withParent returns a new child rule set with the flags copied from the current rule set.
+func (ruleSet *CoordinateRuleSet) withParent() *CoordinateRuleSet {
+	return &CoordinateRuleSet{
+		required:  ruleSet.required,
+		withNil:   ruleSet.withNil,
+		precision: ruleSet.precision,
+		lat:       ruleSet.lat,
+		lng:       ruleSet.lng,
+		parent:    ruleSet,
+	}
+}
+
+// Required returns a boolean indicating if the value is allowed to be omitted when included in a nested object.
+func (ruleSet *CoordinateRuleSet) Required() bool {
+	return ruleSet.required
+}
+
+// WithRequired returns a new rule set with the required flag set.
+// Use WithRequired when nesting a RuleSet and the a value is not allowed to be omitted.
+func (ruleSet *CoordinateRuleSet) WithRequired() *CoordinateRuleSet {
+	if ruleSet.required {
+		return ruleSet
+	}
+
+	newRuleSet := ruleSet.withParent()
+	newRuleSet.required = true
+	newRuleSet.label = "WithRequired()"
+	return newRuleSet
+}
+
+// WithNil returns a new child rule set that accepts a missing location given as a nil input, such as a JSON null or
+// a nil *LatLng. The output is left unchanged. By default nil returns an errors.CodeType error.
+func (ruleSet *CoordinateRuleSet) WithNil() *CoordinateRuleSet {
+	if ruleSet.withNil {
+		return ruleSet
+	}
+
+	newRuleSet := ruleSet.withParent()
+	newRuleSet.withNil = true
+	newRuleSet.label = "WithNil()"
+	return newRuleSet
+}
+
+// WithPrecision returns a new rule set that allows at most n decimal places for both latitude and longitude.
+// Six decimal places is roughly 10cm at the equator.
+//
+// This method panics if n is negative.
+func (ruleSet *CoordinateRuleSet) WithPrecision(n int) *CoordinateRuleSet {
+	if n < 0 {
+		panic(fmt.Errorf("precision must not be negative, got: %d", n))
+	}
+
+	newRuleSet := ruleSet.withParent()
+	newRuleSet.precision = n
+	newRuleSet.lat = baseLat.WithRule(&precisionRule{n})
+	newRuleSet.lng = baseLng.WithRule(&precisionRule{n})
+	newRuleSet.label = fmt.Sprintf("WithPrecision(%d)", n)
+	return newRuleSet
+}
+
+// components returns the raw latitude and longitude values from the input.
+//
+// Supported inputs are LatLng, *LatLng, arrays or slices of length 2 in [lat, lng] order, maps with
+// "lat" and "lng" keys and structs with Lat and Lng fields.
+func components(ctx context.Context, input any) (lat, lng any, err errors.ValidationError) {
+	switch x := input.(type) {
+	case LatLng:
+		return x.Lat, x.Lng, nil
+	case *LatLng:
+		if x != nil {
+			return x.Lat, x.Lng, nil
+		}
+	}
+
+	rv := reflect.ValueOf(input)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Array, reflect.Slice:
+		if rv.Len() == 2 {
+			return rv.Index(0).Interface(), rv.Index(1).Interface(), nil
+		}
+		return nil, nil, errors.Errorf(errors.CodeType, ctx, "coordinate must have exactly 2 values, got: %d", rv.Len())
+	case reflect.Map:
+		if rv.Type().Key().Kind() == reflect.String {
+			latVal := rv.MapIndex(reflect.ValueOf(latKey).Convert(rv.Type().Key()))
+			lngVal := rv.MapIndex(reflect.ValueOf(lngKey).Convert(rv.Type().Key()))
+			if latVal.IsValid() && lngVal.IsValid() {
+				return latVal.Interface(), lngVal.Interface(), nil
+			}
+			return nil, nil, errors.Errorf(errors.CodeType, ctx, "coordinate must have %q and %q keys", latKey, lngKey)
+		}
+	case reflect.Struct:
+		latVal := rv.FieldByName("Lat")
+		lngVal := rv.FieldByName("Lng")
+		if latVal.IsValid() && lngVal.IsValid() && latVal.CanInterface() && lngVal.CanInterface() {
+			return latVal.Interface(), lngVal.Interface(), nil
+		}
+	case reflect.Invalid, reflect.Ptr:
+		return nil, nil, errors.NewCoercionError(ctx, "coordinate", "nil")
+	}
+
+	return nil, nil, errors.NewCoercionError(ctx, "coordinate", rv.Type().String())
+}
+
+// Apply performs a validation of a RuleSet against a value and assigns the result to the output parameter.
+// It returns a ValidationErrorCollection if any validation errors occur.
+//
+// The output may be a *LatLng, a *[2]float64 in [lat, lng] order or a pointer to an interface.
+func (ruleSet *CoordinateRuleSet) Apply(ctx context.Context, input any, output any) errors.ValidationErrorCollection {
+	// Nil is only allowed with WithNil
+	if ruleSet.withNil && util.IsNil(input) {
+		return nil
+	}
+
+	// Ensure output is a non-nil pointer
+	outputVal := reflect.ValueOf(output)
+	if outputVal.Kind() != reflect.Ptr || outputVal.IsNil() {
+		return errors.Collection(errors.Errorf(
+			errors.CodeInternal, ctx, "Output must be a non-nil pointer",
+		))
+	}
+
+	rawLat, rawLng, err := components(ctx, input)
+	if err != nil {
+		return errors.Collection(err)
+	}
+
+	var value LatLng
+	allErrors := errors.Collection()
+
+	allErrors = append(allErrors, ruleSet.lat.Apply(rulecontext.WithPathString(ctx, latKey), rawLat, &value.Lat)...)
+	allErrors = append(allErrors, ruleSet.lng.Apply(rulecontext.WithPathString(ctx, lngKey), rawLng, &value.Lng)...)
+
+	if len(allErrors) > 0 {
+		return allErrors
+	}
+
+	if errs := ruleSet.evaluateRules(ctx, value); errs != nil {
+		return errs
+	}
+
+	switch out := output.(type) {
+	case *LatLng:
+		*out = value
+		return nil
+	case *[2]float64:
+		*out = [2]float64{value.Lat, value.Lng}
+		return nil
+	}
+
+	outputElem := outputVal.Elem()
+
+	if outputElem.Kind() == reflect.Interface && outputElem.IsNil() {
+		outputElem.Set(reflect.ValueOf(value))
+		return nil
+	}
+
+	return errors.Collection(errors.Errorf(
+		errors.CodeInternal, ctx, "Cannot assign %T to %T", value, output,
+	))
+}
+
+// Evaluate performs a validation of a RuleSet against a coordinate and returns a ValidationErrorCollection.
+func (ruleSet *CoordinateRuleSet) Evaluate(ctx context.Context, value LatLng) errors.ValidationErrorCollection {
+	allErrors := errors.Collection()
+
+	allErrors = append(allErrors, ruleSet.lat.Evaluate(rulecontext.WithPathString(ctx, latKey), value.Lat)...)
+	allErrors = append(allErrors, ruleSet.lng.Evaluate(rulecontext.WithPathString(ctx, lngKey), value.Lng)...)
+
+	if len(allErrors) > 0 {
+		return allErrors
+	}
+
+	return ruleSet.evaluateRules(ctx, value)
+}
+
+// evaluateRules evaluates the custom rules once the latitude and longitude are known to be valid.
+func (ruleSet *CoordinateRuleSet) evaluateRules(ctx context.Context, value LatLng) errors.ValidationErrorCollection {
+	allErrors := errors.Collection()
+
+	currentRuleSet := ruleSet
+	ctx = rulecontext.WithRuleSet(ctx, ruleSet)
+
+	for currentRuleSet != nil {
+		if currentRuleSet.rule != nil {
+			if errs := currentRuleSet.rule.Evaluate(ctx, value); errs != nil {
+				allErrors = append(allErrors, errs...)
+			}
+		}
+
+		currentRuleSet = currentRuleSet.parent
+	}
+
+	if len(allErrors) > 0 {
+		return allErrors
+	} else {
+		return nil
+	}
+}
+
+// noConflict returns the new array rule set with all conflicting rules removed.
+// Does not mutate the existing rule sets.
+func (ruleSet *CoordinateRuleSet) noConflict(rule rules.Rule[LatLng]) *CoordinateRuleSet {
+	if ruleSet.rule != nil {
+
+		// Conflicting rules, skip this and return the parent
+		if rule.Conflict(ruleSet.rule) {
+			return ruleSet.parent.noConflict(rule)
+		}
+
+	}
+
+	if ruleSet.parent == nil {
+		return ruleSet
+	}
+
+	newParent := ruleSet.parent.noConflict(rule)
+
+	if newParent == ruleSet.parent {
+		return ruleSet
+	}
+
+	newRuleSet := newParent.withParent()
+	newRuleSet.required = ruleSet.required
+	newRuleSet.withNil = ruleSet.withNil
+	newRuleSet.precision = ruleSet.precision
+	newRuleSet.lat = ruleSet.lat
+	newRuleSet.lng = ruleSet.lng
+	newRuleSet.rule = ruleSet.rule
+	newRuleSet.label = ruleSet.label
+	return newRuleSet
+}
+
+// WithRule returns a new child rule set with a rule added to the list of
+// rules to evaluate. WithRule takes an implementation of the Rule interface
+// for the LatLng type.
+//
+// Custom rules are only evaluated if the latitude and longitude are valid.
+//
+// Use this when implementing custom rules.
+func (ruleSet *CoordinateRuleSet) WithRule(rule rules.Rule[LatLng]) *CoordinateRuleSet {
+	newRuleSet := ruleSet.noConflict(rule).withParent()
+	newRuleSet.rule = rule
+	return newRuleSet
+}
+
+// WithRuleFunc returns a new child rule set with a rule added to the list of
+// rules to evaluate. WithRuleFunc takes an implementation of the Rule interface
+// for the LatLng type.
+//
+// Use this when implementing custom rules.
+func (v *CoordinateRuleSet) WithRuleFunc(rule rules.RuleFunc[LatLng]) *CoordinateRuleSet {
+	return v.WithRule(rule)
+}
+
+// Any returns a new RuleSet that wraps the coordinate RuleSet in any Any rule set
+// which can then be used in nested validation.
+func (ruleSet *CoordinateRuleSet) Any() rules.RuleSet[any] {
+	return rules.WrapAny[LatLng](ruleSet)
+}
+
+// JSONSchema returns an object schema with the latitude and longitude properties.
+func (ruleSet *CoordinateRuleSet) JSONSchema() rules.JSONSchema {
+	return rules.JSONSchema{
+		"type": "object",
+		"properties": map[string]rules.JSONSchema{
+			latKey: rules.JSONSchemaFor(ruleSet.lat),
+			lngKey: rules.JSONSchemaFor(ruleSet.lng),
+		},
+		"required": []string{latKey, lngKey},
+	}
+}
+
+// String returns a string representation of the rule set suitable for debugging.
+func (ruleSet *CoordinateRuleSet) String() string {
+	label := ruleSet.label
+
+	if label == "" {
+		if ruleSet.rule != nil {
+			label = ruleSet.rule.String()
+		}
+	}
+
+	if ruleSet.parent != nil {
+		return ruleSet.parent.String() + "." + label
+	}
+	return label
+}
@@ -0,0 +1,65 @@
+package geo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// Implements the Rule interface for finite numbers.
+// NaN is never less than or greater than a bound so it would otherwise pass the range rules.
+type finiteRule struct {
+	rules.NoConflict[float64]
+}
+
+// Evaluate takes a context and float value and returns an error if it is NaN or infinite.
+func (rule *finiteRule) Evaluate(ctx context.Context, value float64) errors.ValidationErrorCollection {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return errors.Collection(
+			errors.Errorf(errors.CodeRange, ctx, "field must be a finite number"),
+		)
+	}
+
+	return nil
+}
+
+// String returns the string representation of the finite rule.
+func (rule *finiteRule) String() string {
+	return "WithFinite()"
+}
+
+// Implements the Rule interface for the maximum number of decimal places.
+type precisionRule struct {
+	precision int
+}
+
+// Evaluate takes a context and float value and returns an error if it has more decimal places than allowed.
+// The shortest representation of the value is used so 0.1 has one decimal place.
+func (rule *precisionRule) Evaluate(ctx context.Context, value float64) errors.ValidationErrorCollection {
+	str := strconv.FormatFloat(value, 'f', -1, 64)
+
+	if i := strings.IndexByte(str, '.'); i >= 0 && len(str)-i-1 > rule.precision {
+		return errors.Collection(
+			errors.Errorf(errors.CodePattern, ctx, "field must have at most %d decimal places", rule.precision),
+		)
+	}
+
+	return nil
+}
+
+// Conflict returns true for any precision rule.
+func (rule *precisionRule) Conflict(x rules.Rule[float64]) bool {
+	_, ok := x.(*precisionRule)
+	return ok
+}
+
+// String returns the string representation of the precision rule.
+// Example: WithPrecision(6)
+func (rule *precisionRule) String() string {
+	return fmt.Sprintf("WithPrecision(%d)", rule.precision)
+}
@@ -0,0 +1,193 @@
+package geo_test
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/rules/geo"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Default configuration doesn't return errors on valid value.
+// - Implements interface.
+func TestCoordinateRuleSet(t *testing.T) {
+	coord := geo.LatLng{Lat: 51.5007, Lng: -0.1246}
+
+	var output geo.LatLng
+
+	err := geo.Coordinate().Apply(context.TODO(), coord, &output)
+	if err != nil {
+		t.Fatalf("Expected errors to be empty, got: %s", err)
+	}
+
+	if output != coord {
+		t.Fatalf("Expected output to be %v, got: %v", coord, output)
+	}
+
+	ok := testhelpers.CheckRuleSetInterface[geo.LatLng](geo.Coordinate())
+	if !ok {
+		t.Fatal("Expected rule set to be implemented")
+	}
+
+	testhelpers.MustApplyTypes[geo.LatLng](t, geo.Coordinate(), coord)
+}
+
+// Requirements:
+// - Arrays, slices, maps and structs with Lat and Lng are accepted.
+// - Component values are coerced like FloatRuleSet.
+// - Other types return CodeType.
+func TestCoordinateRuleSet_Inputs(t *testing.T) {
+	ruleSet := geo.Coordinate().Any()
+	expected := geo.LatLng{Lat: 10, Lng: 20}
+
+	type point struct {
+		Lat float32
+		Lng int
+	}
+
+	testhelpers.MustApplyMutation(t, ruleSet, &expected, expected)
+	testhelpers.MustApplyMutation(t, ruleSet, [2]float64{10, 20}, expected)
+	testhelpers.MustApplyMutation(t, ruleSet, []any{10, "20"}, expected)
+	testhelpers.MustApplyMutation(t, ruleSet, map[string]any{"lat": 10.0, "lng": 20.0}, expected)
+	testhelpers.MustApplyMutation(t, ruleSet, point{Lat: 10, Lng: 20}, expected)
+
+	testhelpers.MustNotApply(t, ruleSet, []float64{10}, errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet, map[string]any{"lat": 10.0}, errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet, "10,20", errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet, nil, errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet, []any{"north", 20}, errors.CodeType)
+}
+
+// Requirements:
+// - Latitude must be in [-90, 90].
+// - Longitude must be in [-180, 180].
+// - NaN and infinity are rejected.
+func TestCoordinateRuleSet_Range(t *testing.T) {
+	ruleSet := geo.Coordinate().Any()
+
+	testhelpers.MustApplyAny(t, ruleSet, [2]float64{90, 180})
+	testhelpers.MustApplyAny(t, ruleSet, [2]float64{-90, -180})
+
+	testhelpers.MustNotApply(t, ruleSet, [2]float64{90.1, 0}, errors.CodeMax)
+	testhelpers.MustNotApply(t, ruleSet, [2]float64{-90.1, 0}, errors.CodeMin)
+	testhelpers.MustNotApply(t, ruleSet, [2]float64{0, 180.1}, errors.CodeMax)
+	testhelpers.MustNotApply(t, ruleSet, [2]float64{0, -180.1}, errors.CodeMin)
+	testhelpers.MustNotApply(t, ruleSet, [2]float64{math.NaN(), 0}, errors.CodeRange)
+	testhelpers.MustNotApply(t, ruleSet, [2]float64{0, math.Inf(1)}, errors.CodeRange)
+
+	err := geo.Coordinate().Evaluate(context.TODO(), geo.LatLng{Lat: 100, Lng: 200})
+	if len(err) != 2 {
+		t.Errorf("Expected 2 errors, got: %d", len(err))
+	}
+}
+
+// Requirements:
+// - Errors are reported at the component path.
+func TestCoordinateRuleSet_Path(t *testing.T) {
+	ruleSet := rules.StringMap[any]().WithKey("coord", geo.Coordinate().Any())
+
+	var output map[string]any
+	err := ruleSet.Apply(context.TODO(), map[string]any{"coord": [2]float64{0, 181}}, &output)
+
+	if err == nil {
+		t.Fatal("Expected error to not be nil")
+	} else if path := err.First().Path(); path != "/coord/lng" {
+		t.Errorf("Expected error path to be %s, got: %s", "/coord/lng", path)
+	}
+}
+
+// Requirements:
+// - Values with more decimal places than allowed return CodePattern.
+// - Most recent precision is used.
+func TestCoordinateRuleSet_WithPrecision(t *testing.T) {
+	ruleSet := geo.Coordinate().WithPrecision(2)
+
+	testhelpers.MustApplyAny(t, ruleSet.Any(), [2]float64{1.25, -3.5})
+	testhelpers.MustNotApply(t, ruleSet.Any(), [2]float64{1.255, 0}, errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), [2]float64{0, 0.001}, errors.CodePattern)
+
+	ruleSet = ruleSet.WithPrecision(3)
+	testhelpers.MustApplyAny(t, ruleSet.Any(), [2]float64{1.255, 0.001})
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic")
+		}
+	}()
+
+	geo.Coordinate().WithPrecision(-1)
+}
+
+// Requirements:
+// - Output can be a [2]float64.
+func TestCoordinateRuleSet_Output(t *testing.T) {
+	var output [2]float64
+	if err := geo.Coordinate().Apply(context.TODO(), geo.LatLng{Lat: 1, Lng: 2}, &output); err != nil {
+		t.Fatalf("Expected error to be nil, got: %s", err)
+	} else if output != [2]float64{1, 2} {
+		t.Errorf("Expected output to be %v, got: %v", [2]float64{1, 2}, output)
+	}
+}
+
+// Requirements:
+// - Required flag can be set.
+// - Required flag can be read.
+// - Required flag defaults to false.
+func TestCoordinateRuleSet_WithRequired(t *testing.T) {
+	ruleSet := geo.Coordinate()
+
+	if ruleSet.Required() {
+		t.Error("Expected rule set to not be required")
+	}
+
+	ruleSet = ruleSet.WithRequired()
+
+	if !ruleSet.Required() {
+		t.Error("Expected rule set to be required")
+	}
+}
+
+// Requirements:
+// - Custom rules are evaluated.
+// - Flags are kept when rules are added.
+func TestCoordinateRuleSet_WithRuleFunc(t *testing.T) {
+	ruleSet := geo.Coordinate().WithRequired().WithPrecision(1).
+		WithRuleFunc(testhelpers.NewMockRuleWithErrors[geo.LatLng](1).Function())
+
+	if !ruleSet.Required() {
+		t.Error("Expected rule set to be required")
+	}
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), [2]float64{1, 2}, errors.CodeUnknown)
+	testhelpers.MustNotApply(t, ruleSet.Any(), [2]float64{1.25, 2}, errors.CodePattern)
+}
+
+// Requirements:
+// - Serializes to CoordinateRuleSet.
+func TestCoordinateRuleSet_String(t *testing.T) {
+	ruleSet := geo.Coordinate().WithRequired().WithPrecision(6)
+
+	expected := "CoordinateRuleSet.WithRequired().WithPrecision(6)"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - JSON Schema describes an object with lat and lng.
+func TestCoordinateRuleSet_JSONSchema(t *testing.T) {
+	schema, err := json.Marshal(rules.JSONSchemaFor(geo.Coordinate()))
+	if err != nil {
+		t.Fatalf("Expected error to be nil, got: %s", err)
+	}
+
+	expected := `{"properties":{"lat":{"description":"WithFinite()","maximum":90,"minimum":-90,"type":"number"},"lng":{"description":"WithFinite()","maximum":180,"minimum":-180,"type":"number"}},"required":["lat","lng"],"type":"object"}`
+	if string(schema) != expected {
+		t.Errorf("Expected schema to be %s, got: %s", expected, schema)
+	}
+}
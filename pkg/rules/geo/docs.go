@@ -0,0 +1,2 @@
+// Package geo provides RuleSet implementations for geographic values.
+package geo
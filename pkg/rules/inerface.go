@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"proto.zip/studio/validate/internal/util"
 	"proto.zip/studio/validate/pkg/errors"
 	"proto.zip/studio/validate/pkg/rulecontext"
 )
@@ -13,6 +14,7 @@ import (
 type InterfaceRuleSet[T any] struct {
 	NoConflict[T]
 	required bool
+	withNil  bool
 	rule     Rule[T]
 	parent   *InterfaceRuleSet[T]
 	label    string
@@ -41,6 +43,7 @@ func Interface[T any]() *InterfaceRuleSet[T] {
 func (v *InterfaceRuleSet[T]) WithCast(fn func(ctx context.Context, value any) (T, errors.ValidationErrorCollection)) *InterfaceRuleSet[T] {
 	return &InterfaceRuleSet[T]{
 		required: v.required,
+		withNil:  v.withNil,
 		parent:   v,
 		cast:     fn,
 		label:    "WithCast(...)",
@@ -61,14 +64,36 @@ func (v *InterfaceRuleSet[T]) WithRequired() *InterfaceRuleSet[T] {
 
 	return &InterfaceRuleSet[T]{
 		required: true,
+		withNil:  v.withNil,
 		parent:   v,
 		label:    "WithRequired()",
 	}
 }
 
+// WithNil returns a new child rule set that skips the rules for a nil input and leaves the output unchanged.
+// Without WithNil an untyped nil returns an errors.CodeType error, while a nil pointer that implements T is passed
+// to the rules and assigned to the output.
+func (v *InterfaceRuleSet[T]) WithNil() *InterfaceRuleSet[T] {
+	if v.withNil {
+		return v
+	}
+
+	return &InterfaceRuleSet[T]{
+		required: v.required,
+		withNil:  true,
+		parent:   v,
+		label:    "WithNil()",
+	}
+}
+
 // Apply performs a validation of a RuleSet against a value and assigns the result to the output parameter.
 // It returns a ValidationErrorCollection if any validation errors occur.
 func (ruleSet *InterfaceRuleSet[T]) Apply(ctx context.Context, input any, output any) errors.ValidationErrorCollection {
+	// Nil is only allowed with WithNil
+	if ruleSet.withNil && util.IsNil(input) {
+		return nil
+	}
+
 	// Ensure output is a pointer
 	outputVal := reflect.ValueOf(output)
 	if outputVal.Kind() != reflect.Ptr || outputVal.IsNil() {
@@ -118,12 +143,15 @@ func (v *InterfaceRuleSet[T]) Evaluate(ctx context.Context, value T) errors.Vali
 
 	currentRuleSet := v
 	ctx = rulecontext.WithRuleSet(ctx, v)
+	failFast := rulecontext.FailFast(ctx)
 
 	for currentRuleSet != nil {
 		if currentRuleSet.rule != nil {
-			err := currentRuleSet.rule.Evaluate(ctx, value)
-			if err != nil {
-				allErrors = append(allErrors, err...)
+			if errs := currentRuleSet.rule.Evaluate(ctx, value); errs != nil {
+				allErrors = appendErrors(allErrors, errs)
+				if failFast && errs.HasErrors() {
+					break
+				}
 			}
 		}
 
@@ -145,6 +173,7 @@ func (v *InterfaceRuleSet[T]) Evaluate(ctx context.Context, value T) errors.Vali
 func (v *InterfaceRuleSet[T]) WithRule(rule Rule[T]) *InterfaceRuleSet[T] {
 	return &InterfaceRuleSet[T]{
 		required: v.required,
+		withNil:  v.withNil,
 		cast:     v.cast,
 		rule:     rule,
 		parent:   v,
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"reflect"
 
+	"proto.zip/studio/validate/internal/util"
 	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
 )
 
 var baseInt IntRuleSet[int] = IntRuleSet[int]{
@@ -63,15 +65,32 @@ type integer interface {
 }
 
 // Implementation of RuleSet for integers.
+//
+// Unless the rule set is strict, string inputs such as query string and form values are parsed using the base
+// of the rule set. Signs and leading zeros are allowed but whitespace is not. Values that are too large for the
+// type return a range error.
+//
+// Floating point inputs, such as numbers unmarshaled from JSON, are accepted if they are whole numbers so 123.0
+// becomes 123 and 1.5 returns a type error unless a rounding mode is set. Floats larger than the largest integer the
+// float type can represent exactly (2^53 for float64) return a range error since the original value may have already
+// lost precision. NaN and infinite values return a type error. Use WithStrictType to reject all floats.
+//
+// A json.Number, such as from WithJsonOptions with UseNumber, is accepted even in strict mode. Integers are parsed
+// exactly in base 10 and numbers with a fraction or exponent are converted like floats.
 type IntRuleSet[T integer] struct {
 	NoConflict[T]
-	strict   bool
-	base     int
-	rule     Rule[T]
-	required bool
-	parent   *IntRuleSet[T]
-	rounding Rounding
-	label    string
+	strict      bool
+	strictType  bool
+	base        int
+	rule        Rule[T]
+	required    bool
+	withNil     bool
+	errorConfig *errors.ErrorConfig
+	parent      *IntRuleSet[T]
+	rounding    Rounding
+	clampMin    *T
+	clampMax    *T
+	label       string
 }
 
 // Int creates a new integer RuleSet.
@@ -131,12 +150,37 @@ func Uint64() *IntRuleSet[uint64] {
 // deterministically and without loss.
 func (v *IntRuleSet[T]) WithStrict() *IntRuleSet[T] {
 	return &IntRuleSet[T]{
-		strict:   true,
-		parent:   v,
-		base:     v.base,
-		required: v.required,
-		rounding: v.rounding,
-		label:    "WithStrict()",
+		strict:      true,
+		strictType:  v.strictType,
+		parent:      v,
+		base:        v.base,
+		required:    v.required,
+		withNil:     v.withNil,
+		errorConfig: v.errorConfig,
+		rounding:    v.rounding,
+		label:       "WithStrict()",
+	}
+}
+
+// WithStrictType returns a new child RuleSet that only accepts integer types.
+// It implies WithStrict and also rejects floating point inputs, even whole numbers such as 123.0, with a type error.
+//
+// Integer inputs of a different size are still accepted if they are in range for the type.
+func (v *IntRuleSet[T]) WithStrictType() *IntRuleSet[T] {
+	if v.strictType {
+		return v
+	}
+
+	return &IntRuleSet[T]{
+		strict:      true,
+		strictType:  true,
+		parent:      v,
+		base:        v.base,
+		required:    v.required,
+		withNil:     v.withNil,
+		errorConfig: v.errorConfig,
+		rounding:    v.rounding,
+		label:       "WithStrictType()",
 	}
 }
 
@@ -147,12 +191,15 @@ func (v *IntRuleSet[T]) WithStrict() *IntRuleSet[T] {
 // The default is base 10.
 func (v *IntRuleSet[T]) WithBase(base int) *IntRuleSet[T] {
 	return &IntRuleSet[T]{
-		strict:   v.strict,
-		parent:   v,
-		base:     base,
-		required: v.required,
-		rounding: v.rounding,
-		label:    fmt.Sprintf("WithBase(%d)", base),
+		strict:      v.strict,
+		strictType:  v.strictType,
+		parent:      v,
+		base:        base,
+		required:    v.required,
+		withNil:     v.withNil,
+		errorConfig: v.errorConfig,
+		rounding:    v.rounding,
+		label:       fmt.Sprintf("WithBase(%d)", base),
 	}
 }
 
@@ -165,18 +212,46 @@ func (v *IntRuleSet[T]) Required() bool {
 // Use WithRequired when nesting a RuleSet and the a value is not allowed to be omitted.
 func (v *IntRuleSet[T]) WithRequired() *IntRuleSet[T] {
 	return &IntRuleSet[T]{
-		strict:   v.strict,
-		parent:   v,
-		base:     v.base,
-		required: true,
-		rounding: v.rounding,
-		label:    "WithRequired()",
+		strict:      v.strict,
+		strictType:  v.strictType,
+		parent:      v,
+		base:        v.base,
+		required:    true,
+		withNil:     v.withNil,
+		errorConfig: v.errorConfig,
+		rounding:    v.rounding,
+		label:       "WithRequired()",
+	}
+}
+
+// WithNil returns a new child rule set where a nil input, such as a JSON null or a nil pointer, returns no errors
+// and leaves the output unchanged, so a pointer output stays nil. By default nil is a type error.
+func (v *IntRuleSet[T]) WithNil() *IntRuleSet[T] {
+	if v.withNil {
+		return v
+	}
+
+	return &IntRuleSet[T]{
+		strict:      v.strict,
+		strictType:  v.strictType,
+		parent:      v,
+		base:        v.base,
+		required:    v.required,
+		withNil:     true,
+		errorConfig: v.errorConfig,
+		rounding:    v.rounding,
+		label:       "WithNil()",
 	}
 }
 
 // Apply performs a validation of a RuleSet against a value and assigns the result to the output parameter.
 // It returns a ValidationErrorCollection if any validation errors occur.
 func (ruleSet *IntRuleSet[T]) Apply(ctx context.Context, input any, output any) errors.ValidationErrorCollection {
+	// Nil is only allowed with WithNil
+	if ruleSet.withNil && util.IsNil(input) {
+		return nil
+	}
+
 	// Ensure output is a non-nil pointer
 	outputVal := reflect.ValueOf(output)
 	if outputVal.Kind() != reflect.Ptr || outputVal.IsNil() {
@@ -188,9 +263,14 @@ func (ruleSet *IntRuleSet[T]) Apply(ctx context.Context, input any, output any)
 	// Attempt to coerce the input value to an integer
 	intval, validationErr := ruleSet.coerceInt(input, ctx)
 	if validationErr != nil {
+		if validationErr.Code() == errors.CodeRange && ruleSet.hasRange() {
+			validationErr = ruleSet.rangeError(ctx, input)
+		}
 		return errors.Collection(validationErr)
 	}
 
+	intval = ruleSet.clamp(intval)
+
 	// Handle setting the value in output
 	outputElem := outputVal.Elem()
 
@@ -214,17 +294,21 @@ func (ruleSet *IntRuleSet[T]) Apply(ctx context.Context, input any, output any)
 	}
 
 	allErrors := errors.Collection()
+	failFast := rulecontext.FailFast(ctx)
 
 	for currentRuleSet := ruleSet; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
 		if currentRuleSet.rule != nil {
 			if err := currentRuleSet.rule.Evaluate(ctx, intval); err != nil {
-				allErrors = append(allErrors, err...)
+				allErrors = appendErrors(allErrors, err)
+				if failFast && err.HasErrors() {
+					break
+				}
 			}
 		}
 	}
 
 	if len(allErrors) != 0 {
-		return allErrors
+		return errors.WithErrorConfig(ctx, allErrors, ruleSet.errorConfig)
 	}
 	return nil
 }
@@ -232,18 +316,23 @@ func (ruleSet *IntRuleSet[T]) Apply(ctx context.Context, input any, output any)
 // Evaluate performs a validation of a RuleSet against an integer value and returns an integer value of the
 // same type or a ValidationErrorCollection.
 func (v *IntRuleSet[T]) Evaluate(ctx context.Context, value T) errors.ValidationErrorCollection {
+	value = v.clamp(value)
 	allErrors := errors.Collection()
+	failFast := rulecontext.FailFast(ctx)
 
 	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
 		if currentRuleSet.rule != nil {
 			if err := currentRuleSet.rule.Evaluate(ctx, value); err != nil {
-				allErrors = append(allErrors, err...)
+				allErrors = appendErrors(allErrors, err)
+				if failFast && err.HasErrors() {
+					break
+				}
 			}
 		}
 	}
 
 	if len(allErrors) != 0 {
-		return allErrors
+		return errors.WithErrorConfig(ctx, allErrors, v.errorConfig)
 	} else {
 		return nil
 	}
@@ -272,13 +361,18 @@ func (ruleSet *IntRuleSet[T]) withoutConflicts(rule Rule[T]) *IntRuleSet[T] {
 	}
 
 	return &IntRuleSet[T]{
-		strict:   ruleSet.strict,
-		base:     ruleSet.base,
-		rule:     ruleSet.rule,
-		required: ruleSet.required,
-		parent:   newParent,
-		rounding: ruleSet.rounding,
-		label:    ruleSet.label,
+		strict:      ruleSet.strict,
+		strictType:  ruleSet.strictType,
+		base:        ruleSet.base,
+		rule:        ruleSet.rule,
+		required:    ruleSet.required,
+		withNil:     ruleSet.withNil,
+		errorConfig: ruleSet.errorConfig,
+		parent:      newParent,
+		rounding:    ruleSet.rounding,
+		label:       ruleSet.label,
+		clampMin:    ruleSet.clampMin,
+		clampMax:    ruleSet.clampMax,
 	}
 }
 
@@ -289,12 +383,15 @@ func (ruleSet *IntRuleSet[T]) withoutConflicts(rule Rule[T]) *IntRuleSet[T] {
 // Use this when implementing custom rules.
 func (ruleSet *IntRuleSet[T]) WithRule(rule Rule[T]) *IntRuleSet[T] {
 	return &IntRuleSet[T]{
-		strict:   ruleSet.strict,
-		rule:     rule,
-		parent:   ruleSet.withoutConflicts(rule),
-		base:     ruleSet.base,
-		required: ruleSet.required,
-		rounding: ruleSet.rounding,
+		strict:      ruleSet.strict,
+		strictType:  ruleSet.strictType,
+		rule:        rule,
+		parent:      ruleSet.withoutConflicts(rule),
+		base:        ruleSet.base,
+		required:    ruleSet.required,
+		withNil:     ruleSet.withNil,
+		errorConfig: ruleSet.errorConfig,
+		rounding:    ruleSet.rounding,
 	}
 }
 
@@ -2,6 +2,7 @@ package rules_test
 
 import (
 	"context"
+	"math"
 	"reflect"
 	"testing"
 
@@ -246,3 +247,83 @@ func TestIntVariantTypes(t *testing.T) {
 		})
 	}
 }
+
+// Requirements:
+// - Numeric strings are parsed.
+// - Signs and leading zeros are allowed.
+// - Whitespace and non-numeric strings are rejected.
+// - Overflow returns a range error rather than panicking.
+// - Strict rule sets do not parse strings.
+func TestIntCoercionFromStringEdgeCases(t *testing.T) {
+	ruleSet := rules.Int8().Any()
+
+	testhelpers.MustApplyMutation(t, ruleSet, "007", int8(7))
+	testhelpers.MustApplyMutation(t, ruleSet, "+12", int8(12))
+	testhelpers.MustApplyMutation(t, ruleSet, "-12", int8(-12))
+	testhelpers.MustApplyMutation(t, ruleSet, "-128", int8(-128))
+
+	testhelpers.MustNotApply(t, ruleSet, " 12", errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet, "12\n", errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet, "1 2", errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet, "12abc", errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet, "", errors.CodeType)
+
+	testhelpers.MustNotApply(t, ruleSet, "128", errors.CodeRange)
+	testhelpers.MustNotApply(t, ruleSet, "-129", errors.CodeRange)
+	testhelpers.MustNotApply(t, rules.Uint8().Any(), "-1", errors.CodeType)
+
+	testhelpers.MustNotApply(t, rules.Int8().WithStrict().Any(), "12", errors.CodeType)
+}
+
+// Requirements:
+// - Whole number floats such as JSON numbers are accepted.
+// - Floats with a fractional part return a type error unless rounding is set.
+// - Floats that are too large to represent every integer exactly return a range error.
+// - Negative floats are out of range for unsigned types.
+// - NaN and infinite values return a type error.
+func TestIntCoercionFromFloatEdgeCases(t *testing.T) {
+	ruleSet := rules.Int64().Any()
+
+	testhelpers.MustApplyMutation(t, ruleSet, float64(123.0), int64(123))
+	testhelpers.MustApplyMutation(t, ruleSet, float64(-123.0), int64(-123))
+	testhelpers.MustApplyMutation(t, ruleSet, float64(1<<53), int64(1<<53))
+	testhelpers.MustNotApply(t, ruleSet, float64(1.5), errors.CodeType)
+	testhelpers.MustApplyMutation(t, rules.Int64().WithRounding(rules.RoundingDown).Any(), float64(1.5), int64(1))
+
+	testhelpers.MustNotApply(t, ruleSet, float64(1<<53+2), errors.CodeRange)
+	testhelpers.MustNotApply(t, ruleSet, float64(-(1<<53 + 2)), errors.CodeRange)
+	testhelpers.MustNotApply(t, ruleSet, float64(1e20), errors.CodeRange)
+	testhelpers.MustNotApply(t, rules.Uint64().Any(), float64(1e20), errors.CodeRange)
+	testhelpers.MustNotApply(t, ruleSet, float32(1<<25), errors.CodeRange)
+
+	testhelpers.MustNotApply(t, rules.Uint64().Any(), float64(-1), errors.CodeRange)
+	testhelpers.MustNotApply(t, rules.Uint().Any(), float64(-1), errors.CodeRange)
+
+	testhelpers.MustNotApply(t, ruleSet, math.NaN(), errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet, math.Inf(1), errors.CodeType)
+}
+
+// Requirements:
+// - WithStrictType rejects all floats, including whole numbers.
+// - WithStrictType rejects strings.
+// - Integers of other types are accepted if they are in range.
+// - Serializes to WithStrictType().
+func TestIntStrictType(t *testing.T) {
+	ruleSet := rules.Int().WithStrictType()
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), float64(123.0), errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet.Any(), float64(1.5), errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet.WithRounding(rules.RoundingHalfUp).Any(), float64(1.5), errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "123", errors.CodeType)
+	testhelpers.MustApplyMutation(t, ruleSet.Any(), int64(123), 123)
+	testhelpers.MustNotApply(t, rules.Int8().WithStrictType().Any(), 1024, errors.CodeRange)
+
+	if ruleSet.WithStrictType() != ruleSet {
+		t.Error("Expected WithStrictType to return the same rule set")
+	}
+
+	expected := "IntRuleSet[int].WithStrictType()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
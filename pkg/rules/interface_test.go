@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
 	"proto.zip/studio/validate/pkg/rules"
 	"proto.zip/studio/validate/pkg/testhelpers"
 )
@@ -160,3 +161,31 @@ func TestInterfaceWithCast(t *testing.T) {
 	testhelpers.MustApplyMutation(t, ruleSetWithError.Any(), 123, MyTestImplInt(123))
 	testhelpers.MustNotApply(t, ruleSetWithError.Any(), "abc", errors.CodeUnexpected)
 }
+
+// Requirements:
+// - Rules that only return warnings do not prevent the output from being set.
+// - The warnings are returned.
+// - Warnings do not stop the other rules in fail fast mode.
+func TestInterface_Warning(t *testing.T) {
+	warn := func(ctx context.Context, _ MyTestInterface) errors.ValidationErrorCollection {
+		return errors.Collection(errors.WithSeverity(errors.Errorf(errors.CodeUnknown, ctx, "just a warning"), errors.SeverityWarning))
+	}
+
+	var out MyTestInterface
+	errs := rules.Interface[MyTestInterface]().WithRuleFunc(warn).Apply(context.Background(), MyTestImpl{}, &out)
+	if errs.HasErrors() || len(errs.Warnings()) != 1 {
+		t.Errorf("Expected 1 warning and no errors, got: %s", errs)
+	} else if out == nil {
+		t.Errorf("Expected output to be set, got: %v", out)
+	}
+
+	fail := func(ctx context.Context, _ MyTestInterface) errors.ValidationErrorCollection {
+		return errors.Collection(errors.Errorf(errors.CodeForbidden, ctx, "value is not allowed"))
+	}
+
+	ctx := rulecontext.WithFailFast(context.Background())
+	errs = rules.Interface[MyTestInterface]().WithRuleFunc(fail).WithRuleFunc(warn).Evaluate(ctx, MyTestImpl{})
+	if len(errs.Errors()) != 1 || len(errs.Warnings()) != 1 {
+		t.Errorf("Expected 1 error and 1 warning, got: %s", errs)
+	}
+}
@@ -0,0 +1,104 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// Requirements:
+// - AllowedValues returns nil when unset.
+// - AllowedValues returns the cumulative sorted values.
+// - MinLen and MaxLen return the most recent values.
+func TestStringIntrospection(t *testing.T) {
+	ruleSet := rules.String()
+
+	if values := ruleSet.AllowedValues(); values != nil {
+		t.Errorf("Expected allowed values to be nil, got: %v", values)
+	}
+	if _, ok := ruleSet.MinLen(); ok {
+		t.Errorf("Expected min length to not be set")
+	}
+
+	ruleSet = ruleSet.WithAllowedValues("b", "c").WithAllowedValues("a").WithMinLen(1).WithMaxLen(5).WithMaxLen(3)
+
+	if values := ruleSet.AllowedValues(); !reflect.DeepEqual(values, []string{"a", "b", "c"}) {
+		t.Errorf("Expected allowed values to be [a b c], got: %v", values)
+	}
+	if min, ok := ruleSet.MinLen(); !ok || min != 1 {
+		t.Errorf("Expected min length to be 1, got: %d", min)
+	}
+	if max, ok := ruleSet.MaxLen(); !ok || max != 3 {
+		t.Errorf("Expected max length to be 3, got: %d", max)
+	}
+
+	// Modifying the result must not modify the rule set
+	ruleSet.AllowedValues()[0] = "z"
+	if values := ruleSet.AllowedValues(); values[0] != "a" {
+		t.Errorf("Expected allowed values to not be modified, got: %v", values)
+	}
+}
+
+// Requirements:
+// - Min and Max return false when unset.
+// - Min and Max return the most recent values.
+func TestNumberIntrospection(t *testing.T) {
+	if _, ok := rules.Int().Max(); ok {
+		t.Errorf("Expected max to not be set")
+	}
+
+	intRuleSet := rules.Int().WithMin(1).WithMax(10).WithMin(2)
+	if min, ok := intRuleSet.Min(); !ok || min != 2 {
+		t.Errorf("Expected min to be 2, got: %d", min)
+	}
+	if max, ok := intRuleSet.Max(); !ok || max != 10 {
+		t.Errorf("Expected max to be 10, got: %d", max)
+	}
+
+	floatRuleSet := rules.Float64().WithMax(1.5)
+	if _, ok := floatRuleSet.Min(); ok {
+		t.Errorf("Expected min to not be set")
+	}
+	if max, ok := floatRuleSet.Max(); !ok || max != 1.5 {
+		t.Errorf("Expected max to be 1.5, got: %f", max)
+	}
+
+	sliceRuleSet := rules.Slice[int]().WithMinLen(2)
+	if min, ok := sliceRuleSet.MinLen(); !ok || min != 2 {
+		t.Errorf("Expected min length to be 2, got: %d", min)
+	}
+}
+
+// Requirements:
+// - Keys returns constant keys in the order they were first added.
+// - Mappings without rules and dynamic keys are not returned.
+// - KeyRequired returns true if any unconditional rule set for the key is required.
+func TestObjectIntrospection(t *testing.T) {
+	condition := rules.StringMap[any]().WithKey("a", rules.Any())
+
+	ruleSet := rules.StringMap[any]().
+		WithKey("b", rules.String().Any()).
+		WithKey("a", rules.String().Any()).
+		WithKey("b", rules.String().WithRequired().Any()).
+		WithConditionalKey("c", condition, rules.String().WithRequired().Any()).
+		WithDynamicKey(rules.String().WithMinLen(10), rules.Any())
+
+	if keys := ruleSet.Keys(); !reflect.DeepEqual(keys, []string{"b", "a", "c"}) {
+		t.Errorf("Expected keys to be [b a c], got: %v", keys)
+	}
+
+	if !ruleSet.KeyRequired("b") {
+		t.Errorf("Expected b to be required")
+	}
+	if ruleSet.KeyRequired("a") {
+		t.Errorf("Expected a to not be required")
+	}
+	if ruleSet.KeyRequired("c") {
+		t.Errorf("Expected conditional c to not be required")
+	}
+
+	if keys := rules.Struct[testStruct]().Keys(); len(keys) != 0 {
+		t.Errorf("Expected mappings to not be returned, got: %v", keys)
+	}
+}
@@ -0,0 +1,103 @@
+package rules
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// JsonOptions changes how Json strings are decoded by rule sets that use WithJsonOptions.
+// The zero value decodes the same way as WithJson.
+type JsonOptions struct {
+	// UseNumber decodes numbers as json.Number instead of float64 so integers larger than 2^53 keep their exact
+	// value. Int and float rule sets accept json.Number values.
+	UseNumber bool
+
+	// DisallowDuplicateKeys rejects input where any object, including nested objects, has the same key more
+	// than once.
+	DisallowDuplicateKeys bool
+}
+
+// decodeJsonInput decodes the value into out if it is a string or a byte slice, such as json.RawMessage.
+// It returns false if the value is any other kind and an error if it could not be decoded.
+func decodeJsonInput(value reflect.Value, out any, options JsonOptions) (bool, error) {
+	switch {
+	case value.Kind() == reflect.String:
+		return true, decodeJson([]byte(value.String()), out, options)
+	case value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.Uint8:
+		return true, decodeJson(value.Bytes(), out, options)
+	}
+	return false, nil
+}
+
+// decodeJson decodes the data into out using the options.
+// Like json.Unmarshal, it is an error for anything other than whitespace to follow the value.
+func decodeJson(data []byte, out any, options JsonOptions) error {
+	if !options.UseNumber && !options.DisallowDuplicateKeys {
+		return json.Unmarshal(data, out)
+	}
+
+	if options.DisallowDuplicateKeys {
+		if err := checkDuplicateKeys(json.NewDecoder(bytes.NewReader(data))); err != nil {
+			return err
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if options.UseNumber {
+		decoder.UseNumber()
+	}
+
+	if err := decoder.Decode(out); err != nil {
+		return err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return fmt.Errorf("unexpected data after Json value")
+	}
+	return nil
+}
+
+// checkDuplicateKeys reads the next value from the decoder and returns an error if any object in it has the same
+// key more than once.
+func checkDuplicateKeys(decoder *json.Decoder) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	switch token {
+	case json.Delim('{'):
+		keys := make(map[string]bool)
+		for decoder.More() {
+			keyToken, err := decoder.Token()
+			if err != nil {
+				return err
+			}
+
+			key := keyToken.(string)
+			if keys[key] {
+				return fmt.Errorf("duplicate key: %s", key)
+			}
+			keys[key] = true
+
+			if err := checkDuplicateKeys(decoder); err != nil {
+				return err
+			}
+		}
+		_, err = decoder.Token()
+		return err
+
+	case json.Delim('['):
+		for decoder.More() {
+			if err := checkDuplicateKeys(decoder); err != nil {
+				return err
+			}
+		}
+		_, err = decoder.Token()
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package rules
+
+import (
+	"context"
+	"reflect"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// keyJob represents a single key evaluation that is waiting to be run.
+type keyJob[T any, TK comparable, TV any] struct {
+	ctx            context.Context
+	ruleSet        *ObjectRuleSet[T, TK, TV]
+	key            TK
+	inFieldValue   reflect.Value
+	dynamicBuckets []*ObjectRuleSet[T, TK, TV]
+	dynamic        bool // dynamic is true if the key was matched by a dynamic key rule.
+}
+
+// dependsOn returns true if the job has a condition that depends on the key of the other job.
+func (j *keyJob[T, TK, TV]) dependsOn(other *keyJob[T, TK, TV]) bool {
+	if j == other || j.ruleSet.condition == nil {
+		return false
+	}
+
+	for _, keyRule := range j.ruleSet.condition.KeyRules() {
+		if keyRule.Evaluate(context.Background(), other.key) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// orderKeyJobs returns the jobs ordered so that every conditional job comes after all the jobs it depends on.
+// Otherwise the original order is kept and conditional jobs are moved only as far as needed.
+//
+// This is needed when the number of workers is limited. Otherwise a conditional job could occupy every worker while
+// it waits for keys that have not started yet.
+func orderKeyJobs[T any, TK comparable, TV any](jobs []*keyJob[T, TK, TV]) []*keyJob[T, TK, TV] {
+	ordered := make([]*keyJob[T, TK, TV], 0, len(jobs))
+	emitted := make(map[*keyJob[T, TK, TV]]bool, len(jobs))
+	var blocked []*keyJob[T, TK, TV]
+
+	ready := func(job *keyJob[T, TK, TV]) bool {
+		if job.ruleSet.condition == nil {
+			return true
+		}
+		for _, other := range jobs {
+			if !emitted[other] && job.dependsOn(other) {
+				return false
+			}
+		}
+		return true
+	}
+
+	emit := func(job *keyJob[T, TK, TV]) {
+		ordered = append(ordered, job)
+		emitted[job] = true
+	}
+
+	for _, job := range jobs {
+		if !ready(job) {
+			blocked = append(blocked, job)
+			continue
+		}
+		emit(job)
+
+		// Emitting a job may unblock conditional jobs that depend on it.
+		for i := 0; i < len(blocked); i++ {
+			if ready(blocked[i]) {
+				emit(blocked[i])
+				blocked = append(blocked[:i], blocked[i+1:]...)
+				i = -1
+			}
+		}
+	}
+
+	// Circular references are rejected when the rule set is built so this should never happen.
+	// Fall back to the original order rather than dropping jobs.
+	return append(ordered, blocked...)
+}
+
+// runKeyJobs runs each job using the function provided.
+//
+// If limit is less than 1 every job is run in its own goroutine. Otherwise the jobs are run by a fixed number
+// of workers in dependency order.
+func runKeyJobs[T any, TK comparable, TV any](jobs []*keyJob[T, TK, TV], limit int, run func(job *keyJob[T, TK, TV])) {
+	if limit < 1 {
+		for _, job := range jobs {
+			go run(job)
+		}
+		return
+	}
+
+	if limit > len(jobs) {
+		limit = len(jobs)
+	}
+
+	queue := make(chan *keyJob[T, TK, TV], len(jobs))
+	for _, job := range orderKeyJobs(jobs) {
+		queue <- job
+	}
+	close(queue)
+
+	for i := 0; i < limit; i++ {
+		go func() {
+			for job := range queue {
+				run(job)
+			}
+		}()
+	}
+}
+
+// evaluateKeyJobsSequential evaluates each job on the calling goroutine and returns the errors in evaluation order.
+//
+// Jobs are expected in the order they were collected (child to parent). They are evaluated parent to child, in the
+// order the keys were declared, except where a conditional key must wait on a key declared after it.
+func evaluateKeyJobsSequential[T any, TK comparable, TV any](ctx context.Context, jobs []*keyJob[T, TK, TV], evaluate func(job *keyJob[T, TK, TV]) errors.ValidationErrorCollection) errors.ValidationErrorCollection {
+	allErrors := errors.Collection()
+
+	// Reverse the rule sets while keeping dynamic keys for the same rule set in their original order.
+	declared := make([]*keyJob[T, TK, TV], 0, len(jobs))
+	for end := len(jobs); end > 0; {
+		start := end - 1
+		for start > 0 && jobs[start-1].ruleSet == jobs[end-1].ruleSet {
+			start--
+		}
+		declared = append(declared, jobs[start:end]...)
+		end = start
+	}
+
+	for _, job := range orderKeyJobs(declared) {
+		if done(ctx) {
+			return append(allErrors, contextErrorToValidation(ctx))
+		}
+
+		allErrors = appendErrors(allErrors, evaluate(job))
+	}
+
+	return allErrors
+}
+
+// evaluateKeyJobsInline evaluates each job on the calling goroutine in the order the keys were declared.
+//
+// Jobs are expected in the order they were collected (child to parent) and must not have conditions since nothing
+// waits for other keys to finish.
+func evaluateKeyJobsInline[T any, TK comparable, TV any](ctx context.Context, jobs []*keyJob[T, TK, TV], evaluate func(job *keyJob[T, TK, TV]) errors.ValidationErrorCollection) errors.ValidationErrorCollection {
+	allErrors := errors.Collection()
+
+	for i := len(jobs) - 1; i >= 0; i-- {
+		if done(ctx) {
+			return append(allErrors, contextErrorToValidation(ctx))
+		}
+
+		allErrors = appendErrors(allErrors, evaluate(jobs[i]))
+	}
+
+	// Match the concurrent path which reports the context error if it ended while a key was being evaluated.
+	if done(ctx) {
+		return append(allErrors, contextErrorToValidation(ctx))
+	}
+	return allErrors
+}
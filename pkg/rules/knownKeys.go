@@ -3,6 +3,7 @@ package rules
 import (
 	"context"
 	"reflect"
+	"sort"
 
 	"proto.zip/studio/validate/pkg/errors"
 	"proto.zip/studio/validate/pkg/rulecontext"
@@ -17,7 +18,12 @@ const (
 
 // knownKeys is a utility structure to track which keys are seen during validation.
 type knownKeys[TK comparable] struct {
-	keys map[TK]knownKeyType
+	keys      map[TK]knownKeyType
+	sorted    bool // sorted returns unknown keys in a stable order.
+	collapsed bool // collapsed returns a single error for all unknown keys.
+
+	// candidates are the declared keys that are suggested for unknown keys. Suggestions are disabled if empty.
+	candidates []string
 }
 
 // newKnownKeys creates a new instance of knownKeys.
@@ -43,6 +49,10 @@ func (k *knownKeys[TK]) exists(key TK) bool {
 
 // Check validates if all keys in the provided reflect.Value are known.
 // It returns a ValidationErrorCollection with errors for each unexpected key.
+// If collapsed is set, a single error is returned at the path of the object with the paths of the keys in the
+// "keys" metadata, sorted so the error is the same for every run.
+//
+// If there are candidates, the closest candidate to each unknown key is added to the metadata as a suggestion.
 //
 // If allowUnknown is true when creating the object then this always returns an
 // empty error collection.
@@ -55,9 +65,37 @@ func (k *knownKeys[TK]) Check(ctx context.Context, inValue reflect.Value) errors
 	}
 
 	unk := k.Unknown(inValue)
+
+	if k.collapsed && len(unk) > 0 {
+		keys := make([]string, len(unk))
+		for i, key := range unk {
+			keys[i] = toPath(key)
+		}
+		sort.Strings(keys)
+
+		meta := map[string]any{"keys": keys}
+		suggestions := make(map[string]string)
+		for _, key := range keys {
+			if suggestion, ok := nearestKey(key, k.candidates); ok {
+				suggestions[key] = suggestion
+			}
+		}
+		if len(suggestions) > 0 {
+			meta["suggestions"] = suggestions
+		}
+
+		err := errors.Errorf(errors.CodeUnexpected, ctx, "unexpected fields")
+		return append(errs, errors.WithMeta(err, meta))
+	}
+
 	for _, key := range unk {
-		subContext := rulecontext.WithPathString(ctx, toPath(key))
-		errs = append(errs, errors.Errorf(errors.CodeUnexpected, subContext, "unexpected field"))
+		path := toPath(key)
+		subContext := rulecontext.WithPathString(ctx, path)
+		err := errors.Errorf(errors.CodeUnexpected, subContext, "unexpected field")
+		if suggestion, ok := nearestKey(path, k.candidates); ok {
+			err = errors.WithMeta(err, map[string]any{"suggestion": suggestion})
+		}
+		errs = append(errs, err)
 	}
 	return errs
 }
@@ -66,8 +104,13 @@ func (k *knownKeys[TK]) Check(ctx context.Context, inValue reflect.Value) errors
 func (k *knownKeys[TK]) Unknown(inValue reflect.Value) []TK {
 	var out []TK
 
+	mapKeys := inValue.MapKeys()
+	if k.sorted {
+		sortMapKeys(mapKeys)
+	}
+
 	// Loop through each key in the input value and check if it's a known key.
-	for _, key := range inValue.MapKeys() {
+	for _, key := range mapKeys {
 		keyVal := key.Interface().(TK)
 		if !k.exists(keyVal) {
 			out = append(out, keyVal)
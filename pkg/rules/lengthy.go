@@ -1,7 +1,41 @@
 package rules
 
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+	"proto.zip/studio/validate/pkg/rulecontext"
+)
+
 // Define a custom constraint that includes types that can be passed to len
 // Used by minLenRule and maxLenRule
 type lengthy[T any] interface {
 	~string | ~[]T | ~chan T
 }
+
+// lengthMode determines how string length is measured.
+type lengthMode int
+
+const (
+	lengthModeBytes     lengthMode = iota // Default
+	lengthModeRunes                       // Unicode code points
+	lengthModeGraphemes                   // Extended grapheme clusters
+)
+
+// lengthOf returns the length of the value.
+//
+// Strings are measured in bytes unless the current rule set is a StringRuleSet with a different length mode.
+func lengthOf[TV any, T lengthy[TV]](ctx context.Context, value T) int {
+	if str, ok := any(value).(string); ok {
+		if ruleSet, ok := rulecontext.RuleSet(ctx).(*StringRuleSet); ok {
+			switch ruleSet.lengthMode {
+			case lengthModeRunes:
+				return utf8.RuneCountInString(str)
+			case lengthModeGraphemes:
+				return uniseg.GraphemeClusterCount(str)
+			}
+		}
+	}
+	return len(value)
+}
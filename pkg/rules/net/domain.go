@@ -2,11 +2,13 @@ package net
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"regexp"
 	"strings"
 
 	"golang.org/x/net/idna"
+	"proto.zip/studio/validate/internal/util"
 	"proto.zip/studio/validate/pkg/errors"
 	"proto.zip/studio/validate/pkg/rulecontext"
 	"proto.zip/studio/validate/pkg/rules"
@@ -17,6 +19,12 @@ var baseDomainRuleSet DomainRuleSet = DomainRuleSet{
 	label: "DomainRuleSet",
 }
 
+// Default limits from RFC 1035 for the length of a label and of the domain after conversion to punycode.
+const (
+	defaultMaxLabelLength  = 63
+	defaultMaxDomainLength = 255
+)
+
 // domainLabelPattern matches valid domains after they have been converted to punycode
 var domainLabelPattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]{0,61}[a-zA-Z0-9]$`)
 
@@ -24,6 +32,12 @@ var domainLabelPattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]{0,61}[a-zA-Z0
 type DomainRuleSet struct {
 	rules.NoConflict[string]
 	required bool
+	wildcard bool
+	strict   bool
+	text     bool
+	withNil  bool
+	maxLabel int
+	maxLen   int
 	parent   *DomainRuleSet
 	rule     rules.Rule[string]
 	label    string
@@ -44,23 +58,168 @@ func (ruleSet *DomainRuleSet) Required() bool {
 func (ruleSet *DomainRuleSet) WithRequired() *DomainRuleSet {
 	return &DomainRuleSet{
 		required: true,
+		wildcard: ruleSet.wildcard,
+		strict:   ruleSet.strict,
+		text:     ruleSet.text,
+		withNil:  ruleSet.withNil,
+		maxLabel: ruleSet.maxLabel,
+		maxLen:   ruleSet.maxLen,
 		parent:   ruleSet,
 		label:    "WithRequired()",
 	}
 }
 
+// WithWildcard returns a new rule set that allows a single leading wildcard label such as "*.example.com".
+//
+// The remaining labels are validated normally and at least one is required. A wildcard in any other position
+// is still an error.
+func (ruleSet *DomainRuleSet) WithWildcard() *DomainRuleSet {
+	if ruleSet.wildcard {
+		return ruleSet
+	}
+
+	return &DomainRuleSet{
+		required: ruleSet.required,
+		wildcard: true,
+		strict:   ruleSet.strict,
+		text:     ruleSet.text,
+		withNil:  ruleSet.withNil,
+		maxLabel: ruleSet.maxLabel,
+		maxLen:   ruleSet.maxLen,
+		parent:   ruleSet,
+		label:    "WithWildcard()",
+	}
+}
+
+// WithStrictIDNA returns a new rule set that rejects internationalized domains whose Unicode form is not stable.
+//
+// The domain is converted using the IDNA lookup profile and back again. If the result does not match the lowercase
+// input the domain returns an errors.CodePattern error. This rejects labels that contain disallowed code points,
+// invisible characters, mixed-direction text and other input that is changed by the IDNA mapping, which hardens
+// against homograph tricks. Labels that are already punycode must decode to a valid label.
+//
+// By default domains are converted leniently and these inputs are allowed.
+func (ruleSet *DomainRuleSet) WithStrictIDNA() *DomainRuleSet {
+	if ruleSet.strict {
+		return ruleSet
+	}
+
+	return &DomainRuleSet{
+		required: ruleSet.required,
+		wildcard: ruleSet.wildcard,
+		strict:   true,
+		text:     ruleSet.text,
+		withNil:  ruleSet.withNil,
+		maxLabel: ruleSet.maxLabel,
+		maxLen:   ruleSet.maxLen,
+		parent:   ruleSet,
+		label:    "WithStrictIDNA()",
+	}
+}
+
+// WithMaxLabelLength returns a new rule set that limits each label of the domain to n bytes after conversion to
+// punycode. Longer labels return an errors.CodeMax error.
+//
+// The default and largest allowed value is 63. This function panics if n is less than 1 or greater than 63.
+func (ruleSet *DomainRuleSet) WithMaxLabelLength(n int) *DomainRuleSet {
+	if n < 1 || n > defaultMaxLabelLength {
+		panic(fmt.Errorf("max label length must be between 1 and %d, got: %d", defaultMaxLabelLength, n))
+	}
+
+	return &DomainRuleSet{
+		required: ruleSet.required,
+		wildcard: ruleSet.wildcard,
+		strict:   ruleSet.strict,
+		text:     ruleSet.text,
+		withNil:  ruleSet.withNil,
+		maxLabel: n,
+		maxLen:   ruleSet.maxLen,
+		parent:   ruleSet,
+		label:    fmt.Sprintf("WithMaxLabelLength(%d)", n),
+	}
+}
+
+// WithMaxLength returns a new rule set that limits the domain to n bytes after conversion to punycode.
+// Longer domains return an errors.CodeMax error.
+//
+// The default and largest allowed value is 255. This function panics if n is less than 1 or greater than 255.
+func (ruleSet *DomainRuleSet) WithMaxLength(n int) *DomainRuleSet {
+	if n < 1 || n > defaultMaxDomainLength {
+		panic(fmt.Errorf("max length must be between 1 and %d, got: %d", defaultMaxDomainLength, n))
+	}
+
+	return &DomainRuleSet{
+		required: ruleSet.required,
+		wildcard: ruleSet.wildcard,
+		strict:   ruleSet.strict,
+		text:     ruleSet.text,
+		withNil:  ruleSet.withNil,
+		maxLabel: ruleSet.maxLabel,
+		maxLen:   n,
+		parent:   ruleSet,
+		label:    fmt.Sprintf("WithMaxLength(%d)", n),
+	}
+}
+
+// WithNil returns a new rule set where a nil input, such as a JSON null or a nil *string, passes without any of the
+// domain rules being evaluated. The output is left unchanged. Otherwise nil returns an errors.CodeType error.
+func (ruleSet *DomainRuleSet) WithNil() *DomainRuleSet {
+	if ruleSet.withNil {
+		return ruleSet
+	}
+
+	return &DomainRuleSet{
+		required: ruleSet.required,
+		wildcard: ruleSet.wildcard,
+		strict:   ruleSet.strict,
+		text:     ruleSet.text,
+		withNil:  true,
+		maxLabel: ruleSet.maxLabel,
+		maxLen:   ruleSet.maxLen,
+		parent:   ruleSet,
+		label:    "WithNil()",
+	}
+}
+
+// WithTextCoercion returns a new rule set that accepts values implementing encoding.TextMarshaler or fmt.Stringer
+// and validates their text representation. TextMarshaler is preferred if the value implements both. If MarshalText
+// returns an error, Apply returns an errors.CodeType error.
+func (ruleSet *DomainRuleSet) WithTextCoercion() *DomainRuleSet {
+	if ruleSet.text {
+		return ruleSet
+	}
+
+	return &DomainRuleSet{
+		required: ruleSet.required,
+		wildcard: ruleSet.wildcard,
+		strict:   ruleSet.strict,
+		text:     true,
+		withNil:  ruleSet.withNil,
+		maxLabel: ruleSet.maxLabel,
+		maxLen:   ruleSet.maxLen,
+		parent:   ruleSet,
+		label:    "WithTextCoercion()",
+	}
+}
+
 // Apply performs a validation of a RuleSet against a value and assigns the result to the output parameter.
 // It returns a ValidationErrorCollection if any validation errors occur.
 func (ruleSet *DomainRuleSet) Apply(ctx context.Context, input any, output any) errors.ValidationErrorCollection {
+	// Nil is only allowed with WithNil
+	if ruleSet.withNil && util.IsNil(input) {
+		return nil
+	}
+
 	// Attempt to cast the input to a string
-	valueStr, ok := input.(string)
-	if !ok {
-		return errors.Collection(errors.NewCoercionError(ctx, "string", reflect.ValueOf(input).Kind().String()))
+	valueStr, coerceErr := coerceString(ctx, input, ruleSet.text)
+	if coerceErr != nil {
+		return errors.Collection(coerceErr)
 	}
 
 	// Perform the validation
-	if err := ruleSet.Evaluate(ctx, valueStr); err != nil {
-		return err
+	errs := ruleSet.Evaluate(ctx, valueStr)
+	if errs.HasErrors() {
+		return errs
 	}
 
 	outputVal := reflect.ValueOf(output)
@@ -86,14 +245,25 @@ func (ruleSet *DomainRuleSet) Apply(ctx context.Context, input any, output any)
 		))
 	}
 
-	return nil
+	return errs
 }
 
 // validateBasicDomain performs general domain validation that is valid for any and all domains.
+// If wildcard is set then a single leading "*" label is allowed.
+// If strict IDNA is set then the Unicode form of the domain must survive a round trip through punycode.
 // This function always returns a collection even if it is empty.
-func validateBasicDomain(ctx context.Context, value string) errors.ValidationErrorCollection {
+func (ruleSet *DomainRuleSet) validateBasicDomain(ctx context.Context, value string) errors.ValidationErrorCollection {
 	allErrors := errors.Collection()
 
+	maxLen := defaultMaxDomainLength
+	if ruleSet.maxLen > 0 {
+		maxLen = ruleSet.maxLen
+	}
+
+	if ruleSet.wildcard {
+		value = strings.TrimPrefix(value, "*.")
+	}
+
 	// Convert to punycode
 	punycode, err := idna.ToASCII(value)
 
@@ -102,8 +272,13 @@ func validateBasicDomain(ctx context.Context, value string) errors.ValidationErr
 		return allErrors
 	}
 
+	if ruleSet.strict && !stableIDNA(value) {
+		allErrors = append(allErrors, errors.Errorf(errors.CodePattern, ctx, "domain contains unstable unicode"))
+		return allErrors
+	}
+
 	// Check total length
-	if len(punycode) >= 256 {
+	if len(punycode) > maxLen {
 		allErrors = append(allErrors, errors.Errorf(errors.CodeMax, ctx, "domain exceeds maximum length"))
 		return allErrors
 	}
@@ -112,6 +287,10 @@ func validateBasicDomain(ctx context.Context, value string) errors.ValidationErr
 	parts := strings.Split(punycode, ".")
 
 	for _, part := range parts {
+		if ruleSet.maxLabel > 0 && len(part) > ruleSet.maxLabel {
+			allErrors = append(allErrors, errors.Errorf(errors.CodeMax, ctx, "domain segment exceeds maximum length"))
+			break
+		}
 		if !domainLabelPattern.MatchString(part) {
 			allErrors = append(allErrors, errors.Errorf(errors.CodePattern, ctx, "domain segment is invalid"))
 			break
@@ -121,10 +300,40 @@ func validateBasicDomain(ctx context.Context, value string) errors.ValidationErr
 	return allErrors
 }
 
+// stableIDNA returns true if every label of the domain is unchanged by converting it to ASCII and back using the
+// IDNA lookup profile, ignoring case. Labels that are already punycode only need to convert cleanly.
+func stableIDNA(value string) bool {
+	ascii, err := idna.Lookup.ToASCII(value)
+	if err != nil {
+		return false
+	}
+
+	unicode, err := idna.Lookup.ToUnicode(ascii)
+	if err != nil {
+		return false
+	}
+
+	inputLabels := strings.Split(strings.ToLower(value), ".")
+	asciiLabels := strings.Split(ascii, ".")
+	unicodeLabels := strings.Split(unicode, ".")
+
+	if len(inputLabels) != len(asciiLabels) || len(inputLabels) != len(unicodeLabels) {
+		return false
+	}
+
+	for i, label := range inputLabels {
+		if label != unicodeLabels[i] && label != asciiLabels[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Evaluate performs a validation of a RuleSet against a string and returns an object value of the
 // same type or a ValidationErrorCollection.
 func (ruleSet *DomainRuleSet) Evaluate(ctx context.Context, value string) errors.ValidationErrorCollection {
-	allErrors := validateBasicDomain(ctx, value)
+	allErrors := ruleSet.validateBasicDomain(ctx, value)
 
 	if len(allErrors) > 0 {
 		return allErrors
@@ -176,6 +385,12 @@ func (ruleSet *DomainRuleSet) noConflict(rule rules.Rule[string]) *DomainRuleSet
 		rule:     ruleSet.rule,
 		parent:   newParent,
 		required: ruleSet.required,
+		wildcard: ruleSet.wildcard,
+		strict:   ruleSet.strict,
+		text:     ruleSet.text,
+		withNil:  ruleSet.withNil,
+		maxLabel: ruleSet.maxLabel,
+		maxLen:   ruleSet.maxLen,
 		label:    ruleSet.label,
 	}
 }
@@ -190,6 +405,12 @@ func (ruleSet *DomainRuleSet) WithRule(rule rules.Rule[string]) *DomainRuleSet {
 		rule:     rule,
 		parent:   ruleSet.noConflict(rule),
 		required: ruleSet.required,
+		wildcard: ruleSet.wildcard,
+		strict:   ruleSet.strict,
+		text:     ruleSet.text,
+		withNil:  ruleSet.withNil,
+		maxLabel: ruleSet.maxLabel,
+		maxLen:   ruleSet.maxLen,
 	}
 }
 
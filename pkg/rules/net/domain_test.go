@@ -151,3 +151,137 @@ func TestDomainRequiredString(t *testing.T) {
 		t.Errorf("Expected rule set to be %s, got %s", expected, s)
 	}
 }
+
+// Requirements:
+// - Wildcards are not allowed by default.
+// - WithWildcard allows a single leading wildcard label.
+// - Wildcards in any other position are not allowed.
+// - A bare wildcard is not allowed.
+// - The remaining labels are still validated.
+// - Other rules still apply to wildcard domains.
+func TestDomainWithWildcard(t *testing.T) {
+	testhelpers.MustNotApply(t, net.Domain().Any(), "*.ab.cd", errors.CodePattern)
+
+	ruleSet := net.Domain().WithWildcard()
+
+	testhelpers.MustApply(t, ruleSet.Any(), "*.ab.cd")
+	testhelpers.MustApply(t, ruleSet.Any(), "ab.cd")
+	testhelpers.MustNotApply(t, ruleSet.Any(), "ab.*.cd", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "*.*.ab.cd", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "*", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "*ab.cd", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "*.-ab.cd", errors.CodePattern)
+
+	withSuffix := ruleSet.WithRequired().WithSuffix("example.com")
+	testhelpers.MustApply(t, withSuffix.Any(), "*.example.com")
+	testhelpers.MustNotApply(t, withSuffix.Any(), "*.example.net", errors.CodePattern)
+}
+
+// Requirements:
+// - Serializes to WithWildcard()
+func TestDomainWithWildcardString(t *testing.T) {
+	ruleSet := net.Domain().WithWildcard().WithWildcard()
+
+	expected := "DomainRuleSet.WithWildcard()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Stable internationalized and punycode domains pass.
+// - Domains changed by the IDNA mapping return a pattern error.
+// - Invalid punycode labels return a pattern error.
+// - The default rule set still allows these domains.
+func TestDomainWithStrictIDNA(t *testing.T) {
+	ruleSet := net.Domain().WithStrictIDNA()
+
+	testhelpers.MustApply(t, ruleSet.Any(), "example.com")
+	testhelpers.MustApply(t, ruleSet.Any(), "Example.com")
+	testhelpers.MustApply(t, ruleSet.Any(), "bücher.ch")
+	testhelpers.MustApply(t, ruleSet.Any(), "xn--bcher-kva.ch")
+	testhelpers.MustApply(t, ruleSet.WithWildcard().Any(), "*.bücher.ch")
+
+	unstable := []string{
+		"\uff45xample.com",  // Fullwidth e
+		"ex\u00adample.com", // Soft hyphen
+		"bu\u0308cher.ch",   // Decomposed u with diaeresis
+		"xn--ls8h-.com",     // Invalid punycode
+	}
+
+	for _, domain := range unstable {
+		testhelpers.MustNotApply(t, ruleSet.Any(), domain, errors.CodePattern)
+	}
+
+	testhelpers.MustApply(t, net.Domain().Any(), unstable[0])
+	testhelpers.MustApply(t, net.Domain().Any(), unstable[1])
+}
+
+// Requirements:
+// - Serializes to WithStrictIDNA()
+func TestDomainWithStrictIDNAString(t *testing.T) {
+	ruleSet := net.Domain().WithStrictIDNA().WithStrictIDNA().WithRequired()
+
+	expected := "DomainRuleSet.WithStrictIDNA().WithRequired()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Labels over the custom limit return a max error.
+// - Domains over the custom limit return a max error.
+// - Limits are kept by child rule sets.
+// - Serializes to WithMaxLabelLength(n) and WithMaxLength(n).
+func TestDomainWithMaxLength(t *testing.T) {
+	ruleSet := net.Domain().WithMaxLabelLength(8).WithMaxLength(20).WithRequired()
+
+	testhelpers.MustApply(t, ruleSet.Any(), "abcdefgh.com")
+	testhelpers.MustNotApply(t, ruleSet.Any(), "abcdefghi.com", errors.CodeMax)
+	testhelpers.MustApply(t, ruleSet.Any(), "abcdefgh.abcdefg.com")
+	testhelpers.MustNotApply(t, ruleSet.Any(), "abcdefgh.abcdefgh.com", errors.CodeMax)
+
+	// Labels over the default limit are still pattern errors
+	testhelpers.MustNotApply(t, net.Domain().Any(), strings.Repeat("a", 64)+".com", errors.CodePattern)
+
+	expected := "DomainRuleSet.WithMaxLabelLength(8).WithMaxLength(20).WithRequired()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Panics if the limits are above the RFC limits or less than 1.
+func TestDomainWithMaxLengthInvalid(t *testing.T) {
+	for _, fn := range []func(){
+		func() { net.Domain().WithMaxLabelLength(64) },
+		func() { net.Domain().WithMaxLabelLength(0) },
+		func() { net.Domain().WithMaxLength(256) },
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Expected panic")
+				}
+			}()
+			fn()
+		}()
+	}
+}
+
+// Requirements:
+// - Rules that only return warnings do not prevent the output from being set.
+// - The warnings are returned.
+func TestDomain_Warning(t *testing.T) {
+	warn := func(ctx context.Context, _ string) errors.ValidationErrorCollection {
+		return errors.Collection(errors.WithSeverity(errors.Errorf(errors.CodeUnknown, ctx, "just a warning"), errors.SeverityWarning))
+	}
+
+	var out string
+	errs := net.Domain().WithRuleFunc(warn).Apply(context.Background(), "example.com", &out)
+	if errs.HasErrors() || len(errs.Warnings()) != 1 {
+		t.Errorf("Expected 1 warning and no errors, got: %s", errs)
+	} else if out != "example.com" {
+		t.Errorf("Expected output to be set, got: %v", out)
+	}
+}
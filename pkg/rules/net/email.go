@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"strings"
 
+	"proto.zip/studio/validate/internal/util"
 	"proto.zip/studio/validate/pkg/errors"
 	"proto.zip/studio/validate/pkg/rulecontext"
 	"proto.zip/studio/validate/pkg/rules"
@@ -14,6 +15,8 @@ import (
 type EmailRuleSet struct {
 	rules.NoConflict[string]
 	required      bool
+	text          bool
+	withNil       bool
 	parent        *EmailRuleSet
 	rule          rules.Rule[string]
 	domainRuleSet rules.RuleSet[string]
@@ -40,24 +43,67 @@ func (ruleSet *EmailRuleSet) Required() bool {
 func (ruleSet *EmailRuleSet) WithRequired() *EmailRuleSet {
 	return &EmailRuleSet{
 		required:      true,
+		text:          ruleSet.text,
+		withNil:       ruleSet.withNil,
 		parent:        ruleSet,
 		domainRuleSet: ruleSet.domainRuleSet,
 		label:         "WithRequired()",
 	}
 }
 
+// WithNil returns a new rule set that accepts a nil input, such as a JSON null or a nil *string, for optional email
+// addresses. The address is not parsed and the output is left unchanged. By default nil is a type error.
+func (ruleSet *EmailRuleSet) WithNil() *EmailRuleSet {
+	if ruleSet.withNil {
+		return ruleSet
+	}
+
+	return &EmailRuleSet{
+		required:      ruleSet.required,
+		text:          ruleSet.text,
+		withNil:       true,
+		parent:        ruleSet,
+		domainRuleSet: ruleSet.domainRuleSet,
+		label:         "WithNil()",
+	}
+}
+
+// WithTextCoercion returns a new rule set that accepts values implementing encoding.TextMarshaler or fmt.Stringer
+// and validates their text representation. TextMarshaler is preferred if the value implements both. If MarshalText
+// returns an error, Apply returns an errors.CodeType error.
+func (ruleSet *EmailRuleSet) WithTextCoercion() *EmailRuleSet {
+	if ruleSet.text {
+		return ruleSet
+	}
+
+	return &EmailRuleSet{
+		required:      ruleSet.required,
+		text:          true,
+		withNil:       ruleSet.withNil,
+		parent:        ruleSet,
+		domainRuleSet: ruleSet.domainRuleSet,
+		label:         "WithTextCoercion()",
+	}
+}
+
 // Apply performs a validation of a RuleSet against a value and assigns the result to the output parameter.
 // It returns a ValidationErrorCollection if any validation errors occur.
 func (ruleSet *EmailRuleSet) Apply(ctx context.Context, input any, output any) errors.ValidationErrorCollection {
+	// Nil is only allowed with WithNil
+	if ruleSet.withNil && util.IsNil(input) {
+		return nil
+	}
+
 	// Attempt to cast the input to a string
-	valueStr, ok := input.(string)
-	if !ok {
-		return errors.Collection(errors.NewCoercionError(ctx, "string", reflect.ValueOf(input).Kind().String()))
+	valueStr, coerceErr := coerceString(ctx, input, ruleSet.text)
+	if coerceErr != nil {
+		return errors.Collection(coerceErr)
 	}
 
 	// Perform the validation
-	if err := ruleSet.Evaluate(ctx, valueStr); err != nil {
-		return err
+	errs := ruleSet.Evaluate(ctx, valueStr)
+	if errs.HasErrors() {
+		return errs
 	}
 
 	outputVal := reflect.ValueOf(output)
@@ -83,7 +129,7 @@ func (ruleSet *EmailRuleSet) Apply(ctx context.Context, input any, output any) e
 		))
 	}
 
-	return nil
+	return errs
 }
 
 // validateBasicEmail performs general domain validation that is valid for any and all domains.
@@ -176,6 +222,8 @@ func (ruleSet *EmailRuleSet) WithDomain(domainRuleSet rules.RuleSet[string]) *Em
 	return &EmailRuleSet{
 		parent:        ruleSet,
 		required:      ruleSet.required,
+		text:          ruleSet.text,
+		withNil:       ruleSet.withNil,
 		domainRuleSet: domainRuleSet,
 	}
 }
@@ -190,6 +238,8 @@ func (ruleSet *EmailRuleSet) WithRule(rule rules.Rule[string]) *EmailRuleSet {
 		rule:          rule,
 		parent:        ruleSet,
 		required:      ruleSet.required,
+		text:          ruleSet.text,
+		withNil:       ruleSet.withNil,
 		domainRuleSet: ruleSet.domainRuleSet,
 	}
 }
@@ -199,3 +199,20 @@ func TestEmailDomainContext(t *testing.T) {
 		t.Errorf("Expected path to be %s, got: %s", expected, s)
 	}
 }
+
+// Requirements:
+// - Rules that only return warnings do not prevent the output from being set.
+// - The warnings are returned.
+func TestEmail_Warning(t *testing.T) {
+	warn := func(ctx context.Context, _ string) errors.ValidationErrorCollection {
+		return errors.Collection(errors.WithSeverity(errors.Errorf(errors.CodeUnknown, ctx, "just a warning"), errors.SeverityWarning))
+	}
+
+	var out string
+	errs := net.Email().WithRuleFunc(warn).Apply(context.Background(), "hello@example.com", &out)
+	if errs.HasErrors() || len(errs.Warnings()) != 1 {
+		t.Errorf("Expected 1 warning and no errors, got: %s", errs)
+	} else if out != "hello@example.com" {
+		t.Errorf("Expected output to be set, got: %v", out)
+	}
+}
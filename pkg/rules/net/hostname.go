@@ -0,0 +1,328 @@
+package net
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/idna"
+	"proto.zip/studio/validate/internal/util"
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// baseHostnameRuleSet is the base hostname rule set. Since rule sets are immutable.
+var baseHostnameRuleSet HostnameRuleSet = HostnameRuleSet{
+	label: "HostnameRuleSet",
+}
+
+// hostnameLabelPattern matches valid hostname labels after they have been converted to punycode.
+// Unlike domains, labels may start with a digit (RFC 1123).
+var hostnameLabelPattern = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// hostnameUnderscoreLabelPattern is the same as hostnameLabelPattern but also allows underscores.
+var hostnameUnderscoreLabelPattern = regexp.MustCompile(`^[a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?$`)
+
+// HostnameRuleSet implements the RuleSet interface for RFC 1123 hostnames.
+//
+// Hostnames are less strict than domains. Labels may start with a digit and single label hostnames such as
+// "localhost" are allowed.
+type HostnameRuleSet struct {
+	rules.NoConflict[string]
+	required        bool
+	allowUnderscore bool
+	requireFQDN     bool
+	text            bool
+	withNil         bool
+	parent          *HostnameRuleSet
+	rule            rules.Rule[string]
+	label           string
+}
+
+// Hostname returns the base hostname RuleSet.
+func Hostname() *HostnameRuleSet {
+	return &baseHostnameRuleSet
+}
+
+// withParent returns a new child rule set with the flags copied from the current rule set.
+func (ruleSet *HostnameRuleSet) withParent() *HostnameRuleSet {
+	return &HostnameRuleSet{
+		required:        ruleSet.required,
+		allowUnderscore: ruleSet.allowUnderscore,
+		requireFQDN:     ruleSet.requireFQDN,
+		text:            ruleSet.text,
+		withNil:         ruleSet.withNil,
+		parent:          ruleSet,
+	}
+}
+
+// Required returns a boolean indicating if the value is allowed to be omitted when included in a nested object.
+func (ruleSet *HostnameRuleSet) Required() bool {
+	return ruleSet.required
+}
+
+// WithRequired returns a new rule set with the required flag set.
+// Use WithRequired when nesting a RuleSet and the a value is not allowed to be omitted.
+func (ruleSet *HostnameRuleSet) WithRequired() *HostnameRuleSet {
+	newRuleSet := ruleSet.withParent()
+	newRuleSet.required = true
+	newRuleSet.label = "WithRequired()"
+	return newRuleSet
+}
+
+// WithAllowUnderscore returns a new rule set that allows underscores in the hostname labels.
+// This is useful for SRV style names such as "_sip._tcp.example.com".
+func (ruleSet *HostnameRuleSet) WithAllowUnderscore() *HostnameRuleSet {
+	if ruleSet.allowUnderscore {
+		return ruleSet
+	}
+
+	newRuleSet := ruleSet.withParent()
+	newRuleSet.allowUnderscore = true
+	newRuleSet.label = "WithAllowUnderscore()"
+	return newRuleSet
+}
+
+// WithRequireFQDN returns a new rule set that requires the hostname to be fully qualified.
+// A hostname is considered fully qualified if it ends with a dot or has more than one label.
+func (ruleSet *HostnameRuleSet) WithRequireFQDN() *HostnameRuleSet {
+	if ruleSet.requireFQDN {
+		return ruleSet
+	}
+
+	newRuleSet := ruleSet.withParent()
+	newRuleSet.requireFQDN = true
+	newRuleSet.label = "WithRequireFQDN()"
+	return newRuleSet
+}
+
+// WithNil returns a new rule set that skips validation for a nil input, such as a JSON null or a nil *string, and
+// leaves the output unchanged. By default a nil hostname returns an errors.CodeType error.
+func (ruleSet *HostnameRuleSet) WithNil() *HostnameRuleSet {
+	if ruleSet.withNil {
+		return ruleSet
+	}
+
+	newRuleSet := ruleSet.withParent()
+	newRuleSet.withNil = true
+	newRuleSet.label = "WithNil()"
+	return newRuleSet
+}
+
+// WithTextCoercion returns a new rule set that accepts values implementing encoding.TextMarshaler or fmt.Stringer
+// and validates their text representation. TextMarshaler is preferred if the value implements both. If MarshalText
+// returns an error, Apply returns an errors.CodeType error.
+func (ruleSet *HostnameRuleSet) WithTextCoercion() *HostnameRuleSet {
+	if ruleSet.text {
+		return ruleSet
+	}
+
+	newRuleSet := ruleSet.withParent()
+	newRuleSet.text = true
+	newRuleSet.label = "WithTextCoercion()"
+	return newRuleSet
+}
+
+// Apply performs a validation of a RuleSet against a value and assigns the result to the output parameter.
+// It returns a ValidationErrorCollection if any validation errors occur.
+func (ruleSet *HostnameRuleSet) Apply(ctx context.Context, input any, output any) errors.ValidationErrorCollection {
+	// Nil is only allowed with WithNil
+	if ruleSet.withNil && util.IsNil(input) {
+		return nil
+	}
+
+	// Attempt to cast the input to a string
+	valueStr, coerceErr := coerceString(ctx, input, ruleSet.text)
+	if coerceErr != nil {
+		return errors.Collection(coerceErr)
+	}
+
+	// Perform the validation
+	errs := ruleSet.Evaluate(ctx, valueStr)
+	if errs.HasErrors() {
+		return errs
+	}
+
+	outputVal := reflect.ValueOf(output)
+
+	// Check if the output is a non-nil pointer
+	if outputVal.Kind() != reflect.Ptr || outputVal.IsNil() {
+		return errors.Collection(errors.Errorf(
+			errors.CodeInternal, ctx, "Output must be a non-nil pointer",
+		))
+	}
+
+	// Dereference the pointer to get the actual value that needs to be set
+	outputElem := outputVal.Elem()
+
+	switch outputElem.Kind() {
+	case reflect.String:
+		outputElem.SetString(valueStr)
+	case reflect.Interface:
+		outputElem.Set(reflect.ValueOf(valueStr))
+	default:
+		return errors.Collection(errors.Errorf(
+			errors.CodeInternal, ctx, "Cannot assign string to %T", output,
+		))
+	}
+
+	return errs
+}
+
+// validateHostname performs the RFC 1123 hostname validation.
+// This function always returns a collection even if it is empty.
+func (ruleSet *HostnameRuleSet) validateHostname(ctx context.Context, value string) errors.ValidationErrorCollection {
+	allErrors := errors.Collection()
+
+	// A single trailing dot denotes the root and is not part of the labels
+	fqdn := strings.HasSuffix(value, ".")
+	value = strings.TrimSuffix(value, ".")
+
+	// Convert to punycode
+	punycode, err := idna.ToASCII(value)
+
+	if err != nil {
+		allErrors = append(allErrors, errors.Errorf(errors.CodePattern, ctx, "hostname contains invalid unicode"))
+		return allErrors
+	}
+
+	// Check total length
+	if len(punycode) > 253 {
+		allErrors = append(allErrors, errors.Errorf(errors.CodeMax, ctx, "hostname exceeds maximum length"))
+		return allErrors
+	}
+
+	// Each labels should contain only valid characters
+	parts := strings.Split(punycode, ".")
+
+	pattern := hostnameLabelPattern
+	if ruleSet.allowUnderscore {
+		pattern = hostnameUnderscoreLabelPattern
+	}
+
+	for _, part := range parts {
+		if !pattern.MatchString(part) {
+			allErrors = append(allErrors, errors.Errorf(errors.CodePattern, ctx, "hostname segment is invalid"))
+			return allErrors
+		}
+	}
+
+	if ruleSet.requireFQDN && !fqdn && len(parts) < 2 {
+		allErrors = append(allErrors, errors.Errorf(errors.CodePattern, ctx, "hostname must be fully qualified"))
+	}
+
+	return allErrors
+}
+
+// Evaluate performs a validation of a RuleSet against a string and returns an object value of the
+// same type or a ValidationErrorCollection.
+func (ruleSet *HostnameRuleSet) Evaluate(ctx context.Context, value string) errors.ValidationErrorCollection {
+	allErrors := ruleSet.validateHostname(ctx, value)
+
+	if len(allErrors) > 0 {
+		return allErrors
+	}
+
+	currentRuleSet := ruleSet
+	ctx = rulecontext.WithRuleSet(ctx, ruleSet)
+
+	for currentRuleSet != nil {
+		if currentRuleSet.rule != nil {
+			if errs := currentRuleSet.rule.Evaluate(ctx, value); errs != nil {
+				allErrors = append(allErrors, errs...)
+			}
+		}
+
+		currentRuleSet = currentRuleSet.parent
+	}
+
+	if len(allErrors) > 0 {
+		return allErrors
+	} else {
+		return nil
+	}
+}
+
+// noConflict returns the new array rule set with all conflicting rules removed.
+// Does not mutate the existing rule sets.
+func (ruleSet *HostnameRuleSet) noConflict(rule rules.Rule[string]) *HostnameRuleSet {
+	if ruleSet.rule != nil {
+
+		// Conflicting rules, skip this and return the parent
+		if rule.Conflict(ruleSet.rule) {
+			return ruleSet.parent.noConflict(rule)
+		}
+
+	}
+
+	if ruleSet.parent == nil {
+		return ruleSet
+	}
+
+	newParent := ruleSet.parent.noConflict(rule)
+
+	if newParent == ruleSet.parent {
+		return ruleSet
+	}
+
+	return &HostnameRuleSet{
+		rule:            ruleSet.rule,
+		parent:          newParent,
+		required:        ruleSet.required,
+		allowUnderscore: ruleSet.allowUnderscore,
+		requireFQDN:     ruleSet.requireFQDN,
+		text:            ruleSet.text,
+		withNil:         ruleSet.withNil,
+		label:           ruleSet.label,
+	}
+}
+
+// WithRule returns a new child rule set with a rule added to the list of
+// rules to evaluate. WithRule takes an implementation of the Rule interface
+// for the string type.
+//
+// Use this when implementing custom rules.
+func (ruleSet *HostnameRuleSet) WithRule(rule rules.Rule[string]) *HostnameRuleSet {
+	return &HostnameRuleSet{
+		rule:            rule,
+		parent:          ruleSet.noConflict(rule),
+		required:        ruleSet.required,
+		allowUnderscore: ruleSet.allowUnderscore,
+		requireFQDN:     ruleSet.requireFQDN,
+		text:            ruleSet.text,
+		withNil:         ruleSet.withNil,
+	}
+}
+
+// WithRuleFunc returns a new child rule set with a rule added to the list of
+// rules to evaluate. WithRuleFunc takes an implementation of the Rule interface
+// for the string type.
+//
+// Use this when implementing custom rules.
+func (v *HostnameRuleSet) WithRuleFunc(rule rules.RuleFunc[string]) *HostnameRuleSet {
+	return v.WithRule(rule)
+}
+
+// Any returns a new RuleSet that wraps the hostname RuleSet in any Any rule set
+// which can then be used in nested validation.
+func (ruleSet *HostnameRuleSet) Any() rules.RuleSet[any] {
+	return rules.WrapAny[string](ruleSet)
+}
+
+// String returns a string representation of the rule set suitable for debugging.
+func (ruleSet *HostnameRuleSet) String() string {
+	label := ruleSet.label
+
+	if label == "" {
+		if ruleSet.rule != nil {
+			label = ruleSet.rule.String()
+		}
+	}
+
+	if ruleSet.parent != nil {
+		return ruleSet.parent.String() + "." + label
+	}
+	return label
+}
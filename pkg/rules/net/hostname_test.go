@@ -0,0 +1,173 @@
+package net_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules/net"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Default configuration doesn't return errors on valid value.
+// - Implements interface.
+func TestHostnameRuleSet(t *testing.T) {
+	var output string
+
+	example := "example.com"
+
+	err := net.Hostname().Apply(context.TODO(), example, &output)
+	if err != nil {
+		t.Errorf("Expected errors to be empty, got: %s", err)
+		return
+	}
+
+	if output != example {
+		t.Error("Expected test hostname to be returned")
+		return
+	}
+
+	ok := testhelpers.CheckRuleSetInterface[string](net.Hostname())
+	if !ok {
+		t.Error("Expected rule set to be implemented")
+		return
+	}
+
+	testhelpers.MustApplyTypes[string](t, net.Hostname(), example)
+}
+
+// Requirements:
+// - Labels may start with a digit.
+// - Single label hostnames are allowed.
+// - Single character labels are allowed.
+// - A trailing dot is allowed.
+// - Labels may not start or end with a hyphen.
+// - Empty labels are not allowed.
+// See: RFC 1123
+func TestHostnameLabels(t *testing.T) {
+	ruleSet := net.Hostname().Any()
+
+	testhelpers.MustApply(t, ruleSet, "3dns.example.com")
+	testhelpers.MustApply(t, ruleSet, "localhost")
+	testhelpers.MustApply(t, ruleSet, "a.b.c")
+	testhelpers.MustApply(t, ruleSet, "example.com.")
+	testhelpers.MustApply(t, ruleSet, "bücher.example")
+
+	testhelpers.MustNotApply(t, ruleSet, "-example.com", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet, "example-.com", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet, "example..com", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet, "", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet, "_sip._tcp.example.com", errors.CodePattern)
+}
+
+// Requirements:
+// - Labels cannot exceed 63 characters.
+// - Hostnames cannot exceed 253 characters.
+// - errors.CodeMax is returned for long hostnames.
+func TestHostnameLength(t *testing.T) {
+	ruleSet := net.Hostname().Any()
+
+	testhelpers.MustApply(t, ruleSet, strings.Repeat("a", 63)+".com")
+	testhelpers.MustNotApply(t, ruleSet, strings.Repeat("a", 64)+".com", errors.CodePattern)
+
+	// 4 * 63 + 1 = 253
+	label := strings.Repeat("a", 62) + "."
+	testhelpers.MustApply(t, ruleSet, strings.Repeat(label, 4)+"a")
+	testhelpers.MustNotApply(t, ruleSet, strings.Repeat(label, 4)+"ab", errors.CodeMax)
+}
+
+// Requirements:
+// - WithAllowUnderscore allows underscores in labels.
+func TestHostnameWithAllowUnderscore(t *testing.T) {
+	ruleSet := net.Hostname().WithAllowUnderscore().Any()
+
+	testhelpers.MustApply(t, ruleSet, "_sip._tcp.example.com")
+	testhelpers.MustApply(t, ruleSet, "my_host")
+	testhelpers.MustNotApply(t, ruleSet, "-sip.example.com", errors.CodePattern)
+}
+
+// Requirements:
+// - WithRequireFQDN requires more than one label or a trailing dot.
+func TestHostnameWithRequireFQDN(t *testing.T) {
+	ruleSet := net.Hostname().WithRequireFQDN().Any()
+
+	testhelpers.MustApply(t, ruleSet, "example.com")
+	testhelpers.MustApply(t, ruleSet, "localhost.")
+	testhelpers.MustNotApply(t, ruleSet, "localhost", errors.CodePattern)
+}
+
+// Requirements:
+// - Errors when input is not a string.
+// - errors.CodeType is returned.
+func TestHostnameType(t *testing.T) {
+	testhelpers.MustNotApply(t, net.Hostname().Any(), 123, errors.CodeType)
+}
+
+// Requirements:
+// - Required flag can be set.
+// - Required flag defaults to false.
+// - Flags are kept when rules are added.
+func TestHostnameRequired(t *testing.T) {
+	ruleSet := net.Hostname()
+
+	if ruleSet.Required() {
+		t.Error("Expected rule set to not be required")
+	}
+
+	ruleSet = ruleSet.WithRequired().WithAllowUnderscore().WithRuleFunc(testhelpers.NewMockRule[string]().Function())
+
+	if !ruleSet.Required() {
+		t.Error("Expected rule set to be required")
+	}
+
+	testhelpers.MustApply(t, ruleSet.Any(), "_srv.example.com")
+}
+
+// Requirements:
+// - Custom rules are evaluated.
+func TestHostnameCustom(t *testing.T) {
+	mock := testhelpers.NewMockRuleWithErrors[string](1)
+
+	var output string
+	err := net.Hostname().
+		WithRuleFunc(mock.Function()).
+		Apply(context.TODO(), "example.com", &output)
+
+	if err == nil {
+		t.Error("Expected errors to not be empty")
+	}
+
+	if c := mock.EvaluateCallCount(); c != 1 {
+		t.Errorf("Expected rule to be called once, got %d", c)
+	}
+}
+
+// Requirements:
+// - Serializes flags.
+func TestHostnameString(t *testing.T) {
+	ruleSet := net.Hostname().WithRequired().WithAllowUnderscore().WithRequireFQDN()
+
+	expected := "HostnameRuleSet.WithRequired().WithAllowUnderscore().WithRequireFQDN()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Rules that only return warnings do not prevent the output from being set.
+// - The warnings are returned.
+func TestHostname_Warning(t *testing.T) {
+	warn := func(ctx context.Context, _ string) errors.ValidationErrorCollection {
+		return errors.Collection(errors.WithSeverity(errors.Errorf(errors.CodeUnknown, ctx, "just a warning"), errors.SeverityWarning))
+	}
+
+	var out string
+	errs := net.Hostname().WithRuleFunc(warn).Apply(context.Background(), "example", &out)
+	if errs.HasErrors() || len(errs.Warnings()) != 1 {
+		t.Errorf("Expected 1 warning and no errors, got: %s", errs)
+	} else if out != "example" {
+		t.Errorf("Expected output to be set, got: %v", out)
+	}
+}
@@ -0,0 +1,60 @@
+package net_test
+
+import (
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/rules/net"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Nil inputs are type errors by default.
+// - With WithNil nil and nil pointer inputs are accepted and the output is left nil.
+// - Non-nil values are still validated.
+// - Nullable keys are accepted in objects.
+// - Serializes to WithNil().
+func TestWithNil(t *testing.T) {
+	cases := []struct {
+		name      string
+		base      rules.RuleSet[any]
+		ruleSet   rules.RuleSet[any]
+		invalid   string
+		serialize string
+	}{
+		{"domain", net.Domain().Any(), net.Domain().WithNil().Any(), "-example", "DomainRuleSet.WithNil().Any()"},
+		{"email", net.Email().Any(), net.Email().WithNil().Any(), "example.com", "EmailRuleSet.WithNil().Any()"},
+		{"hostname", net.Hostname().Any(), net.Hostname().WithNil().Any(), "-host", "HostnameRuleSet.WithNil().Any()"},
+		{"uri", net.URI().Any(), net.URI().WithNil().Any(), "://", "URIRuleSet.WithNil().Any()"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var nilString *string
+
+			testhelpers.MustNotApply(t, tc.base, nil, errors.CodeType)
+
+			for _, input := range []any{nil, nilString} {
+				var out any
+				if err := tc.ruleSet.Apply(context.Background(), input, &out); err != nil {
+					t.Errorf("Expected nil to be valid, got: %s", err)
+				} else if out != nil {
+					t.Errorf("Expected output to be nil, got: %v", out)
+				}
+			}
+
+			if err := tc.ruleSet.Apply(context.Background(), tc.invalid, new(string)); err == nil {
+				t.Error("Expected invalid value to return errors")
+			}
+
+			object := rules.StringMap[any]().WithKey("value", tc.ruleSet)
+			testhelpers.MustApplyAny(t, object.Any(), map[string]any{"value": nil})
+
+			if s := tc.ruleSet.String(); s != tc.serialize {
+				t.Errorf("Expected rule set to be %s, got %s", tc.serialize, s)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package net
+
+import (
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// basePortRuleSet is the base port rule set. Since rule sets are immutable.
+var basePortRuleSet *rules.IntRuleSet[int] = rules.Int().WithMin(0).WithMax(65535)
+
+// Port returns a RuleSet for network port numbers.
+//
+// Ports must be between 0 and 65535. Strings are coerced to integers so the rule set can be used with the port
+// portion of a "host:port" string. Additional rules, such as WithAllowedValues, can be added to the returned
+// rule set.
+func Port() *rules.IntRuleSet[int] {
+	return basePortRuleSet
+}
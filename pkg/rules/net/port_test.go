@@ -0,0 +1,41 @@
+package net_test
+
+import (
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules/net"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Valid ports pass.
+// - Strings are coerced to integers.
+// - Out of range ports fail.
+// - Non-numeric values fail.
+func TestPort(t *testing.T) {
+	ruleSet := net.Port().Any()
+
+	testhelpers.MustApply(t, ruleSet, 0)
+	testhelpers.MustApply(t, ruleSet, 65535)
+	testhelpers.MustNotApply(t, ruleSet, -1, errors.CodeMin)
+	testhelpers.MustNotApply(t, ruleSet, 65536, errors.CodeMax)
+	testhelpers.MustNotApply(t, ruleSet, "http", errors.CodeType)
+
+	var output int
+	if err := net.Port().Apply(context.TODO(), "8080", &output); err != nil {
+		t.Errorf("Expected errors to be empty, got: %s", err)
+	} else if output != 8080 {
+		t.Errorf("Expected output to be 8080, got: %d", output)
+	}
+}
+
+// Requirements:
+// - Additional rules can be added to the port rule set.
+func TestPortWithAllowedValues(t *testing.T) {
+	ruleSet := net.Port().WithAllowedValues(80, 443).Any()
+
+	testhelpers.MustApplyMutation(t, ruleSet, "443", 443)
+	testhelpers.MustNotApply(t, ruleSet, "8080", errors.CodeNotAllowed)
+}
@@ -0,0 +1,84 @@
+package net
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/idna"
+	"proto.zip/studio/validate/internal/util"
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// Implements the Rule interface for an allowed list of top level domains.
+type domainTLDsRule struct {
+	tlds []string // tlds holds the sorted and normalized top level domains.
+}
+
+// Evaluate takes a context and string value and returns an error if the top level domain is not in the allowed list.
+func (rule *domainTLDsRule) Evaluate(ctx context.Context, value string) errors.ValidationErrorCollection {
+	// Convert to punycode
+	punycode, _ := idna.ToASCII(value)
+
+	tld := punycode[strings.LastIndex(punycode, ".")+1:]
+
+	if _, found := slices.BinarySearch(rule.tlds, strings.ToUpper(tld)); !found {
+		return errors.Collection(
+			errors.Errorf(errors.CodeNotAllowed, ctx, "top level domain is not allowed"),
+		)
+	}
+
+	return nil
+}
+
+// Conflict returns true for any allowed top level domains rule.
+func (rule *domainTLDsRule) Conflict(x rules.Rule[string]) bool {
+	_, ok := x.(*domainTLDsRule)
+	return ok
+}
+
+// String returns the string representation of the allowed top level domains rule.
+// Example: WithAllowedTLDs("COM", "NET", "ORG")
+func (rule *domainTLDsRule) String() string {
+	return util.StringsToRuleOutput("WithAllowedTLDs", rule.tlds)
+}
+
+// getTLDsRule returns the previously defined allowed top level domains rule or nil if there is none.
+func (ruleSet *DomainRuleSet) getTLDsRule() *domainTLDsRule {
+	for currentRuleSet := ruleSet; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if tldsRule, ok := currentRuleSet.rule.(*domainTLDsRule); ok {
+			return tldsRule
+		}
+	}
+	return nil
+}
+
+// WithAllowedTLDs returns a new child RuleSet that only allows domains with one of the provided
+// top level domains.
+//
+// Top level domain matching is case insensitive and unicode values are converted to punycode.
+// This method can be called more than once and the allowed top level domains are cumulative.
+//
+// WithAllowedTLDs will panic if any of the values are not valid domain labels.
+func (v *DomainRuleSet) WithAllowedTLDs(tld string, rest ...string) *DomainRuleSet {
+	tlds := make([]string, 0, 1+len(rest))
+
+	if existing := v.getTLDsRule(); existing != nil {
+		tlds = append(tlds, existing.tlds...)
+	}
+
+	for _, value := range append([]string{tld}, rest...) {
+		punycode, err := idna.ToASCII(strings.TrimPrefix(value, "."))
+		if err != nil {
+			panic(err)
+		}
+		tlds = append(tlds, strings.ToUpper(punycode))
+	}
+
+	slices.Sort(tlds)
+
+	return v.WithRule(&domainTLDsRule{
+		tlds: slices.Compact(tlds),
+	})
+}
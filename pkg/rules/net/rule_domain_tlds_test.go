@@ -0,0 +1,62 @@
+package net_test
+
+import (
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules/net"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Domains with an allowed TLD pass.
+// - Domains with other TLDs fail with errors.CodeNotAllowed.
+// - TLD matching is case insensitive.
+// - Unicode TLDs are matched after punycode conversion.
+func TestDomainWithAllowedTLDs(t *testing.T) {
+	ruleSet := net.Domain().WithAllowedTLDs("com", "ORG", "рф").Any()
+
+	testhelpers.MustApply(t, ruleSet, "example.com")
+	testhelpers.MustApply(t, ruleSet, "example.CoM")
+	testhelpers.MustApply(t, ruleSet, "example.org")
+	testhelpers.MustApply(t, ruleSet, "example.рф")
+	testhelpers.MustApply(t, ruleSet, "example.xn--p1ai")
+	testhelpers.MustNotApply(t, ruleSet, "example.net", errors.CodeNotAllowed)
+}
+
+// Requirements:
+// - Structural errors are returned before the TLD is checked.
+func TestDomainWithAllowedTLDsInvalidDomain(t *testing.T) {
+	ruleSet := net.Domain().WithAllowedTLDs("com").Any()
+
+	testhelpers.MustNotApply(t, ruleSet, "-example.net", errors.CodePattern)
+}
+
+// Requirements:
+// - Allowed TLDs are cumulative.
+// - Only one rule is kept.
+// - Serializes the normalized values.
+func TestDomainWithAllowedTLDsUnion(t *testing.T) {
+	ruleSet := net.Domain().WithAllowedTLDs("com").WithRequired().WithAllowedTLDs("net", "com")
+
+	testhelpers.MustApply(t, ruleSet.Any(), "example.com")
+	testhelpers.MustApply(t, ruleSet.Any(), "example.net")
+	testhelpers.MustNotApply(t, ruleSet.Any(), "example.org", errors.CodeNotAllowed)
+
+	expected := `DomainRuleSet.WithRequired().WithAllowedTLDs("COM", "NET")`
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Panics when a TLD cannot be encoded as punycode.
+func TestDomainWithAllowedTLDsPunycodeError(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+
+	net.Domain().WithAllowedTLDs("xn--é")
+}
@@ -0,0 +1,113 @@
+package net
+
+import (
+	"context"
+	stderrors "errors"
+	stdnet "net"
+	"strings"
+
+	"golang.org/x/net/idna"
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// Implements the Rule interface for checking that an email domain can receive mail.
+type mxRule struct {
+	failOpen bool
+}
+
+// Evaluate takes a context and an email address and returns an error if the domain has no MX records and no
+// A or AAAA records to fall back to.
+func (rule *mxRule) Evaluate(ctx context.Context, value string) errors.ValidationErrorCollection {
+	domain := value[strings.LastIndex(value, "@")+1:]
+	if punycode, err := idna.ToASCII(domain); err == nil {
+		domain = punycode
+	}
+
+	resolver := rulecontext.Resolver(ctx)
+
+	mx, err := resolver.LookupMX(ctx, domain)
+	if err != nil && !isNotFound(err) {
+		return rule.lookupError(ctx, err)
+	}
+
+	// A single record with a host of "." is a null MX and means the domain does not accept mail (RFC 7505).
+	if len(mx) == 1 && (mx[0].Host == "." || mx[0].Host == "") {
+		return errors.Collection(errors.Errorf(errors.CodeNotFound, ctx, "domain does not accept email"))
+	}
+	if len(mx) > 0 {
+		return nil
+	}
+
+	// Without MX records mail is delivered to the domain itself (RFC 5321).
+	hosts, err := resolver.LookupHost(ctx, domain)
+	if err != nil && !isNotFound(err) {
+		return rule.lookupError(ctx, err)
+	}
+	if len(hosts) > 0 {
+		return nil
+	}
+
+	return errors.Collection(errors.Errorf(errors.CodeNotFound, ctx, "domain does not have any mail servers"))
+}
+
+// lookupError returns the error for a lookup that failed for a reason other than the records not existing.
+func (rule *mxRule) lookupError(ctx context.Context, err error) errors.ValidationErrorCollection {
+	switch ctx.Err() {
+	case context.DeadlineExceeded:
+		return errors.Collection(errors.Errorf(errors.CodeTimeout, ctx, "validation timed out before completing"))
+	case context.Canceled:
+		return errors.Collection(errors.Errorf(errors.CodeCancelled, ctx, "validation was cancelled"))
+	}
+
+	if rule.failOpen {
+		return nil
+	}
+	return errors.Collection(errors.Errorf(errors.CodeUnavailable, ctx, "unable to look up mail servers"))
+}
+
+// isNotFound returns true if the error is a DNS error for a name or record that does not exist.
+func isNotFound(err error) bool {
+	var dnsErr *stdnet.DNSError
+	return stderrors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
+
+// Conflict returns true for any MX rule.
+func (rule *mxRule) Conflict(x rules.Rule[string]) bool {
+	_, ok := x.(*mxRule)
+	return ok
+}
+
+// String returns the string representation of the MX rule.
+// Example: WithMXCheck()
+func (rule *mxRule) String() string {
+	if rule.failOpen {
+		return "WithMXCheckFailOpen()"
+	}
+	return "WithMXCheck()"
+}
+
+// WithMXCheck returns a new child rule set that looks up the MX records of the email domain and returns an
+// errors.CodeNotFound error if there are none. Domains without MX records are still accepted if they have an A or
+// AAAA record since mail is delivered to the domain itself. A null MX record is treated as no records.
+//
+// The lookup uses the resolver from rulecontext.Resolver, which is net.DefaultResolver unless one is set with
+// rulecontext.WithResolver. The context deadline and cancellation are passed to the resolver.
+//
+// If the lookup fails for another reason, such as a network error, an errors.CodeUnavailable error is returned so
+// it can be told apart from an invalid address and retried with rules.WithRetry. Use WithMXCheckFailOpen to accept
+// the value instead.
+//
+// This rule performs network I/O and can be slow. It is only run if the address is well formed.
+func (ruleSet *EmailRuleSet) WithMXCheck() *EmailRuleSet {
+	return ruleSet.WithRule(&mxRule{})
+}
+
+// WithMXCheckFailOpen is the same as WithMXCheck except that lookup failures other than missing records do not
+// return an error. Use this when a DNS outage should not block users from signing up.
+//
+// This rule performs network I/O and can be slow.
+func (ruleSet *EmailRuleSet) WithMXCheckFailOpen() *EmailRuleSet {
+	return ruleSet.WithRule(&mxRule{failOpen: true})
+}
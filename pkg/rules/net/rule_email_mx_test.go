@@ -0,0 +1,129 @@
+package net_test
+
+import (
+	"context"
+	stdnet "net"
+	"testing"
+	"time"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+	"proto.zip/studio/validate/pkg/rules/net"
+)
+
+// fakeResolver answers lookups from maps and returns a not found error for unknown names.
+type fakeResolver struct {
+	mx    map[string][]*stdnet.MX
+	hosts map[string][]string
+	err   error
+	calls []string
+}
+
+func (r *fakeResolver) LookupMX(ctx context.Context, name string) ([]*stdnet.MX, error) {
+	r.calls = append(r.calls, name)
+	if r.err != nil {
+		return nil, r.err
+	}
+	if mx, ok := r.mx[name]; ok {
+		return mx, nil
+	}
+	return nil, &stdnet.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func (r *fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	if hosts, ok := r.hosts[host]; ok {
+		return hosts, nil
+	}
+	return nil, &stdnet.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+func newFakeResolver() *fakeResolver {
+	return &fakeResolver{
+		mx: map[string][]*stdnet.MX{
+			"example.com":      {{Host: "mail.example.com.", Pref: 10}},
+			"nomail.example":   {{Host: ".", Pref: 0}},
+			"xn--bcher-kva.ch": {{Host: "mail.xn--bcher-kva.ch.", Pref: 10}},
+		},
+		hosts: map[string][]string{
+			"a-only.com": {"192.0.2.1"},
+		},
+	}
+}
+
+// Requirements:
+// - Domains with MX records pass.
+// - Domains with only A records pass.
+// - Domains with no records or a null MX record return a not found error.
+// - International domains are looked up as punycode.
+// - Serializes to WithMXCheck().
+func TestEmailWithMXCheck(t *testing.T) {
+	resolver := newFakeResolver()
+	ctx := rulecontext.WithResolver(context.Background(), resolver)
+	ruleSet := net.Email().WithDomain(net.Domain()).WithMXCheck()
+
+	var out string
+	for _, email := range []string{"hello@example.com", "hello@a-only.com", "hello@bücher.ch"} {
+		if errs := ruleSet.Apply(ctx, email, &out); errs != nil {
+			t.Errorf("Expected %s to pass, got: %s", email, errs)
+		}
+	}
+
+	for _, email := range []string{"hello@missing.com", "hello@nomail.example"} {
+		errs := ruleSet.Apply(ctx, email, &out)
+		if len(errs) != 1 || errs.First().Code() != errors.CodeNotFound {
+			t.Errorf("Expected a not found error for %s, got: %s", email, errs)
+		}
+	}
+
+	// Malformed addresses are not looked up
+	resolver.calls = nil
+	if errs := ruleSet.Apply(ctx, "hello", &out); errs == nil {
+		t.Error("Expected an error")
+	}
+	if len(resolver.calls) != 0 {
+		t.Errorf("Expected no lookups, got: %v", resolver.calls)
+	}
+
+	expected := "EmailRuleSet.WithMXCheck()"
+	if s := net.Email().WithMXCheck().String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Lookup failures return an unavailable error.
+// - Lookup failures pass when failing open.
+// - Context errors are returned as timeout errors either way.
+// - Serializes to WithMXCheckFailOpen().
+func TestEmailWithMXCheck_Failure(t *testing.T) {
+	resolver := &fakeResolver{err: &stdnet.DNSError{Err: "server misbehaving", IsTemporary: true}}
+	ctx := rulecontext.WithResolver(context.Background(), resolver)
+
+	var out string
+	errs := net.Email().WithMXCheck().Apply(ctx, "hello@example.com", &out)
+	if len(errs) != 1 || errs.First().Code() != errors.CodeUnavailable {
+		t.Errorf("Expected an unavailable error, got: %s", errs)
+	}
+
+	failOpen := net.Email().WithMXCheckFailOpen()
+	if errs := failOpen.Apply(ctx, "hello@example.com", &out); errs != nil {
+		t.Errorf("Expected errors to be empty, got: %s", errs)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Nanosecond)
+	defer cancel()
+	<-ctx.Done()
+
+	errs = failOpen.Apply(ctx, "hello@example.com", &out)
+	if len(errs) != 1 || errs.First().Code() != errors.CodeTimeout {
+		t.Errorf("Expected a timeout error, got: %s", errs)
+	}
+
+	expected := "EmailRuleSet.WithMXCheckFailOpen()"
+	if s := failOpen.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
@@ -0,0 +1,56 @@
+package net
+
+import (
+	"fmt"
+
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// partRuleSet returns the rule set to use for a URI part. Percent encoding is always checked and the required
+// flag of the current rule set is kept so WithPathRuleSet and WithQueryRequired can be called in any order.
+func partRuleSet(current, replacement *rules.StringRuleSet) *rules.StringRuleSet {
+	if replacement == nil {
+		panic(fmt.Errorf("expected rule set to not be nil"))
+	}
+
+	ruleSet := replacement.WithRuleFunc(percentEncodingRule)
+	if current.Required() {
+		ruleSet = ruleSet.WithRequired()
+	}
+	return ruleSet
+}
+
+// WithPathRuleSet returns a new rule set that validates the path of the URI with the provided rule set instead of
+// the default one. Use this to require a path shape such as a minimum length or a pattern.
+//
+// The path is still checked for invalid percent encoding. The path may be empty so use WithMinLen if it must not be.
+func (ruleSet *URIRuleSet) WithPathRuleSet(pathRuleSet *rules.StringRuleSet) *URIRuleSet {
+	newRuleSet := ruleSet.copyWithParent(ruleSet)
+	newRuleSet.pathRuleSet = partRuleSet(ruleSet.pathRuleSet, pathRuleSet)
+	newRuleSet.label = fmt.Sprintf("WithPathRuleSet(%s)", pathRuleSet)
+	return newRuleSet
+}
+
+// WithQueryRuleSet returns a new rule set that validates the query of the URI with the provided rule set instead of
+// the default one.
+//
+// The query is still checked for invalid percent encoding. The rule set is not evaluated when the query is omitted,
+// use WithQueryRequired to require it.
+func (ruleSet *URIRuleSet) WithQueryRuleSet(queryRuleSet *rules.StringRuleSet) *URIRuleSet {
+	newRuleSet := ruleSet.copyWithParent(ruleSet)
+	newRuleSet.queryRuleSet = partRuleSet(ruleSet.queryRuleSet, queryRuleSet)
+	newRuleSet.label = fmt.Sprintf("WithQueryRuleSet(%s)", queryRuleSet)
+	return newRuleSet
+}
+
+// WithFragmentRuleSet returns a new rule set that validates the fragment of the URI with the provided rule set
+// instead of the default one.
+//
+// The fragment is still checked for invalid percent encoding. The rule set is not evaluated when the fragment is
+// omitted, use WithFragmentRequired to require it.
+func (ruleSet *URIRuleSet) WithFragmentRuleSet(fragmentRuleSet *rules.StringRuleSet) *URIRuleSet {
+	newRuleSet := ruleSet.copyWithParent(ruleSet)
+	newRuleSet.fragmentRuleSet = partRuleSet(ruleSet.fragmentRuleSet, fragmentRuleSet)
+	newRuleSet.label = fmt.Sprintf("WithFragmentRuleSet(%s)", fragmentRuleSet)
+	return newRuleSet
+}
@@ -0,0 +1,57 @@
+package net_test
+
+import (
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/rules/net"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - The path is validated with the provided rule set.
+// - Percent encoding is still checked.
+// - Serializes to WithPathRuleSet(...).
+func TestURIWithPathRuleSet(t *testing.T) {
+	ruleSet := net.URI().WithPathRuleSet(rules.String().WithRegexpString("^/api/", "path must start with /api/"))
+
+	testhelpers.MustApply(t, ruleSet.Any(), "https://example.com/api/users")
+	testhelpers.MustNotApply(t, ruleSet.Any(), "https://example.com/users", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "https://example.com", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "https://example.com/api/%zz", errors.CodeEncoding)
+
+	expected := "URIRuleSet.WithPathRuleSet(StringRuleSet.WithRegexp(^/api/))"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - The query and fragment are validated with the provided rule sets.
+// - The required flag is kept when the rule set is replaced.
+// - Omitted parts are not validated unless required.
+func TestURIWithQueryAndFragmentRuleSet(t *testing.T) {
+	ruleSet := net.URI().
+		WithQueryRequired().
+		WithQueryRuleSet(rules.String().WithMinLen(3)).
+		WithFragmentRuleSet(rules.String().WithMaxLen(4))
+
+	testhelpers.MustApply(t, ruleSet.Any(), "https://example.com/?a=1")
+	testhelpers.MustApply(t, ruleSet.Any(), "https://example.com/?a=1#top")
+	testhelpers.MustNotApply(t, ruleSet.Any(), "https://example.com/?a", errors.CodeMin)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "https://example.com/?a=1#bottom", errors.CodeMax)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "https://example.com/", errors.CodeRequired)
+}
+
+// Requirements:
+// - Panics on a nil rule set.
+func TestURIWithPathRuleSetNil(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic")
+		}
+	}()
+
+	net.URI().WithPathRuleSet(nil)
+}
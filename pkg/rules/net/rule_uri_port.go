@@ -2,6 +2,7 @@ package net
 
 import (
 	"fmt"
+	"strings"
 
 	"proto.zip/studio/validate/internal/util"
 )
@@ -35,3 +36,27 @@ func (ruleSet *URIRuleSet) WithAllowedPorts(value int, rest ...int) *URIRuleSet
 	newRuleSet.label = util.StringsToRuleOutput[int]("WithAllowedPorts", list)
 	return newRuleSet
 }
+
+// WithDefaultPort returns a new rule set that assumes the provided port when the authority of a URI with the
+// scheme does not include one.
+//
+// The default port is only used to set the "port" context value for custom rules. It is not validated against
+// other port rules and does not change the output.
+//
+// Scheme matching is case insensitive. This method will panic if the port is not between 0 and 65535.
+func (ruleSet *URIRuleSet) WithDefaultPort(scheme string, port int) *URIRuleSet {
+	if port < 0 || port > 65535 {
+		panic(fmt.Errorf("default port must be between 0 and 65535, got: %d", port))
+	}
+
+	newRuleSet := ruleSet.copyWithParent(ruleSet)
+
+	newRuleSet.defaultPorts = make(map[string]int, len(ruleSet.defaultPorts)+1)
+	for k, v := range ruleSet.defaultPorts {
+		newRuleSet.defaultPorts[k] = v
+	}
+	newRuleSet.defaultPorts[strings.ToLower(scheme)] = port
+
+	newRuleSet.label = fmt.Sprintf("WithDefaultPort(%q, %d)", scheme, port)
+	return newRuleSet
+}
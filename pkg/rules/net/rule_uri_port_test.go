@@ -1,6 +1,7 @@
 package net_test
 
 import (
+	"context"
 	"testing"
 
 	"proto.zip/studio/validate/pkg/errors"
@@ -28,3 +29,59 @@ func TestWithAllowedPorts(t *testing.T) {
 	testhelpers.MustNotApply(t, ruleSet, "http://example.com:150", errors.CodeNotAllowed)
 	testhelpers.MustApply(t, ruleSet, "http://example.com:100")
 }
+
+// Requirements:
+// - The default port for the scheme is set on the context when the port is omitted.
+// - Explicit ports are not replaced.
+// - Schemes without a default port keep an empty port.
+// - Scheme matching is case insensitive.
+func TestWithDefaultPort(t *testing.T) {
+	var port any
+	fn := func(ctx context.Context, _ string) errors.ValidationErrorCollection {
+		port = ctx.Value("port")
+		return nil
+	}
+
+	ruleSet := net.URI().
+		WithDefaultPort("https", 443).
+		WithDefaultPort("HTTP", 80).
+		WithRuleFunc(fn).
+		Any()
+
+	tests := map[string]string{
+		"https://example.com/":      "443",
+		"HTTPS://example.com/":      "443",
+		"http://example.com/":       "80",
+		"https://example.com:8443/": "8443",
+		"ftp://example.com/":        "",
+	}
+
+	for uri, expected := range tests {
+		port = nil
+		testhelpers.MustApply(t, ruleSet, uri)
+
+		if port == nil || port.(string) != expected {
+			t.Errorf("Expected port for `%s` to be `%s`, got `%v`", uri, expected, port)
+		}
+	}
+}
+
+// Requirements:
+// - Serializes to WithDefaultPort(...)
+// - Panics when the port is out of range.
+func TestWithDefaultPortString(t *testing.T) {
+	ruleSet := net.URI().WithDefaultPort("https", 443)
+
+	expected := `URIRuleSet.WithDefaultPort("https", 443)`
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+
+	net.URI().WithDefaultPort("https", 65536)
+}
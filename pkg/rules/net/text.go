@@ -0,0 +1,29 @@
+package net
+
+import (
+	"context"
+	"reflect"
+
+	"proto.zip/studio/validate/internal/util"
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// coerceString returns the input if it is a string. If text is true, values that implement encoding.TextMarshaler
+// or fmt.Stringer are converted to their text representation.
+func coerceString(ctx context.Context, input any, text bool) (string, errors.ValidationError) {
+	if str, ok := input.(string); ok {
+		return str, nil
+	}
+
+	if text {
+		str, ok, err := util.Text(input)
+		if err != nil {
+			return "", errors.Errorf(errors.CodeType, ctx, "value could not be converted to text: %s", err)
+		}
+		if ok {
+			return str, nil
+		}
+	}
+
+	return "", errors.NewCoercionError(ctx, "string", reflect.ValueOf(input).Kind().String())
+}
@@ -0,0 +1,86 @@
+package net_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/rules/net"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// textValue is a custom type that implements fmt.Stringer.
+type textValue string
+
+func (v textValue) String() string {
+	return string(v)
+}
+
+// marshalerValue is a custom type that implements encoding.TextMarshaler.
+type marshalerValue struct {
+	text string
+	err  error
+}
+
+func (v marshalerValue) MarshalText() ([]byte, error) {
+	return []byte(v.text), v.err
+}
+
+// String is not used since MarshalText is preferred.
+func (v marshalerValue) String() string {
+	return "invalid"
+}
+
+// Requirements:
+// - Stringer and TextMarshaler inputs are rejected by default.
+// - With text coercion their text representation is validated and written to the output.
+// - MarshalText errors are type errors.
+// - Serializes to WithTextCoercion().
+func TestWithTextCoercion(t *testing.T) {
+	cases := []struct {
+		name      string
+		base      rules.RuleSet[any]
+		ruleSet   rules.RuleSet[any]
+		valid     string
+		invalid   string
+		serialize string
+	}{
+		{"domain", net.Domain().Any(), net.Domain().WithTextCoercion().Any(), "example.com", "-example", "DomainRuleSet.WithTextCoercion().Any()"},
+		{"email", net.Email().Any(), net.Email().WithTextCoercion().Any(), "a@example.com", "example.com", "EmailRuleSet.WithTextCoercion().Any()"},
+		{"hostname", net.Hostname().Any(), net.Hostname().WithTextCoercion().Any(), "localhost", "-host", "HostnameRuleSet.WithTextCoercion().Any()"},
+		{"uri", net.URI().Any(), net.URI().WithTextCoercion().Any(), "https://example.com/", "://", "URIRuleSet.WithTextCoercion().Any()"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			testhelpers.MustNotApply(t, tc.base, textValue(tc.valid), errors.CodeType)
+
+			var out string
+			if err := tc.ruleSet.Apply(context.Background(), textValue(tc.valid), &out); err != nil {
+				t.Errorf("Expected Stringer to be valid, got: %s", err)
+			} else if out != tc.valid {
+				t.Errorf("Expected output to be %s, got: %s", tc.valid, out)
+			}
+
+			out = ""
+			if err := tc.ruleSet.Apply(context.Background(), marshalerValue{text: tc.valid}, &out); err != nil {
+				t.Errorf("Expected TextMarshaler to be valid, got: %s", err)
+			} else if out != tc.valid {
+				t.Errorf("Expected output to be %s, got: %s", tc.valid, out)
+			}
+
+			if err := tc.ruleSet.Apply(context.Background(), textValue(tc.invalid), &out); err == nil {
+				t.Error("Expected invalid Stringer to return errors")
+			}
+
+			testhelpers.MustNotApply(t, tc.ruleSet, marshalerValue{err: fmt.Errorf("bad value")}, errors.CodeType)
+			testhelpers.MustNotApply(t, tc.ruleSet, 123, errors.CodeType)
+
+			if s := tc.ruleSet.String(); s != tc.serialize {
+				t.Errorf("Expected rule set to be %s, got %s", tc.serialize, s)
+			}
+		})
+	}
+}
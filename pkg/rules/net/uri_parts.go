@@ -0,0 +1,93 @@
+package net
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// rawPortContextKey is used to store the port exactly as it appears in the URI.
+// The "port" context value may contain a default port instead.
+type rawPortContextKey struct{}
+
+// URIParts contains the components of a URI exactly as they were validated by URIRuleSet.
+//
+// All values are in their original encoded form. Values that are not present in the URI are empty.
+type URIParts struct {
+	Scheme    string
+	Authority string
+	Userinfo  string
+	User      string
+	Password  string
+	Host      string
+	Port      string
+	Path      string
+	Query     string
+	Fragment  string
+}
+
+// contextString returns the string value for the key or an empty string if it does not exist.
+func contextString(ctx context.Context, key any) string {
+	str, _ := ctx.Value(key).(string)
+	return str
+}
+
+// uriPartsFromContext returns the URI parts from the context values set by URIRuleSet.
+func uriPartsFromContext(ctx context.Context) *URIParts {
+	return &URIParts{
+		Scheme:    contextString(ctx, "scheme"),
+		Authority: contextString(ctx, "authority"),
+		Userinfo:  contextString(ctx, "userinfo"),
+		User:      contextString(ctx, "user"),
+		Password:  contextString(ctx, "password"),
+		Host:      contextString(ctx, "host"),
+		Port:      contextString(ctx, rawPortContextKey{}),
+		Path:      contextString(ctx, "path"),
+		Query:     contextString(ctx, "query"),
+		Fragment:  contextString(ctx, "fragment"),
+	}
+}
+
+// URL converts the parts to a url.URL.
+//
+// Encoded values are decoded for the fields of url.URL that expect decoded values. Since the parts have already been
+// validated this does not return an error.
+func (parts *URIParts) URL() *url.URL {
+	u := &url.URL{
+		Scheme:   parts.Scheme,
+		Host:     parts.Host,
+		RawQuery: parts.Query,
+	}
+
+	if parts.Port != "" {
+		u.Host = parts.Host + ":" + parts.Port
+	}
+
+	if parts.Userinfo != "" {
+		user, _ := url.PathUnescape(parts.User)
+
+		if strings.Contains(parts.Userinfo, ":") {
+			password, _ := url.PathUnescape(parts.Password)
+			u.User = url.UserPassword(user, password)
+		} else {
+			u.User = url.User(user)
+		}
+	}
+
+	if parts.Scheme != "" && parts.Authority == "" && parts.Path != "" && !strings.HasPrefix(parts.Path, "/") {
+		// URIs such as "mailto:user@example.com" have an opaque path
+		u.Opaque = parts.Path
+	} else {
+		u.Path, _ = url.PathUnescape(parts.Path)
+		if u.EscapedPath() != parts.Path {
+			u.RawPath = parts.Path
+		}
+	}
+
+	u.Fragment, _ = url.PathUnescape(parts.Fragment)
+	if u.EscapedFragment() != parts.Fragment {
+		u.RawFragment = parts.Fragment
+	}
+
+	return u
+}
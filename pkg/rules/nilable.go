@@ -0,0 +1,57 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+
+	"proto.zip/studio/validate/internal/util"
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// NilableRuleSet implements RuleSet and wraps another rule set so that nil inputs are allowed.
+type NilableRuleSet[T any] struct {
+	NoConflict[T]
+	inner RuleSet[T]
+}
+
+// Nilable wraps a rule set so that a nil input, such as a JSON null or a nil pointer, is accepted without calling the
+// wrapped rule set. The output is left unchanged so pointer and interface outputs stay nil. Any other input is passed
+// to the wrapped rule set.
+//
+// Rule sets such as StringRuleSet and IntRuleSet have a WithNil method that does the same thing and should be
+// preferred. Use Nilable for combinators such as AllOf, OneOf, Not and When. For object keys, ObjectRuleSet.WithExplicitNull may be a better fit since it also sets the zero
+// value for null keys.
+func Nilable[T any](inner RuleSet[T]) *NilableRuleSet[T] {
+	return &NilableRuleSet[T]{
+		inner: inner,
+	}
+}
+
+// Required returns the required flag of the wrapped rule set.
+func (v *NilableRuleSet[T]) Required() bool {
+	return v.inner.Required()
+}
+
+// Apply returns nil without changing the output if the input is nil. Otherwise it applies the wrapped rule set.
+func (v *NilableRuleSet[T]) Apply(ctx context.Context, input, output any) errors.ValidationErrorCollection {
+	if util.IsNil(input) {
+		return nil
+	}
+	return v.inner.Apply(ctx, input, output)
+}
+
+// Evaluate evaluates the wrapped rule set.
+func (v *NilableRuleSet[T]) Evaluate(ctx context.Context, value T) errors.ValidationErrorCollection {
+	return v.inner.Evaluate(ctx, value)
+}
+
+// Any returns a new RuleSet that wraps the nilable RuleSet in any Any rule set
+// which can then be used in nested validation.
+func (v *NilableRuleSet[T]) Any() RuleSet[any] {
+	return WrapAny[T](v)
+}
+
+// String returns a string representation of the rule set suitable for debugging.
+func (v *NilableRuleSet[T]) String() string {
+	return fmt.Sprintf("Nilable(%s)", v.inner)
+}
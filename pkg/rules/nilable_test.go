@@ -0,0 +1,142 @@
+package rules_test
+
+import (
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/rules/geo"
+	"proto.zip/studio/validate/pkg/rules/net"
+	"proto.zip/studio/validate/pkg/rules/numbers"
+	"proto.zip/studio/validate/pkg/rules/phone"
+	rtime "proto.zip/studio/validate/pkg/rules/time"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Nil inputs are accepted and the output is left nil.
+// - Other inputs are passed to the wrapped rule set.
+// - Required and String come from the wrapped rule set.
+func TestNilable(t *testing.T) {
+	ruleSet := rules.Nilable[int](rules.Int().WithMin(10).WithRequired())
+
+	testhelpers.MustImplementWithNil(t, ruleSet.Any())
+	testhelpers.MustApply(t, ruleSet.Any(), 10)
+	testhelpers.MustNotApply(t, ruleSet.Any(), 5, errors.CodeMin)
+
+	if errs := ruleSet.Evaluate(context.Background(), 5); errs == nil {
+		t.Error("Expected Evaluate to use the wrapped rule set")
+	}
+
+	if !ruleSet.Required() {
+		t.Error("Expected rule set to be required")
+	}
+
+	expected := "Nilable(IntRuleSet[int].WithMin(10).WithRequired())"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Every exported rule set constructor supports nil through WithNil.
+// - Combinators support nil through Nilable.
+// - Without WithNil a nil input returns a type error and does not panic.
+// - Any accepts nil without WithNil.
+// - Not accepts nil without Nilable since the wrapped rule set rejects it.
+func TestNilableConformance(t *testing.T) {
+	type nilStruct struct {
+		Name string
+	}
+
+	cases := map[string]rules.RuleSet[any]{
+		"Any":             rules.Any().WithNil(),
+		"AllOf":           rules.Nilable[int](rules.AllOf[int](rules.Int())).Any(),
+		"Constant":        rules.Constant("a").WithNil().Any(),
+		"Enum":            rules.Enum("a", "b").WithNil().Any(),
+		"Float64":         rules.Float64().WithNil().Any(),
+		"Int":             rules.Int().WithNil().Any(),
+		"Interface":       rules.Interface[any]().WithNil().Any(),
+		"Not":             rules.Nilable[int](rules.Not[int](rules.Int().WithMin(10), errors.CodeMax)).Any(),
+		"OneOf":           rules.Nilable[int](rules.OneOf[int](rules.Int())).Any(),
+		"Slice":           rules.Slice[int]().WithNil().Any(),
+		"String":          rules.String().WithNil().Any(),
+		"StringMap":       rules.StringMap[any]().WithNil().Any(),
+		"Struct":          rules.Struct[nilStruct]().WithNil().Any(),
+		"Transform":       rules.Nilable[string](rules.WithTransform[string](rules.String(), func(_ context.Context, value any) (any, error) { return value, nil })).Any(),
+		"When":            rules.Nilable[int](rules.When[int](func(context.Context) bool { return true }, rules.Int())).Any(),
+		"geo.Coordinate":  geo.Coordinate().WithNil().Any(),
+		"net.Domain":      net.Domain().WithNil().Any(),
+		"net.Email":       net.Email().WithNil().Any(),
+		"net.Hostname":    net.Hostname().WithNil().Any(),
+		"net.URI":         net.URI().WithNil().Any(),
+		"numbers.BigInt":  numbers.BigInt().WithNil().Any(),
+		"numbers.Decimal": numbers.Decimal().WithNil().Any(),
+		"phone.Phone":     phone.Phone().WithNil().Any(),
+		"time.Date":       rtime.Date().WithNil().Any(),
+		"time.Time":       rtime.Time().WithNil().Any(),
+	}
+
+	for name, ruleSet := range cases {
+		t.Run(name, func(t *testing.T) {
+			testhelpers.MustImplementWithNil(t, ruleSet)
+		})
+	}
+
+	without := map[string]rules.RuleSet[any]{
+		"AllOf":           rules.AllOf[int](rules.Int()).Any(),
+		"Constant":        rules.Constant("a").Any(),
+		"Enum":            rules.Enum("a", "b").Any(),
+		"Float64":         rules.Float64().Any(),
+		"Int":             rules.Int().Any(),
+		"Interface":       rules.Interface[any]().Any(),
+		"OneOf":           rules.OneOf[int](rules.Int()).Any(),
+		"Slice":           rules.Slice[int]().Any(),
+		"String":          rules.String().Any(),
+		"StringMap":       rules.StringMap[any]().Any(),
+		"Struct":          rules.Struct[nilStruct]().Any(),
+		"Transform":       rules.WithTransform[string](rules.String(), func(_ context.Context, value any) (any, error) { return value, nil }).Any(),
+		"When":            rules.When[int](func(context.Context) bool { return true }, rules.Int()).Any(),
+		"geo.Coordinate":  geo.Coordinate().Any(),
+		"net.Domain":      net.Domain().Any(),
+		"net.Email":       net.Email().Any(),
+		"net.Hostname":    net.Hostname().Any(),
+		"net.URI":         net.URI().Any(),
+		"numbers.BigInt":  numbers.BigInt().Any(),
+		"numbers.Decimal": numbers.Decimal().Any(),
+		"phone.Phone":     phone.Phone().Any(),
+		"time.Date":       rtime.Date().Any(),
+		"time.Time":       rtime.Time().Any(),
+	}
+
+	for name, ruleSet := range without {
+		t.Run(name+"/WithoutNil", func(t *testing.T) {
+			testhelpers.MustNotApply(t, ruleSet, nil, errors.CodeType)
+		})
+	}
+
+	testhelpers.MustApply(t, rules.Any(), nil)
+	testhelpers.MustApply(t, rules.Not[int](rules.Int(), errors.CodeForbidden).Any(), nil)
+}
+
+// Requirements:
+// - WithNil does not change the rule set if it is already set.
+// - Non-nil inputs are still validated.
+// - Serializes to WithNil()
+func TestWithNil(t *testing.T) {
+	ruleSet := rules.Int().WithMin(10).WithNil()
+
+	if ruleSet.WithNil() != ruleSet {
+		t.Error("Expected WithNil to return the same rule set")
+	}
+
+	testhelpers.MustApply(t, ruleSet.Any(), 10)
+	testhelpers.MustNotApply(t, ruleSet.Any(), 5, errors.CodeMin)
+	testhelpers.MustNotApply(t, rules.Int().Any(), nil, errors.CodeType)
+
+	expected := "IntRuleSet[int].WithMin(10).WithNil()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
@@ -0,0 +1,70 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// NotRuleSet implements RuleSet and passes only if the wrapped rule set fails.
+type NotRuleSet[T any] struct {
+	NoConflict[T]
+	inner RuleSet[T]
+	code  errors.ErrorCode
+}
+
+// Not returns a rule set that negates the wrapped rule set. This is useful for deny list style checks where the
+// value must not match a rule set.
+//
+// If the wrapped rule set passes, an error with the provided code is returned. If it fails, the input is
+// assigned to the output unchanged. The errors from the wrapped rule set are discarded by design since a failure
+// is the expected result.
+//
+// The wrapped rule set is applied to a separate output value so any coercion or changes it makes are never
+// returned.
+func Not[T any](inner RuleSet[T], code errors.ErrorCode) *NotRuleSet[T] {
+	return &NotRuleSet[T]{
+		inner: inner,
+		code:  code,
+	}
+}
+
+// Required returns the required flag of the wrapped rule set.
+func (v *NotRuleSet[T]) Required() bool {
+	return v.inner.Required()
+}
+
+// Apply applies the wrapped rule set and assigns the input to the output if it fails.
+func (v *NotRuleSet[T]) Apply(ctx context.Context, input, output any) errors.ValidationErrorCollection {
+	var discard T
+	if errs := v.inner.Apply(ctx, input, &discard); !errs.HasErrors() {
+		return errors.Collection(v.notError(ctx))
+	}
+
+	return assignValue(ctx, input, output)
+}
+
+// Evaluate returns an error if the wrapped rule set passes.
+func (v *NotRuleSet[T]) Evaluate(ctx context.Context, value T) errors.ValidationErrorCollection {
+	if errs := v.inner.Evaluate(ctx, value); !errs.HasErrors() {
+		return errors.Collection(v.notError(ctx))
+	}
+	return nil
+}
+
+// notError returns the error for a value that matched the wrapped rule set.
+func (v *NotRuleSet[T]) notError(ctx context.Context) errors.ValidationError {
+	return errors.Errorf(v.code, ctx, "value is not allowed")
+}
+
+// Any returns a new RuleSet that wraps the Not RuleSet in any Any rule set
+// which can then be used in nested validation.
+func (v *NotRuleSet[T]) Any() RuleSet[any] {
+	return WrapAny[T](v)
+}
+
+// String returns a string representation of the rule set suitable for debugging.
+func (v *NotRuleSet[T]) String() string {
+	return fmt.Sprintf("Not(%s, %s)", v.inner, v.code)
+}
@@ -0,0 +1,72 @@
+package rules_test
+
+import (
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Fails with the provided code when the wrapped rule set passes.
+// - Passes when the wrapped rule set fails.
+// - Errors from the wrapped rule set are not returned.
+func TestNot(t *testing.T) {
+	ruleSet := rules.Not[string](rules.String().WithRegexpString(`^admin`, "admin"), errors.CodeForbidden)
+
+	testhelpers.MustApply(t, ruleSet.Any(), "user")
+	testhelpers.MustNotApply(t, ruleSet.Any(), "administrator", errors.CodeForbidden)
+
+	if err := ruleSet.Evaluate(context.Background(), "admin"); len(err) != 1 {
+		t.Errorf("Expected 1 error, got: %d", len(err))
+	} else if err[0].Code() != errors.CodeForbidden {
+		t.Errorf("Expected error code to be %s, got: %s", errors.CodeForbidden, err[0].Code())
+	}
+
+	if err := ruleSet.Evaluate(context.Background(), "user"); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	}
+}
+
+// Requirements:
+// - The output of the wrapped rule set is never returned.
+// - The input is assigned unchanged.
+func TestNot_Output(t *testing.T) {
+	replace := rules.WithTransform[int](rules.Int().WithMin(100), func(_ context.Context, value any) (any, error) {
+		return 1000, nil
+	})
+
+	ruleSet := rules.Not[int](rules.Int().WithMin(100), errors.CodeForbidden)
+
+	var out any
+	if err := ruleSet.Apply(context.Background(), 5, &out); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	} else if out != 5 {
+		t.Errorf("Expected output to be 5, got: %v", out)
+	}
+
+	var intOut int
+	if err := rules.Not[int](replace, errors.CodeForbidden).Apply(context.Background(), 5, &intOut); err == nil {
+		t.Errorf("Expected errors to not be nil")
+	} else if intOut != 0 {
+		t.Errorf("Expected output to not be modified, got: %d", intOut)
+	}
+}
+
+// Requirements:
+// - Required is passed through from the wrapped rule set.
+// - Serializes to string.
+func TestNot_String(t *testing.T) {
+	ruleSet := rules.Not[int](rules.Int().WithRequired().WithMin(1), errors.CodeForbidden)
+
+	if !ruleSet.Required() {
+		t.Errorf("Expected rule set to be required")
+	}
+
+	expected := "Not(IntRuleSet[int].WithRequired().WithMin(1), DENIED)"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
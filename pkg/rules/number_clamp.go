@@ -0,0 +1,120 @@
+package rules
+
+import "fmt"
+
+// clampValue returns the value limited to the bounds. A nil bound is ignored.
+func clampValue[T integer | floating](value T, min, max *T) T {
+	if min != nil && value < *min {
+		return *min
+	}
+	if max != nil && value > *max {
+		return *max
+	}
+	return value
+}
+
+// clamp returns the value limited to the most recent clamp bounds.
+func (v *IntRuleSet[T]) clamp(value T) T {
+	var min, max *T
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if min == nil {
+			min = currentRuleSet.clampMin
+		}
+		if max == nil {
+			max = currentRuleSet.clampMax
+		}
+	}
+	return clampValue(value, min, max)
+}
+
+// WithClampMin returns a new child RuleSet that replaces values less than min with min instead of returning an
+// error. The clamped value is written to the output and is the value passed to the rules.
+//
+// Clamping happens before rules are evaluated. If WithMin is also set it only returns an error when its minimum
+// is greater than the clamp minimum.
+func (v *IntRuleSet[T]) WithClampMin(min T) *IntRuleSet[T] {
+	return &IntRuleSet[T]{
+		strict:      v.strict,
+		strictType:  v.strictType,
+		parent:      v,
+		base:        v.base,
+		required:    v.required,
+		withNil:     v.withNil,
+		errorConfig: v.errorConfig,
+		rounding:    v.rounding,
+		clampMin:    &min,
+		label:       fmt.Sprintf("WithClampMin(%d)", min),
+	}
+}
+
+// WithClampMax returns a new child RuleSet that replaces values greater than max with max instead of returning an
+// error. The clamped value is written to the output and is the value passed to the rules.
+//
+// Clamping happens before rules are evaluated. If WithMax is also set it only returns an error when its maximum
+// is less than the clamp maximum.
+func (v *IntRuleSet[T]) WithClampMax(max T) *IntRuleSet[T] {
+	return &IntRuleSet[T]{
+		strict:      v.strict,
+		strictType:  v.strictType,
+		parent:      v,
+		base:        v.base,
+		required:    v.required,
+		withNil:     v.withNil,
+		errorConfig: v.errorConfig,
+		rounding:    v.rounding,
+		clampMax:    &max,
+		label:       fmt.Sprintf("WithClampMax(%d)", max),
+	}
+}
+
+// clamp returns the value limited to the most recent clamp bounds.
+func (v *FloatRuleSet[T]) clamp(value T) T {
+	var min, max *T
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if min == nil {
+			min = currentRuleSet.clampMin
+		}
+		if max == nil {
+			max = currentRuleSet.clampMax
+		}
+	}
+	return clampValue(value, min, max)
+}
+
+// WithClampMin returns a new child RuleSet that replaces values less than min with min instead of returning an
+// error. The clamped value is written to the output and is the value passed to the rules.
+//
+// Clamping happens after rounding and before rules are evaluated. If WithMin is also set it only returns an
+// error when its minimum is greater than the clamp minimum.
+func (v *FloatRuleSet[T]) WithClampMin(min T) *FloatRuleSet[T] {
+	return &FloatRuleSet[T]{
+		strict:      v.strict,
+		parent:      v,
+		required:    v.required,
+		withNil:     v.withNil,
+		errorConfig: v.errorConfig,
+		rounding:    v.rounding,
+		precision:   v.precision,
+		clampMin:    &min,
+		label:       fmt.Sprintf("WithClampMin(%f)", min),
+	}
+}
+
+// WithClampMax returns a new child RuleSet that replaces values greater than max with max instead of returning an
+// error. The clamped value is written to the output and is the value passed to the rules.
+//
+// Clamping happens after rounding and before rules are evaluated. If WithMax is also set it only returns an
+// error when its maximum is less than the clamp maximum.
+func (v *FloatRuleSet[T]) WithClampMax(max T) *FloatRuleSet[T] {
+	return &FloatRuleSet[T]{
+		strict:      v.strict,
+		parent:      v,
+		required:    v.required,
+		withNil:     v.withNil,
+		errorConfig: v.errorConfig,
+		rounding:    v.rounding,
+		precision:   v.precision,
+		clampMax:    &max,
+		label:       fmt.Sprintf("WithClampMax(%f)", max),
+	}
+}
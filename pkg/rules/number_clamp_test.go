@@ -0,0 +1,94 @@
+package rules_test
+
+import (
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+type testClampStruct struct {
+	Limit int
+	Ratio float64
+}
+
+// Requirements:
+// - Values outside the clamp bounds are replaced with the nearest bound.
+// - Values inside the bounds are unchanged.
+// - No errors are returned.
+func TestIntRuleSet_WithClamp(t *testing.T) {
+	ruleSet := rules.Int().WithClampMin(1).WithClampMax(100).Any()
+
+	testhelpers.MustApplyMutation(t, ruleSet, 0, 1)
+	testhelpers.MustApplyMutation(t, ruleSet, 500, 100)
+	testhelpers.MustApplyMutation(t, ruleSet, "-20", 1)
+	testhelpers.MustApply(t, ruleSet, 50)
+}
+
+// Requirements:
+// - Values outside the clamp bounds are replaced with the nearest bound.
+// - Clamping happens after rounding.
+func TestFloatRuleSet_WithClamp(t *testing.T) {
+	ruleSet := rules.Float64().WithClampMin(0).WithClampMax(1).Any()
+
+	testhelpers.MustApplyMutation(t, ruleSet, -0.5, 0.0)
+	testhelpers.MustApplyMutation(t, ruleSet, 1.5, 1.0)
+	testhelpers.MustApply(t, ruleSet, 0.5)
+
+	rounded := rules.Float64().WithRounding(rules.RoundingUp, 0).WithClampMax(1.5).Any()
+	testhelpers.MustApplyMutation(t, rounded, 1.2, 1.5)
+}
+
+// Requirements:
+// - The output struct receives the clamped value.
+func TestClamp_Struct(t *testing.T) {
+	ruleSet := rules.Struct[testClampStruct]().
+		WithKey("Limit", rules.Int().WithClampMax(100).Any()).
+		WithKey("Ratio", rules.Float64().WithClampMin(0).Any())
+
+	var out testClampStruct
+	if err := ruleSet.Apply(context.Background(), map[string]any{"Limit": 1000, "Ratio": -1.0}, &out); err != nil {
+		t.Fatalf("Expected errors to be nil, got: %s", err)
+	}
+
+	if out.Limit != 100 {
+		t.Errorf("Expected Limit to be 100, got: %d", out.Limit)
+	}
+	if out.Ratio != 0 {
+		t.Errorf("Expected Ratio to be 0, got: %f", out.Ratio)
+	}
+}
+
+// Requirements:
+// - Rules are evaluated against the clamped value.
+// - WithMin only errors if it is stricter than the clamp.
+// - Clamps are kept when conflicting rules are replaced.
+// - The most recent clamp is used.
+// - Serializes to string.
+func TestClamp_WithMin(t *testing.T) {
+	ruleSet := rules.Int().WithMin(0).WithClampMin(5).WithMin(1)
+
+	testhelpers.MustApplyMutation(t, ruleSet.Any(), -10, 5)
+
+	stricter := rules.Int().WithClampMin(5).WithMin(10).Any()
+	testhelpers.MustNotApply(t, stricter, 0, errors.CodeMin)
+
+	replaced := rules.Int().WithClampMax(10).WithClampMax(20).Any()
+	testhelpers.MustApplyMutation(t, replaced, 30, 20)
+
+	if err := rules.Int().WithClampMax(10).WithMax(10).Evaluate(context.Background(), 50); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	}
+
+	expected := "IntRuleSet[int].WithClampMin(5).WithMin(1)"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+
+	expected = "FloatRuleSet[float64].WithClampMax(1.500000)"
+	if s := rules.Float64().WithClampMax(1.5).String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
@@ -2,6 +2,7 @@ package rules
 
 import (
 	"context"
+	"encoding/json"
 	"math"
 	"reflect"
 	"strconv"
@@ -15,7 +16,9 @@ const tolerance = 1e-9
 // tryCoerceIntToInt attempts to coerce an int from one type to another and checks that no data was lost in the process.
 func tryCoerceIntToInt[From, To integer](ruleSet *IntRuleSet[To], value From, ctx context.Context) (To, errors.ValidationError) {
 	intval := To(value)
-	if From(intval) != value {
+
+	// Converting between signed and unsigned types of the same size keeps the bits so the sign must also match
+	if From(intval) != value || (value < 0) != (intval < 0) {
 		return 0, errors.NewRangeError(ctx, ruleSet.typeName())
 	}
 	return intval, nil
@@ -23,38 +26,58 @@ func tryCoerceIntToInt[From, To integer](ruleSet *IntRuleSet[To], value From, ct
 
 // tryCoerceFloatToInt attempts to coerce a float into an int and checks that no data was lost in the process.
 // Rounding rules are applied when appropriate.
+//
+// Floats above the largest exactly representable integer are rejected since any integer in that range may have
+// been rounded to the float before it reached the rule set, such as when JSON is unmarshaled to float64.
 func tryCoerceFloatToInt[From floating, To integer](ruleSet *IntRuleSet[To], value From, ctx context.Context) (To, errors.ValidationError) {
-	var int64val int64
 	float64val := float64(value)
 
-	rounding := ruleSet.rounding
+	if ruleSet.strictType || math.IsNaN(float64val) || math.IsInf(float64val, 0) {
+		return 0, errors.NewCoercionError(ctx, ruleSet.typeName(), reflect.ValueOf(value).Kind().String())
+	}
+
+	var rounded float64
 
-	switch rounding {
+	switch ruleSet.rounding {
 	case RoundingDown:
-		int64val = int64(math.Floor(float64val))
+		rounded = math.Floor(float64val)
 	case RoundingUp:
-		int64val = int64(math.Ceil(float64val))
+		rounded = math.Ceil(float64val)
 	case RoundingHalfUp:
-		int64val = int64(math.Round(float64val))
+		rounded = math.Round(float64val)
 	case RoundingHalfEven:
-		int64val = int64(math.RoundToEven(float64val))
+		rounded = math.RoundToEven(float64val)
 	default:
-		int64val = int64(math.Round(float64val))
+		rounded = math.Round(float64val)
 
-		if math.Abs(float64(int64val)-float64val) > tolerance {
+		if math.Abs(rounded-float64val) > tolerance {
 			return 0, errors.NewCoercionError(ctx, ruleSet.typeName(), reflect.ValueOf(value).Kind().String())
 		}
 	}
 
+	// Converting a float that is out of range for int64 is implementation defined so check before converting
+	if math.Abs(rounded) > maxExactFloatInt(value) {
+		return 0, errors.NewRangeError(ctx, ruleSet.typeName())
+	}
+
+	int64val := int64(rounded)
 	intval := To(int64val)
 
-	if int64(intval) != int64val {
+	if int64(intval) != int64val || (int64val < 0) != (intval < 0) {
 		return 0, errors.NewRangeError(ctx, ruleSet.typeName())
 	}
 
 	return intval, nil
 }
 
+// maxExactFloatInt returns the largest integer that the float type can represent along with every integer below it.
+func maxExactFloatInt[From floating](value From) float64 {
+	if _, ok := any(value).(float32); ok {
+		return 1 << 24
+	}
+	return 1 << 53
+}
+
 // parseInt attempts to parse an int from a string while using reflection to get the right type.
 func parseInt[To integer](value string, base int) (To, error) {
 	t := reflect.TypeOf(*new(To))
@@ -69,6 +92,24 @@ func parseInt[To integer](value string, base int) (To, error) {
 	}
 }
 
+// tryCoerceNumberToInt attempts to convert a json.Number into an int.
+// Json numbers are always base 10. Numbers with a fraction or exponent are converted like floats.
+func tryCoerceNumberToInt[To integer](ruleSet *IntRuleSet[To], value json.Number, ctx context.Context) (To, errors.ValidationError) {
+	intval, err := parseInt[To](string(value), 10)
+	if err == nil {
+		return intval, nil
+	}
+	if err.(*strconv.NumError).Err == strconv.ErrRange {
+		return 0, errors.NewRangeError(ctx, ruleSet.typeName())
+	}
+
+	floatval, err := value.Float64()
+	if err != nil {
+		return 0, errors.NewCoercionError(ctx, ruleSet.typeName(), "string")
+	}
+	return tryCoerceFloatToInt[float64, To](ruleSet, floatval, ctx)
+}
+
 // tryCoerceIntDefault attempts to convert to an int from a non-float and non-int type
 func tryCoerceIntDefault[To integer](ruleSet *IntRuleSet[To], value any, ctx context.Context) (To, errors.ValidationError) {
 	if ruleSet.strict {
@@ -121,6 +162,8 @@ func (ruleSet *IntRuleSet[T]) coerceInt(value any, ctx context.Context) (T, erro
 		return tryCoerceFloatToInt[float32, T](ruleSet, x, ctx)
 	case float64:
 		return tryCoerceFloatToInt[float64, T](ruleSet, x, ctx)
+	case json.Number:
+		return tryCoerceNumberToInt[T](ruleSet, x, ctx)
 	default:
 		return tryCoerceIntDefault[T](ruleSet, value, ctx)
 	}
@@ -146,6 +189,19 @@ func tryCoerceIntToFloat[From integer, To floating](value From, ctx context.Cont
 	return floatval, nil
 }
 
+// tryCoerceNumberToFloat attempts to convert a json.Number into a float.
+func tryCoerceNumberToFloat[To floating](ruleSet *FloatRuleSet[To], value json.Number, ctx context.Context) (To, errors.ValidationError) {
+	bits := reflect.TypeOf(*new(To)).Bits()
+	floatval, err := strconv.ParseFloat(string(value), bits)
+	if err != nil {
+		if err.(*strconv.NumError).Err == strconv.ErrRange {
+			return 0, errors.NewRangeError(ctx, ruleSet.typeName())
+		}
+		return 0, errors.NewCoercionError(ctx, ruleSet.typeName(), "string")
+	}
+	return To(floatval), nil
+}
+
 // tryCoerceFloatDefault attempts to convert to a floar from a non-float and non-int type
 func tryCoerceFloatDefault[To floating](ruleSet *FloatRuleSet[To], value any, ctx context.Context) (To, errors.ValidationError) {
 	if ruleSet.strict {
@@ -201,6 +257,8 @@ func (v *FloatRuleSet[T]) coerceFloat(value any, ctx context.Context) (T, errors
 		return tryCoerceFloatToFloat[float32, T](x, ctx)
 	case float64:
 		return tryCoerceFloatToFloat[float64, T](x, ctx)
+	case json.Number:
+		return tryCoerceNumberToFloat[T](v, x, ctx)
 	default:
 		return tryCoerceFloatDefault[T](v, value, ctx)
 	}
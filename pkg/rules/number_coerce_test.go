@@ -1,6 +1,7 @@
 package rules_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
@@ -186,3 +187,23 @@ func TestUnknownToFloat(t *testing.T) {
 	ruleSetUnsigned := rules.Float64().Any()
 	testhelpers.MustNotApply(t, ruleSetUnsigned, &from, errors.CodeType)
 }
+
+// Requirements:
+// - json.Number integers are parsed exactly, even in strict mode.
+// - json.Number values with a fraction or exponent are converted like floats.
+// - Values out of range return a range error.
+// - Floats accept json.Number.
+func TestCoerceJsonNumber(t *testing.T) {
+	ruleSet := rules.Int64().WithStrict().Any()
+
+	testhelpers.MustApplyMutation(t, ruleSet, json.Number("9007199254740993"), int64(9007199254740993))
+	testhelpers.MustApplyMutation(t, ruleSet, json.Number("-12"), int64(-12))
+	testhelpers.MustApplyMutation(t, ruleSet, json.Number("1.2e1"), int64(12))
+	testhelpers.MustNotApply(t, ruleSet, json.Number("1.5"), errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet, json.Number("99999999999999999999"), errors.CodeRange)
+	testhelpers.MustNotApply(t, rules.Int8().Any(), json.Number("300"), errors.CodeRange)
+	testhelpers.MustNotApply(t, rules.Int64().WithStrictType().Any(), json.Number("1.0"), errors.CodeType)
+
+	testhelpers.MustApplyMutation(t, rules.Float64().WithStrict().Any(), json.Number("1.5"), float64(1.5))
+	testhelpers.MustNotApply(t, rules.Float32().Any(), json.Number("1e100"), errors.CodeRange)
+}
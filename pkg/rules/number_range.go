@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// integerLimits returns the smallest and largest values of the integer type.
+func integerLimits[T integer]() (T, T) {
+	var zero T
+	max := ^zero
+	if max > 0 {
+		// Unsigned
+		return zero, max
+	}
+
+	bits := reflect.TypeOf(zero).Bits()
+	max = T(1)<<(bits-1) - 1
+	return -max - 1, max
+}
+
+// rangeRule implements Rule for WithRange. The rule itself never fails since every value of the type is in range.
+// Its presence tells the rule set to report inputs that are out of range for the type as minimum and maximum errors.
+type rangeRule[T integer] struct{}
+
+// Evaluate always returns nil since coerced values are always in range for the type.
+func (rule *rangeRule[T]) Evaluate(ctx context.Context, value T) errors.ValidationErrorCollection {
+	return nil
+}
+
+// Conflict returns true for any range rule.
+func (rule *rangeRule[T]) Conflict(x Rule[T]) bool {
+	_, ok := x.(*rangeRule[T])
+	return ok
+}
+
+// String returns the string representation of the range rule.
+// Example: WithRange()
+func (rule *rangeRule[T]) String() string {
+	return "WithRange()"
+}
+
+// WithRange returns a new child RuleSet that reports inputs that are too large or too small for the integer type
+// as errors.CodeMax and errors.CodeMin errors using the type limits, the same as if WithMax and WithMin had been
+// called with them. Without it these inputs return a single errors.CodeRange error.
+//
+// Either way the input never wraps around. WithMin and WithMax can still be used to narrow the range and the type
+// limits are only used in the JSON schema if they are not set.
+func (v *IntRuleSet[T]) WithRange() *IntRuleSet[T] {
+	return v.WithRule(&rangeRule[T]{})
+}
+
+// hasRange returns true if WithRange was called on the rule set or any of its parents.
+func (v *IntRuleSet[T]) hasRange() bool {
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if _, ok := currentRuleSet.rule.(*rangeRule[T]); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// rangeError returns the minimum or maximum error for an input that is out of range for the type.
+// The direction is taken from the sign of the input.
+func (v *IntRuleSet[T]) rangeError(ctx context.Context, value any) errors.ValidationError {
+	min, max := integerLimits[T]()
+	if isNegative(value) {
+		return errors.Errorf(errors.CodeMin, ctx, "field must be greater than %d", min)
+	}
+	return errors.Errorf(errors.CodeMax, ctx, "field cannot be greater than %d", max)
+}
+
+// isNegative returns true if the value is a negative number or a string that starts with a minus sign.
+func isNegative(value any) bool {
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int() < 0
+	case reflect.Float32, reflect.Float64:
+		return rv.Float() < 0
+	case reflect.String:
+		return strings.HasPrefix(rv.String(), "-")
+	}
+	return false
+}
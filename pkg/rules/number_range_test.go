@@ -0,0 +1,72 @@
+package rules_test
+
+import (
+	"math"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Inputs that overflow the type return a range error instead of wrapping.
+// - Negative inputs return a range error for unsigned types, including types of the same size.
+func TestIntOverflow(t *testing.T) {
+	testhelpers.MustNotApply(t, rules.Int8().Any(), 300, errors.CodeRange)
+	testhelpers.MustNotApply(t, rules.Int8().Any(), float64(300), errors.CodeRange)
+	testhelpers.MustNotApply(t, rules.Int8().Any(), "300", errors.CodeRange)
+	testhelpers.MustNotApply(t, rules.Uint16().Any(), -1, errors.CodeRange)
+
+	testhelpers.MustNotApply(t, rules.Uint64().Any(), int64(-1), errors.CodeRange)
+	testhelpers.MustNotApply(t, rules.Uint().Any(), -1, errors.CodeRange)
+	testhelpers.MustNotApply(t, rules.Int64().Any(), uint64(math.MaxUint64), errors.CodeRange)
+	testhelpers.MustNotApply(t, rules.Int().Any(), uint(math.MaxUint), errors.CodeRange)
+
+	testhelpers.MustApplyMutation(t, rules.Uint64().Any(), int64(1), uint64(1))
+	testhelpers.MustApplyMutation(t, rules.Int64().Any(), uint64(math.MaxInt64), int64(math.MaxInt64))
+}
+
+// Requirements:
+// - Inputs above the type maximum return a max error.
+// - Inputs below the type minimum return a min error.
+// - Values inside the range are unaffected.
+// - Serializes to WithRange().
+func TestIntWithRange(t *testing.T) {
+	ruleSet := rules.Int8().WithRange()
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), 300, errors.CodeMax)
+	testhelpers.MustNotApply(t, ruleSet.Any(), float64(300), errors.CodeMax)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "300", errors.CodeMax)
+	testhelpers.MustNotApply(t, ruleSet.Any(), -300, errors.CodeMin)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "-300", errors.CodeMin)
+	testhelpers.MustApplyMutation(t, ruleSet.Any(), 127, int8(127))
+	testhelpers.MustApplyMutation(t, ruleSet.Any(), -128, int8(-128))
+
+	testhelpers.MustNotApply(t, rules.Uint8().WithRange().Any(), -1, errors.CodeMin)
+	testhelpers.MustNotApply(t, rules.Uint64().WithRange().Any(), int64(-1), errors.CodeMin)
+
+	// Other errors are unchanged
+	testhelpers.MustNotApply(t, ruleSet.Any(), 1.5, errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet.WithMax(10).Any(), 11, errors.CodeMax)
+
+	expected := "IntRuleSet[int8].WithRange()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - The type limits are included in the schema.
+// - Explicit limits take precedence.
+func TestIntWithRangeJSONSchema(t *testing.T) {
+	expected := `{"maximum":127,"minimum":-128,"type":"integer"}`
+	if s := mustJSONSchema(t, rules.Int8().WithRange()); s != expected {
+		t.Errorf("Expected schema to be %s, got %s", expected, s)
+	}
+
+	expected = `{"maximum":255,"minimum":5,"type":"integer"}`
+	if s := mustJSONSchema(t, rules.Uint8().WithMin(5).WithRange()); s != expected {
+		t.Errorf("Expected schema to be %s, got %s", expected, s)
+	}
+}
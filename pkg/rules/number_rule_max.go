@@ -51,3 +51,23 @@ func (v *FloatRuleSet[T]) WithMax(max T) *FloatRuleSet[T] {
 		"f",
 	})
 }
+
+// Max returns the maximum value and true if one is set. This is useful for generating documentation or schemas.
+func (v *IntRuleSet[T]) Max() (T, bool) {
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if rule, ok := currentRuleSet.rule.(*maxRule[T]); ok {
+			return rule.max, true
+		}
+	}
+	return 0, false
+}
+
+// Max returns the maximum value and true if one is set. This is useful for generating documentation or schemas.
+func (v *FloatRuleSet[T]) Max() (T, bool) {
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if rule, ok := currentRuleSet.rule.(*maxRule[T]); ok {
+			return rule.max, true
+		}
+	}
+	return 0, false
+}
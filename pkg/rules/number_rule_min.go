@@ -51,3 +51,23 @@ func (v *FloatRuleSet[T]) WithMin(min T) *FloatRuleSet[T] {
 		"f",
 	})
 }
+
+// Min returns the minimum value and true if one is set. This is useful for generating documentation or schemas.
+func (v *IntRuleSet[T]) Min() (T, bool) {
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if rule, ok := currentRuleSet.rule.(*minRule[T]); ok {
+			return rule.min, true
+		}
+	}
+	return 0, false
+}
+
+// Min returns the minimum value and true if one is set. This is useful for generating documentation or schemas.
+func (v *FloatRuleSet[T]) Min() (T, bool) {
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if rule, ok := currentRuleSet.rule.(*minRule[T]); ok {
+			return rule.min, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,64 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// Implements the Rule interface for steps
+type stepRule[T integer] struct {
+	start T
+	step  T
+}
+
+// remainder returns the non-negative remainder of x divided by the step.
+// This avoids computing x - start which could overflow for large values.
+func (rule *stepRule[T]) remainder(x T) T {
+	r := x % rule.step
+	if r < 0 {
+		r += rule.step
+	}
+	return r
+}
+
+// Evaluate takes a context and integer value and returns an error if it is not a whole number of steps from the start.
+func (rule *stepRule[T]) Evaluate(ctx context.Context, value T) errors.ValidationErrorCollection {
+	if rule.remainder(value) != rule.remainder(rule.start) {
+		return errors.Collection(
+			errors.Errorf(errors.CodeStep, ctx, "field must be in increments of %d from %d", rule.step, rule.start),
+		)
+	}
+
+	return nil
+}
+
+// Conflict returns true for any step rule.
+func (rule *stepRule[T]) Conflict(x Rule[T]) bool {
+	_, ok := x.(*stepRule[T])
+	return ok
+}
+
+// String returns the string representation of the step rule.
+// Example: WithStep(10, 10)
+func (rule *stepRule[T]) String() string {
+	return fmt.Sprintf("WithStep(%d, %d)", rule.start, rule.step)
+}
+
+// WithStep returns a new child RuleSet that only allows values that are a whole number of steps from start.
+// For example WithStep(10, 10) allows 10, 20, 30 and so on. Use WithMin and WithMax to limit the range.
+//
+// Values less than start are allowed if they are also a whole number of steps away.
+//
+// This method will panic if step is not greater than 0.
+func (v *IntRuleSet[T]) WithStep(start, step T) *IntRuleSet[T] {
+	if step <= 0 {
+		panic(fmt.Errorf("step must be greater than 0, got: %d", step))
+	}
+
+	return v.WithRule(&stepRule[T]{
+		start,
+		step,
+	})
+}
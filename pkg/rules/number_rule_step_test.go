@@ -0,0 +1,74 @@
+package rules_test
+
+import (
+	"math"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Values a whole number of steps from the start are allowed.
+// - Composes with min and max.
+// - Boundaries at the start and max are allowed.
+func TestWithStep(t *testing.T) {
+	ruleSet := rules.Int().WithStep(10, 10).WithMin(10).WithMax(100).Any()
+
+	testhelpers.MustApply(t, ruleSet, 10)
+	testhelpers.MustApply(t, ruleSet, 50)
+	testhelpers.MustApply(t, ruleSet, 100)
+	testhelpers.MustNotApply(t, ruleSet, 15, errors.CodeStep)
+	testhelpers.MustNotApply(t, ruleSet, 0, errors.CodeMin)
+	testhelpers.MustNotApply(t, ruleSet, 110, errors.CodeMax)
+
+	offset := rules.Int().WithStep(3, 5).Any()
+
+	testhelpers.MustApply(t, offset, 3)
+	testhelpers.MustApply(t, offset, 8)
+	testhelpers.MustApply(t, offset, -2)
+	testhelpers.MustNotApply(t, offset, 5, errors.CodeStep)
+	testhelpers.MustNotApply(t, offset, -3, errors.CodeStep)
+}
+
+// Requirements:
+// - Large values do not overflow.
+func TestWithStep_Overflow(t *testing.T) {
+	ruleSet := rules.Int64().WithStep(math.MinInt64, 2).Any()
+
+	testhelpers.MustApply(t, ruleSet, int64(math.MaxInt64-1))
+	testhelpers.MustNotApply(t, ruleSet, int64(math.MaxInt64), errors.CodeStep)
+
+	large := rules.Int64().WithStep(-1, math.MaxInt64).Any()
+
+	testhelpers.MustApply(t, large, int64(math.MaxInt64-1))
+	testhelpers.MustApply(t, large, int64(-1))
+	testhelpers.MustNotApply(t, large, int64(math.MaxInt64), errors.CodeStep)
+
+	unsigned := rules.Uint8().WithStep(250, 5).Any()
+
+	testhelpers.MustApply(t, unsigned, uint8(0))
+	testhelpers.MustApply(t, unsigned, uint8(255))
+	testhelpers.MustNotApply(t, unsigned, uint8(254), errors.CodeStep)
+}
+
+// Requirements:
+// - Only one step can exist on a rule set.
+// - Serializes to string.
+// - Panics if step is not positive.
+func TestWithStep_String(t *testing.T) {
+	ruleSet := rules.Int().WithStep(1, 2).WithStep(10, 10)
+
+	expected := "IntRuleSet[int].WithStep(10, 10)"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+	rules.Int().WithStep(0, 0)
+}
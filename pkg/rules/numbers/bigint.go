@@ -0,0 +1,293 @@
+package numbers
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"proto.zip/studio/validate/internal/util"
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// BigIntRuleSet implements the RuleSet interface for arbitrary precision integers using math/big.
+//
+// This is useful for values that do not fit in an int64, such as 256 bit token amounts, since they can be
+// validated without a lossy conversion to float.
+type BigIntRuleSet struct {
+	rules.NoConflict[*big.Int]
+	required bool
+	withNil  bool
+	base     int
+	parent   *BigIntRuleSet
+	rule     rules.Rule[*big.Int]
+	label    string
+}
+
+// baseBigIntRuleSet is the base big integer rule set. Since rule sets are immutable.
+var baseBigIntRuleSet BigIntRuleSet = BigIntRuleSet{
+	base:  10,
+	label: "BigIntRuleSet",
+}
+
+// BigInt returns the base big integer RuleSet.
+func BigInt() *BigIntRuleSet {
+	return &baseBigIntRuleSet
+}
+
+// Required returns a boolean indicating if the value is allowed to be omitted when included in a nested object.
+func (ruleSet *BigIntRuleSet) Required() bool {
+	return ruleSet.required
+}
+
+// WithRequired returns a new rule set with the required flag set.
+// Use WithRequired when nesting a RuleSet and the a value is not allowed to be omitted.
+func (ruleSet *BigIntRuleSet) WithRequired() *BigIntRuleSet {
+	if ruleSet.required {
+		return ruleSet
+	}
+
+	return &BigIntRuleSet{
+		required: true,
+		withNil:  ruleSet.withNil,
+		base:     ruleSet.base,
+		parent:   ruleSet,
+		label:    "WithRequired()",
+	}
+}
+
+// WithNil returns a new child rule set that accepts a nil input, such as a JSON null or a nil *big.Int, and leaves
+// the output unchanged. Without WithNil a nil input returns an errors.CodeType error.
+func (ruleSet *BigIntRuleSet) WithNil() *BigIntRuleSet {
+	if ruleSet.withNil {
+		return ruleSet
+	}
+
+	return &BigIntRuleSet{
+		required: ruleSet.required,
+		withNil:  true,
+		base:     ruleSet.base,
+		parent:   ruleSet,
+		label:    "WithNil()",
+	}
+}
+
+// WithBase returns a new child rule set with the number base set.
+// The base is used to parse strings and to format the output when the output is a string.
+// For base 16 an optional "0x" prefix is allowed on input.
+//
+// The default is base 10. This method will panic if the base is not between 2 and 62.
+func (ruleSet *BigIntRuleSet) WithBase(base int) *BigIntRuleSet {
+	if base < 2 || base > big.MaxBase {
+		panic(fmt.Errorf("base must be between 2 and %d, got: %d", big.MaxBase, base))
+	}
+
+	return &BigIntRuleSet{
+		required: ruleSet.required,
+		withNil:  ruleSet.withNil,
+		base:     base,
+		parent:   ruleSet,
+		label:    fmt.Sprintf("WithBase(%d)", base),
+	}
+}
+
+// coerce attempts to convert the input to a new big integer.
+// The returned value never shares memory with the input.
+func (ruleSet *BigIntRuleSet) coerce(ctx context.Context, input any) (*big.Int, errors.ValidationError) {
+	switch x := input.(type) {
+	case *big.Int:
+		if x != nil {
+			return new(big.Int).Set(x), nil
+		}
+		return nil, errors.NewCoercionError(ctx, "big integer", "nil")
+	case big.Int:
+		return new(big.Int).Set(&x), nil
+	}
+
+	rv := reflect.ValueOf(input)
+
+	switch rv.Kind() {
+	case reflect.String:
+		str := rv.String()
+		if ruleSet.base == 16 {
+			str = strings.TrimPrefix(strings.TrimPrefix(str, "0x"), "0X")
+		}
+
+		// Underscores are only allowed by SetString with base 0
+		if value, ok := new(big.Int).SetString(str, ruleSet.base); ok && !strings.Contains(str, "_") {
+			return value, nil
+		}
+		return nil, errors.Errorf(errors.CodeType, ctx, "value is not a valid base %d integer", ruleSet.base)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Int).SetUint64(rv.Uint()), nil
+	case reflect.Invalid:
+		return nil, errors.NewCoercionError(ctx, "big integer", "nil")
+	}
+
+	return nil, errors.NewCoercionError(ctx, "big integer", rv.Kind().String())
+}
+
+// Apply performs a validation of a RuleSet against a value and assigns the result to the output parameter.
+// It returns a ValidationErrorCollection if any validation errors occur.
+//
+// The output may be a *big.Int, a **big.Int, a *string or a pointer to an interface. Strings are formatted using
+// the base of the rule set.
+func (ruleSet *BigIntRuleSet) Apply(ctx context.Context, input any, output any) errors.ValidationErrorCollection {
+	// Nil is only allowed with WithNil
+	if ruleSet.withNil && util.IsNil(input) {
+		return nil
+	}
+
+	// Ensure output is a non-nil pointer
+	outputVal := reflect.ValueOf(output)
+	if outputVal.Kind() != reflect.Ptr || outputVal.IsNil() {
+		return errors.Collection(errors.Errorf(
+			errors.CodeInternal, ctx, "Output must be a non-nil pointer",
+		))
+	}
+
+	value, validationErr := ruleSet.coerce(ctx, input)
+	if validationErr != nil {
+		return errors.Collection(validationErr)
+	}
+
+	errs := ruleSet.Evaluate(ctx, value)
+	if errs.HasErrors() {
+		return errs
+	}
+
+	switch out := output.(type) {
+	case *big.Int:
+		out.Set(value)
+		return errs
+	case **big.Int:
+		*out = value
+		return errs
+	}
+
+	outputElem := outputVal.Elem()
+
+	switch {
+	case outputElem.Kind() == reflect.Interface && outputElem.IsNil():
+		outputElem.Set(reflect.ValueOf(value))
+	case outputElem.Kind() == reflect.String:
+		outputElem.SetString(value.Text(ruleSet.base))
+	default:
+		return errors.Collection(errors.Errorf(
+			errors.CodeInternal, ctx, "Cannot assign %T to %T", value, output,
+		))
+	}
+
+	return errs
+}
+
+// Evaluate performs a validation of a RuleSet against a big integer and returns a ValidationErrorCollection.
+func (ruleSet *BigIntRuleSet) Evaluate(ctx context.Context, value *big.Int) errors.ValidationErrorCollection {
+	if value == nil {
+		return errors.Collection(errors.NewCoercionError(ctx, "big integer", "nil"))
+	}
+
+	allErrors := errors.Collection()
+
+	currentRuleSet := ruleSet
+	ctx = rulecontext.WithRuleSet(ctx, ruleSet)
+
+	for currentRuleSet != nil {
+		if currentRuleSet.rule != nil {
+			if errs := currentRuleSet.rule.Evaluate(ctx, value); errs != nil {
+				allErrors = append(allErrors, errs...)
+			}
+		}
+
+		currentRuleSet = currentRuleSet.parent
+	}
+
+	if len(allErrors) > 0 {
+		return allErrors
+	} else {
+		return nil
+	}
+}
+
+// noConflict returns the new array rule set with all conflicting rules removed.
+// Does not mutate the existing rule sets.
+func (ruleSet *BigIntRuleSet) noConflict(rule rules.Rule[*big.Int]) *BigIntRuleSet {
+	if ruleSet.rule != nil {
+
+		// Conflicting rules, skip this and return the parent
+		if rule.Conflict(ruleSet.rule) {
+			return ruleSet.parent.noConflict(rule)
+		}
+
+	}
+
+	if ruleSet.parent == nil {
+		return ruleSet
+	}
+
+	newParent := ruleSet.parent.noConflict(rule)
+
+	if newParent == ruleSet.parent {
+		return ruleSet
+	}
+
+	return &BigIntRuleSet{
+		rule:     ruleSet.rule,
+		base:     ruleSet.base,
+		parent:   newParent,
+		required: ruleSet.required,
+		withNil:  ruleSet.withNil,
+		label:    ruleSet.label,
+	}
+}
+
+// WithRule returns a new child rule set with a rule added to the list of
+// rules to evaluate. WithRule takes an implementation of the Rule interface
+// for the *big.Int type.
+//
+// Use this when implementing custom rules.
+func (ruleSet *BigIntRuleSet) WithRule(rule rules.Rule[*big.Int]) *BigIntRuleSet {
+	return &BigIntRuleSet{
+		rule:     rule,
+		base:     ruleSet.base,
+		parent:   ruleSet.noConflict(rule),
+		required: ruleSet.required,
+		withNil:  ruleSet.withNil,
+	}
+}
+
+// WithRuleFunc returns a new child rule set with a rule added to the list of
+// rules to evaluate. WithRuleFunc takes an implementation of the Rule interface
+// for the *big.Int type.
+//
+// Use this when implementing custom rules.
+func (v *BigIntRuleSet) WithRuleFunc(rule rules.RuleFunc[*big.Int]) *BigIntRuleSet {
+	return v.WithRule(rule)
+}
+
+// Any returns a new RuleSet that wraps the big integer RuleSet in any Any rule set
+// which can then be used in nested validation.
+func (ruleSet *BigIntRuleSet) Any() rules.RuleSet[any] {
+	return rules.WrapAny[*big.Int](ruleSet)
+}
+
+// String returns a string representation of the rule set suitable for debugging.
+func (ruleSet *BigIntRuleSet) String() string {
+	label := ruleSet.label
+
+	if label == "" {
+		if ruleSet.rule != nil {
+			label = ruleSet.rule.String()
+		}
+	}
+
+	if ruleSet.parent != nil {
+		return ruleSet.parent.String() + "." + label
+	}
+	return label
+}
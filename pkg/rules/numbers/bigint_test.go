@@ -0,0 +1,224 @@
+package numbers_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules/numbers"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// mustParseBigInt parses a base 10 string or fails the test.
+func mustParseBigInt(t testing.TB, s string) *big.Int {
+	t.Helper()
+
+	value, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		t.Fatalf("Unable to parse test value: %s", s)
+	}
+	return value
+}
+
+// checkBigIntEqual compares big integer outputs by value.
+func checkBigIntEqual(a, b any) error {
+	expected, ok := a.(*big.Int)
+	if !ok {
+		return fmt.Errorf("expected value to be *big.Int, got: %T", a)
+	}
+	actual, ok := b.(*big.Int)
+	if !ok {
+		return fmt.Errorf("expected output to be *big.Int, got: %T", b)
+	}
+	if expected.Cmp(actual) != 0 {
+		return fmt.Errorf("expected output to be %s, got: %s", expected, actual)
+	}
+	return nil
+}
+
+// Requirements:
+// - Default configuration doesn't return errors on valid value.
+// - Implements interface.
+func TestBigIntRuleSet(t *testing.T) {
+	value := mustParseBigInt(t, "123456789012345678901234567890")
+
+	var output *big.Int
+
+	err := numbers.BigInt().Apply(context.TODO(), value, &output)
+	if err != nil {
+		t.Fatalf("Expected errors to be empty, got: %s", err)
+	}
+
+	if output.Cmp(value) != 0 {
+		t.Fatalf("Expected output to be %s, got: %s", value, output)
+	}
+
+	if output == value {
+		t.Error("Expected output to not share memory with the input")
+	}
+
+	ok := testhelpers.CheckRuleSetInterface[*big.Int](numbers.BigInt())
+	if !ok {
+		t.Fatal("Expected rule set to be implemented")
+	}
+
+	testhelpers.MustApplyTypes[*big.Int](t, numbers.BigInt(), value)
+}
+
+// Requirements:
+// - Base 10 strings are parsed.
+// - Integer types are coerced.
+// - Malformed strings return CodeType.
+func TestBigIntRuleSet_Coerce(t *testing.T) {
+	ruleSet := numbers.BigInt().Any()
+
+	testhelpers.MustApplyFunc(t, ruleSet, "-98765432109876543210", mustParseBigInt(t, "-98765432109876543210"), checkBigIntEqual)
+	testhelpers.MustApplyFunc(t, ruleSet, 42, big.NewInt(42), checkBigIntEqual)
+	testhelpers.MustApplyFunc(t, ruleSet, uint64(18446744073709551615), mustParseBigInt(t, "18446744073709551615"), checkBigIntEqual)
+	testhelpers.MustApplyFunc(t, ruleSet, *big.NewInt(7), big.NewInt(7), checkBigIntEqual)
+
+	testhelpers.MustNotApply(t, ruleSet, "12a", errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet, "", errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet, "1_000", errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet, 1.5, errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet, nil, errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet, (*big.Int)(nil), errors.CodeType)
+}
+
+// Requirements:
+// - Base 16 strings are parsed with or without a 0x prefix.
+// - Base 10 only strings fail in base 16 and the reverse.
+// - String output uses the rule set base.
+func TestBigIntRuleSet_WithBase(t *testing.T) {
+	ruleSet := numbers.BigInt().WithBase(16)
+
+	testhelpers.MustApplyFunc(t, ruleSet.Any(), "ff", big.NewInt(255), checkBigIntEqual)
+	testhelpers.MustApplyFunc(t, ruleSet.Any(), "0xFF", big.NewInt(255), checkBigIntEqual)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "0xzz", errors.CodeType)
+	testhelpers.MustNotApply(t, numbers.BigInt().Any(), "ff", errors.CodeType)
+
+	var output string
+	if err := ruleSet.Apply(context.TODO(), "0x1F", &output); err != nil {
+		t.Fatalf("Expected error to be nil, got: %s", err)
+	} else if output != "1f" {
+		t.Errorf("Expected output to be %q, got: %q", "1f", output)
+	}
+
+	if err := numbers.BigInt().Apply(context.TODO(), 31, &output); err != nil {
+		t.Fatalf("Expected error to be nil, got: %s", err)
+	} else if output != "31" {
+		t.Errorf("Expected output to be %q, got: %q", "31", output)
+	}
+}
+
+// Requirements:
+// - WithBase panics on an invalid base.
+func TestBigIntRuleSet_WithBasePanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic")
+		}
+	}()
+
+	numbers.BigInt().WithBase(1)
+}
+
+// Requirements:
+// - Output can be assigned to a non-nil *big.Int.
+// - Incompatible outputs return CodeInternal.
+func TestBigIntRuleSet_Output(t *testing.T) {
+	output := new(big.Int)
+
+	if err := numbers.BigInt().Apply(context.TODO(), "12", output); err != nil {
+		t.Fatalf("Expected error to be nil, got: %s", err)
+	} else if output.Cmp(big.NewInt(12)) != 0 {
+		t.Errorf("Expected output to be 12, got: %s", output)
+	}
+
+	var outputInt int
+	err := numbers.BigInt().Apply(context.TODO(), "12", &outputInt)
+	if err == nil {
+		t.Error("Expected error to not be nil")
+	} else if code := err.First().Code(); code != errors.CodeInternal {
+		t.Errorf("Expected error code to be %s, got: %s", errors.CodeInternal, code)
+	}
+}
+
+// Requirements:
+// - Required flag can be set.
+// - Required flag can be read.
+// - Required flag defaults to false.
+func TestBigIntRuleSet_WithRequired(t *testing.T) {
+	ruleSet := numbers.BigInt()
+
+	if ruleSet.Required() {
+		t.Error("Expected rule set to not be required")
+	}
+
+	ruleSet = ruleSet.WithRequired()
+
+	if !ruleSet.Required() {
+		t.Error("Expected rule set to be required")
+	}
+
+	if ruleSet.WithRequired() != ruleSet {
+		t.Error("Expected WithRequired to return the same rule set when already required")
+	}
+}
+
+// Requirements:
+// - Custom rules are evaluated.
+func TestBigIntRuleSet_WithRuleFunc(t *testing.T) {
+	ruleSet := numbers.BigInt().
+		WithRuleFunc(func(_ context.Context, value *big.Int) errors.ValidationErrorCollection {
+			if value.Bit(0) == 1 {
+				return errors.Collection(errors.Errorf(errors.CodePattern, context.TODO(), "value must be even"))
+			}
+			return nil
+		}).
+		Any()
+
+	testhelpers.MustApplyFunc(t, ruleSet, "2", big.NewInt(2), checkBigIntEqual)
+	testhelpers.MustNotApply(t, ruleSet, "3", errors.CodePattern)
+}
+
+// Requirements:
+// - Serializes to BigIntRuleSet.
+func TestBigIntRuleSet_String(t *testing.T) {
+	ruleSet := numbers.BigInt().WithRequired().WithBase(16)
+
+	expected := "BigIntRuleSet.WithRequired().WithBase(16)"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Evaluate returns an error on nil.
+func TestBigIntRuleSet_EvaluateNil(t *testing.T) {
+	err := numbers.BigInt().Evaluate(context.TODO(), nil)
+	if err == nil {
+		t.Error("Expected error to not be nil")
+	} else if code := err.First().Code(); code != errors.CodeType {
+		t.Errorf("Expected error code to be %s, got: %s", errors.CodeType, code)
+	}
+}
+
+// Requirements:
+// - Rules that only return warnings do not prevent the output from being set.
+// - The warnings are returned.
+func TestBigInt_Warning(t *testing.T) {
+	warn := func(ctx context.Context, _ *big.Int) errors.ValidationErrorCollection {
+		return errors.Collection(errors.WithSeverity(errors.Errorf(errors.CodeUnknown, ctx, "just a warning"), errors.SeverityWarning))
+	}
+
+	var out string
+	errs := numbers.BigInt().WithRuleFunc(warn).Apply(context.Background(), "123", &out)
+	if errs.HasErrors() || len(errs.Warnings()) != 1 {
+		t.Errorf("Expected 1 warning and no errors, got: %s", errs)
+	} else if out != "123" {
+		t.Errorf("Expected output to be set, got: %v", out)
+	}
+}
@@ -0,0 +1,304 @@
+package numbers
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"proto.zip/studio/validate/internal/util"
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// decimalPattern matches plain decimal numbers. Scientific notation, thousands separators, a leading plus sign
+// and a missing integer or fractional part are not allowed.
+var decimalPattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// baseDecimalRuleSet is the base decimal rule set. Since rule sets are immutable.
+var baseDecimalRuleSet DecimalRuleSet = DecimalRuleSet{
+	label: "DecimalRuleSet",
+}
+
+// DecimalRuleSet implements the RuleSet interface for fixed precision decimal numbers such as currency amounts.
+//
+// Values are kept as strings so that no precision is lost and the number of fractional digits is preserved.
+// For example "19.90" is not rewritten to "19.9". Apply can also output a *big.Rat for callers that need to
+// perform arithmetic on the value.
+type DecimalRuleSet struct {
+	rules.NoConflict[string]
+	required   bool
+	withNil    bool
+	minorUnits int
+	parent     *DecimalRuleSet
+	rule       rules.Rule[string]
+	label      string
+}
+
+// Decimal returns the base decimal RuleSet.
+func Decimal() *DecimalRuleSet {
+	return &baseDecimalRuleSet
+}
+
+// withParent returns a new child rule set with the flags copied from the current rule set.
+func (ruleSet *DecimalRuleSet) withParent() *DecimalRuleSet {
+	return &DecimalRuleSet{
+		required:   ruleSet.required,
+		withNil:    ruleSet.withNil,
+		minorUnits: ruleSet.minorUnits,
+		parent:     ruleSet,
+	}
+}
+
+// Required returns a boolean indicating if the value is allowed to be omitted when included in a nested object.
+func (ruleSet *DecimalRuleSet) Required() bool {
+	return ruleSet.required
+}
+
+// WithRequired returns a new rule set with the required flag set.
+// Use WithRequired when nesting a RuleSet and the a value is not allowed to be omitted.
+func (ruleSet *DecimalRuleSet) WithRequired() *DecimalRuleSet {
+	if ruleSet.required {
+		return ruleSet
+	}
+
+	newRuleSet := ruleSet.withParent()
+	newRuleSet.required = true
+	newRuleSet.label = "WithRequired()"
+	return newRuleSet
+}
+
+// WithNil returns a new child rule set that accepts a nil input, such as a JSON null, and leaves the output
+// unchanged. Otherwise nil returns an errors.CodeType error.
+func (ruleSet *DecimalRuleSet) WithNil() *DecimalRuleSet {
+	if ruleSet.withNil {
+		return ruleSet
+	}
+
+	newRuleSet := ruleSet.withParent()
+	newRuleSet.withNil = true
+	newRuleSet.label = "WithNil()"
+	return newRuleSet
+}
+
+// WithMinorUnits returns a new rule set that treats integer input as an amount of minor units with the
+// provided number of fractional digits. For example with a scale of 2 the integer 1999 is read as "19.99".
+//
+// By default integer input is read as a whole number. This method panics if the scale is negative.
+func (ruleSet *DecimalRuleSet) WithMinorUnits(scale int) *DecimalRuleSet {
+	if scale < 0 {
+		panic(fmt.Errorf("scale must not be negative, got: %d", scale))
+	}
+
+	newRuleSet := ruleSet.withParent()
+	newRuleSet.minorUnits = scale
+	newRuleSet.label = fmt.Sprintf("WithMinorUnits(%d)", scale)
+	return newRuleSet
+}
+
+// parseDecimal returns the value of a decimal string and true or nil and false if the string is not
+// a valid decimal.
+func parseDecimal(value string) (*big.Rat, bool) {
+	if !decimalPattern.MatchString(value) {
+		return nil, false
+	}
+	return new(big.Rat).SetString(value)
+}
+
+// decimalScale returns the number of fractional digits in a decimal string.
+func decimalScale(value string) int {
+	if i := strings.IndexByte(value, '.'); i >= 0 {
+		return len(value) - i - 1
+	}
+	return 0
+}
+
+// coerce attempts to convert the input to a decimal string.
+func (ruleSet *DecimalRuleSet) coerce(ctx context.Context, input any) (string, errors.ValidationError) {
+	rv := reflect.ValueOf(input)
+
+	var unscaled *big.Int
+
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		unscaled = big.NewInt(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		unscaled = new(big.Int).SetUint64(rv.Uint())
+	case reflect.Invalid:
+		return "", errors.NewCoercionError(ctx, "decimal", "nil")
+	default:
+		return "", errors.NewCoercionError(ctx, "decimal", rv.Kind().String())
+	}
+
+	if ruleSet.minorUnits == 0 {
+		return unscaled.String(), nil
+	}
+
+	return new(big.Rat).SetFrac(unscaled, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(ruleSet.minorUnits)), nil)).
+		FloatString(ruleSet.minorUnits), nil
+}
+
+// Apply performs a validation of a RuleSet against a value and assigns the result to the output parameter.
+// It returns a ValidationErrorCollection if any validation errors occur.
+//
+// The output may be a *string, a *big.Rat, a **big.Rat or a pointer to an interface. Interfaces are assigned
+// the string value.
+func (ruleSet *DecimalRuleSet) Apply(ctx context.Context, input any, output any) errors.ValidationErrorCollection {
+	// Nil is only allowed with WithNil
+	if ruleSet.withNil && util.IsNil(input) {
+		return nil
+	}
+
+	// Ensure output is a non-nil pointer
+	outputVal := reflect.ValueOf(output)
+	if outputVal.Kind() != reflect.Ptr || outputVal.IsNil() {
+		return errors.Collection(errors.Errorf(
+			errors.CodeInternal, ctx, "Output must be a non-nil pointer",
+		))
+	}
+
+	value, validationErr := ruleSet.coerce(ctx, input)
+	if validationErr != nil {
+		return errors.Collection(validationErr)
+	}
+
+	errs := ruleSet.Evaluate(ctx, value)
+	if errs.HasErrors() {
+		return errs
+	}
+
+	switch out := output.(type) {
+	case *big.Rat:
+		out.SetString(value)
+		return errs
+	case **big.Rat:
+		*out, _ = new(big.Rat).SetString(value)
+		return errs
+	}
+
+	outputElem := outputVal.Elem()
+
+	switch outputElem.Kind() {
+	case reflect.String:
+		outputElem.SetString(value)
+	case reflect.Interface:
+		outputElem.Set(reflect.ValueOf(value))
+	default:
+		return errors.Collection(errors.Errorf(
+			errors.CodeInternal, ctx, "Cannot assign decimal to %T", output,
+		))
+	}
+
+	return errs
+}
+
+// Evaluate performs a validation of a RuleSet against a decimal string and returns a ValidationErrorCollection.
+func (ruleSet *DecimalRuleSet) Evaluate(ctx context.Context, value string) errors.ValidationErrorCollection {
+	if _, ok := parseDecimal(value); !ok {
+		return errors.Collection(errors.Errorf(errors.CodePattern, ctx, "value is not a valid decimal"))
+	}
+
+	allErrors := errors.Collection()
+
+	currentRuleSet := ruleSet
+	ctx = rulecontext.WithRuleSet(ctx, ruleSet)
+
+	for currentRuleSet != nil {
+		if currentRuleSet.rule != nil {
+			if errs := currentRuleSet.rule.Evaluate(ctx, value); errs != nil {
+				allErrors = append(allErrors, errs...)
+			}
+		}
+
+		currentRuleSet = currentRuleSet.parent
+	}
+
+	if len(allErrors) > 0 {
+		return allErrors
+	} else {
+		return nil
+	}
+}
+
+// noConflict returns the new array rule set with all conflicting rules removed.
+// Does not mutate the existing rule sets.
+func (ruleSet *DecimalRuleSet) noConflict(rule rules.Rule[string]) *DecimalRuleSet {
+	if ruleSet.rule != nil {
+
+		// Conflicting rules, skip this and return the parent
+		if rule.Conflict(ruleSet.rule) {
+			return ruleSet.parent.noConflict(rule)
+		}
+
+	}
+
+	if ruleSet.parent == nil {
+		return ruleSet
+	}
+
+	newParent := ruleSet.parent.noConflict(rule)
+
+	if newParent == ruleSet.parent {
+		return ruleSet
+	}
+
+	return &DecimalRuleSet{
+		rule:       ruleSet.rule,
+		parent:     newParent,
+		required:   ruleSet.required,
+		withNil:    ruleSet.withNil,
+		minorUnits: ruleSet.minorUnits,
+		label:      ruleSet.label,
+	}
+}
+
+// WithRule returns a new child rule set with a rule added to the list of
+// rules to evaluate. WithRule takes an implementation of the Rule interface
+// for the string type.
+//
+// Use this when implementing custom rules.
+func (ruleSet *DecimalRuleSet) WithRule(rule rules.Rule[string]) *DecimalRuleSet {
+	return &DecimalRuleSet{
+		rule:       rule,
+		parent:     ruleSet.noConflict(rule),
+		required:   ruleSet.required,
+		withNil:    ruleSet.withNil,
+		minorUnits: ruleSet.minorUnits,
+	}
+}
+
+// WithRuleFunc returns a new child rule set with a rule added to the list of
+// rules to evaluate. WithRuleFunc takes an implementation of the Rule interface
+// for the string type.
+//
+// Use this when implementing custom rules.
+func (v *DecimalRuleSet) WithRuleFunc(rule rules.RuleFunc[string]) *DecimalRuleSet {
+	return v.WithRule(rule)
+}
+
+// Any returns a new RuleSet that wraps the decimal RuleSet in any Any rule set
+// which can then be used in nested validation.
+func (ruleSet *DecimalRuleSet) Any() rules.RuleSet[any] {
+	return rules.WrapAny[string](ruleSet)
+}
+
+// String returns a string representation of the rule set suitable for debugging.
+func (ruleSet *DecimalRuleSet) String() string {
+	label := ruleSet.label
+
+	if label == "" {
+		if ruleSet.rule != nil {
+			label = ruleSet.rule.String()
+		}
+	}
+
+	if ruleSet.parent != nil {
+		return ruleSet.parent.String() + "." + label
+	}
+	return label
+}
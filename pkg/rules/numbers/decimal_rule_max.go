@@ -0,0 +1,54 @@
+package numbers
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// Implements the Rule interface for maximum
+type maxDecimalRule struct {
+	max     *big.Rat
+	display string
+}
+
+// Evaluate takes a context and decimal value and returns an error if it is not equal or less than the specified value.
+func (rule *maxDecimalRule) Evaluate(ctx context.Context, value string) errors.ValidationErrorCollection {
+	if rat, ok := parseDecimal(value); ok && rat.Cmp(rule.max) > 0 {
+		return errors.Collection(
+			errors.Errorf(errors.CodeMax, ctx, "field must be less than %s", rule.display),
+		)
+	}
+
+	return nil
+}
+
+// Conflict returns true for any maximum rule.
+func (rule *maxDecimalRule) Conflict(x rules.Rule[string]) bool {
+	_, ok := x.(*maxDecimalRule)
+	return ok
+}
+
+// String returns the string representation of the maximum rule.
+// Example: WithMax("2.50")
+func (rule *maxDecimalRule) String() string {
+	return fmt.Sprintf("WithMax(%q)", rule.display)
+}
+
+// WithMax returns a new child RuleSet that is constrained to the provided maximum value.
+//
+// This method panics if max is not a valid decimal.
+func (v *DecimalRuleSet) WithMax(max string) *DecimalRuleSet {
+	rat, ok := parseDecimal(max)
+	if !ok {
+		panic(fmt.Errorf("invalid decimal: %q", max))
+	}
+
+	return v.WithRule(&maxDecimalRule{
+		max:     rat,
+		display: max,
+	})
+}
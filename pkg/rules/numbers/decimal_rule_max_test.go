@@ -0,0 +1,37 @@
+package numbers_test
+
+import (
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules/numbers"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Values above the maximum return CodeMax.
+// - Values equal to or below the maximum pass.
+// - Most recent maximum is used.
+func TestDecimalRuleSet_WithMax(t *testing.T) {
+	ruleSet := numbers.Decimal().WithMax("99.99")
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), "100", errors.CodeMax)
+	testhelpers.MustApply(t, ruleSet.Any(), "99.99")
+	testhelpers.MustApply(t, ruleSet.Any(), "-1000")
+
+	ruleSet = ruleSet.WithMax("1000")
+	testhelpers.MustApply(t, ruleSet.Any(), "100")
+
+	expected := `DecimalRuleSet.WithMax("1000")`
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic")
+		}
+	}()
+
+	numbers.Decimal().WithMax("1,000")
+}
@@ -0,0 +1,85 @@
+package numbers
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// Implements the Rule interface for minimum
+type minDecimalRule struct {
+	min     *big.Rat
+	display string
+}
+
+// Evaluate takes a context and decimal value and returns an error if it is not equal or greater than the specified value.
+func (rule *minDecimalRule) Evaluate(ctx context.Context, value string) errors.ValidationErrorCollection {
+	if rat, ok := parseDecimal(value); ok && rat.Cmp(rule.min) < 0 {
+		return errors.Collection(
+			errors.Errorf(errors.CodeMin, ctx, "field must be greater than %s", rule.display),
+		)
+	}
+
+	return nil
+}
+
+// Conflict returns true for any minimum rule.
+func (rule *minDecimalRule) Conflict(x rules.Rule[string]) bool {
+	_, ok := x.(*minDecimalRule)
+	return ok
+}
+
+// String returns the string representation of the minimum rule.
+// Example: WithMin("2.50")
+func (rule *minDecimalRule) String() string {
+	return fmt.Sprintf("WithMin(%q)", rule.display)
+}
+
+// WithMin returns a new child RuleSet that is constrained to the provided minimum value.
+//
+// This method panics if min is not a valid decimal.
+func (v *DecimalRuleSet) WithMin(min string) *DecimalRuleSet {
+	rat, ok := parseDecimal(min)
+	if !ok {
+		panic(fmt.Errorf("invalid decimal: %q", min))
+	}
+
+	return v.WithRule(&minDecimalRule{
+		min:     rat,
+		display: min,
+	})
+}
+
+// Implements the Rule interface for non-negative values
+type nonNegativeDecimalRule struct{}
+
+// Evaluate takes a context and decimal value and returns an error if it is less than zero.
+func (rule *nonNegativeDecimalRule) Evaluate(ctx context.Context, value string) errors.ValidationErrorCollection {
+	if rat, ok := parseDecimal(value); ok && rat.Sign() < 0 {
+		return errors.Collection(
+			errors.Errorf(errors.CodeMin, ctx, "field must not be negative"),
+		)
+	}
+
+	return nil
+}
+
+// Conflict returns true for any non-negative rule.
+func (rule *nonNegativeDecimalRule) Conflict(x rules.Rule[string]) bool {
+	_, ok := x.(*nonNegativeDecimalRule)
+	return ok
+}
+
+// String returns the string representation of the non-negative rule.
+func (rule *nonNegativeDecimalRule) String() string {
+	return "WithNonNegative()"
+}
+
+// WithNonNegative returns a new child RuleSet that does not allow values less than zero.
+// Negative zero ("-0.00") is allowed.
+func (v *DecimalRuleSet) WithNonNegative() *DecimalRuleSet {
+	return v.WithRule(&nonNegativeDecimalRule{})
+}
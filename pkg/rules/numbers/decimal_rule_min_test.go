@@ -0,0 +1,55 @@
+package numbers_test
+
+import (
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules/numbers"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Values below the minimum return CodeMin.
+// - Values equal to or above the minimum pass regardless of scale.
+// - Most recent minimum is used.
+func TestDecimalRuleSet_WithMin(t *testing.T) {
+	ruleSet := numbers.Decimal().WithMin("10.50")
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), "10.49", errors.CodeMin)
+	testhelpers.MustApply(t, ruleSet.Any(), "10.5")
+	testhelpers.MustApply(t, ruleSet.Any(), "10.500")
+	testhelpers.MustApply(t, ruleSet.Any(), "11")
+
+	ruleSet = ruleSet.WithMin("-1")
+	testhelpers.MustApply(t, ruleSet.Any(), "-0.99")
+	testhelpers.MustNotApply(t, ruleSet.Any(), "-1.01", errors.CodeMin)
+
+	expected := `DecimalRuleSet.WithMin("-1")`
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - WithMin panics on an invalid decimal.
+func TestDecimalRuleSet_WithMinPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic")
+		}
+	}()
+
+	numbers.Decimal().WithMin("1e3")
+}
+
+// Requirements:
+// - Negative values return CodeMin.
+// - Zero and negative zero are allowed.
+func TestDecimalRuleSet_WithNonNegative(t *testing.T) {
+	ruleSet := numbers.Decimal().WithNonNegative().Any()
+
+	testhelpers.MustNotApply(t, ruleSet, "-0.01", errors.CodeMin)
+	testhelpers.MustApply(t, ruleSet, "0")
+	testhelpers.MustApply(t, ruleSet, "-0.00")
+	testhelpers.MustApply(t, ruleSet, "0.01")
+}
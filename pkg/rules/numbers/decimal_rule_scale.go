@@ -0,0 +1,52 @@
+package numbers
+
+import (
+	"context"
+	"fmt"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// Implements the Rule interface for the maximum number of fractional digits.
+type maxScaleDecimalRule struct {
+	scale int
+}
+
+// Evaluate takes a context and decimal value and returns an error if it has more fractional digits than allowed.
+// Trailing zeros are counted so "1.500" has a scale of 3.
+func (rule *maxScaleDecimalRule) Evaluate(ctx context.Context, value string) errors.ValidationErrorCollection {
+	if decimalScale(value) > rule.scale {
+		return errors.Collection(
+			errors.Errorf(errors.CodePattern, ctx, "field must have at most %d decimal places", rule.scale),
+		)
+	}
+
+	return nil
+}
+
+// Conflict returns true for any maximum scale rule.
+func (rule *maxScaleDecimalRule) Conflict(x rules.Rule[string]) bool {
+	_, ok := x.(*maxScaleDecimalRule)
+	return ok
+}
+
+// String returns the string representation of the maximum scale rule.
+// Example: WithMaxScale(2)
+func (rule *maxScaleDecimalRule) String() string {
+	return fmt.Sprintf("WithMaxScale(%d)", rule.scale)
+}
+
+// WithMaxScale returns a new child RuleSet that is constrained to the provided number of fractional digits.
+// A scale of 0 only allows whole numbers.
+//
+// This method panics if the scale is negative.
+func (v *DecimalRuleSet) WithMaxScale(scale int) *DecimalRuleSet {
+	if scale < 0 {
+		panic(fmt.Errorf("scale must not be negative, got: %d", scale))
+	}
+
+	return v.WithRule(&maxScaleDecimalRule{
+		scale,
+	})
+}
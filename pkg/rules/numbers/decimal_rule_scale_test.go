@@ -0,0 +1,40 @@
+package numbers_test
+
+import (
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules/numbers"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Values with more fractional digits than allowed return CodePattern.
+// - Trailing zeros count towards the scale.
+// - Most recent scale is used.
+func TestDecimalRuleSet_WithMaxScale(t *testing.T) {
+	ruleSet := numbers.Decimal().WithMaxScale(2)
+
+	testhelpers.MustApply(t, ruleSet.Any(), "19")
+	testhelpers.MustApply(t, ruleSet.Any(), "19.9")
+	testhelpers.MustApply(t, ruleSet.Any(), "19.99")
+	testhelpers.MustNotApply(t, ruleSet.Any(), "19.999", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "1.500", errors.CodePattern)
+
+	ruleSet = ruleSet.WithMaxScale(0)
+	testhelpers.MustApply(t, ruleSet.Any(), "19")
+	testhelpers.MustNotApply(t, ruleSet.Any(), "19.9", errors.CodePattern)
+
+	expected := "DecimalRuleSet.WithMaxScale(0)"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic")
+		}
+	}()
+
+	numbers.Decimal().WithMaxScale(-1)
+}
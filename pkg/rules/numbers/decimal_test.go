@@ -0,0 +1,159 @@
+package numbers_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules/numbers"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Default configuration doesn't return errors on valid value.
+// - Implements interface.
+func TestDecimalRuleSet(t *testing.T) {
+	var output string
+
+	err := numbers.Decimal().Apply(context.TODO(), "19.90", &output)
+	if err != nil {
+		t.Fatalf("Expected errors to be empty, got: %s", err)
+	}
+
+	if output != "19.90" {
+		t.Fatalf("Expected output to be %q, got: %q", "19.90", output)
+	}
+
+	ok := testhelpers.CheckRuleSetInterface[string](numbers.Decimal())
+	if !ok {
+		t.Fatal("Expected rule set to be implemented")
+	}
+
+	testhelpers.MustApplyTypes[string](t, numbers.Decimal(), "19.99")
+}
+
+// Requirements:
+// - Plain decimals are allowed.
+// - Scientific notation, separators and partial numbers return CodePattern.
+// - Floats return CodeType.
+func TestDecimalRuleSet_Format(t *testing.T) {
+	ruleSet := numbers.Decimal().Any()
+
+	testhelpers.MustApply(t, ruleSet, "0")
+	testhelpers.MustApply(t, ruleSet, "-12.5")
+	testhelpers.MustApply(t, ruleSet, "123456789012345678901234567890.123456789")
+
+	testhelpers.MustNotApply(t, ruleSet, "1e5", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet, "1,000.00", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet, "1_000", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet, "+1", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet, ".5", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet, "5.", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet, "", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet, " 1", errors.CodePattern)
+
+	testhelpers.MustNotApply(t, ruleSet, 1.5, errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet, nil, errors.CodeType)
+}
+
+// Requirements:
+// - Integers are whole numbers by default.
+// - Integers are minor units when WithMinorUnits is used.
+func TestDecimalRuleSet_WithMinorUnits(t *testing.T) {
+	testhelpers.MustApplyMutation(t, numbers.Decimal().Any(), 1999, "1999")
+
+	ruleSet := numbers.Decimal().WithMinorUnits(2).Any()
+	testhelpers.MustApplyMutation(t, ruleSet, 1999, "19.99")
+	testhelpers.MustApplyMutation(t, ruleSet, -5, "-0.05")
+	testhelpers.MustApplyMutation(t, ruleSet, uint8(100), "1.00")
+	testhelpers.MustApply(t, ruleSet, "3.5")
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic")
+		}
+	}()
+
+	numbers.Decimal().WithMinorUnits(-1)
+}
+
+// Requirements:
+// - Output can be a *big.Rat.
+// - Incompatible outputs return CodeInternal.
+func TestDecimalRuleSet_Output(t *testing.T) {
+	var ratPtr *big.Rat
+	if err := numbers.Decimal().Apply(context.TODO(), "19.99", &ratPtr); err != nil {
+		t.Fatalf("Expected error to be nil, got: %s", err)
+	} else if ratPtr.Cmp(big.NewRat(1999, 100)) != 0 {
+		t.Errorf("Expected output to be 19.99, got: %s", ratPtr.FloatString(2))
+	}
+
+	rat := new(big.Rat)
+	if err := numbers.Decimal().Apply(context.TODO(), "-0.25", rat); err != nil {
+		t.Fatalf("Expected error to be nil, got: %s", err)
+	} else if rat.Cmp(big.NewRat(-1, 4)) != 0 {
+		t.Errorf("Expected output to be -0.25, got: %s", rat.FloatString(2))
+	}
+
+	var outputFloat float64
+	err := numbers.Decimal().Apply(context.TODO(), "1", &outputFloat)
+	if err == nil {
+		t.Error("Expected error to not be nil")
+	} else if code := err.First().Code(); code != errors.CodeInternal {
+		t.Errorf("Expected error code to be %s, got: %s", errors.CodeInternal, code)
+	}
+}
+
+// Requirements:
+// - Required flag can be set.
+// - Required flag can be read.
+// - Required flag defaults to false.
+func TestDecimalRuleSet_WithRequired(t *testing.T) {
+	ruleSet := numbers.Decimal()
+
+	if ruleSet.Required() {
+		t.Error("Expected rule set to not be required")
+	}
+
+	ruleSet = ruleSet.WithRequired()
+
+	if !ruleSet.Required() {
+		t.Error("Expected rule set to be required")
+	}
+}
+
+// Requirements:
+// - Custom rules are evaluated.
+func TestDecimalRuleSet_WithRuleFunc(t *testing.T) {
+	ruleSet := numbers.Decimal().WithRuleFunc(testhelpers.NewMockRuleWithErrors[string](1).Function()).Any()
+	testhelpers.MustNotApply(t, ruleSet, "1.00", errors.CodeUnknown)
+}
+
+// Requirements:
+// - Serializes to DecimalRuleSet.
+func TestDecimalRuleSet_String(t *testing.T) {
+	ruleSet := numbers.Decimal().WithRequired().WithMinorUnits(2).WithMaxScale(2).WithNonNegative().WithMax("100.00")
+
+	expected := `DecimalRuleSet.WithRequired().WithMinorUnits(2).WithMaxScale(2).WithNonNegative().WithMax("100.00")`
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Rules that only return warnings do not prevent the output from being set.
+// - The warnings are returned.
+func TestDecimal_Warning(t *testing.T) {
+	warn := func(ctx context.Context, _ string) errors.ValidationErrorCollection {
+		return errors.Collection(errors.WithSeverity(errors.Errorf(errors.CodeUnknown, ctx, "just a warning"), errors.SeverityWarning))
+	}
+
+	var out string
+	errs := numbers.Decimal().WithRuleFunc(warn).Apply(context.Background(), "1.5", &out)
+	if errs.HasErrors() || len(errs.Warnings()) != 1 {
+		t.Errorf("Expected 1 warning and no errors, got: %s", errs)
+	} else if out != "1.5" {
+		t.Errorf("Expected output to be set, got: %v", out)
+	}
+}
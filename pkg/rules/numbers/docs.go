@@ -0,0 +1,2 @@
+// Package numbers provides RuleSet implementations for arbitrary precision numbers.
+package numbers
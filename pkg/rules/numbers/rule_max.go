@@ -0,0 +1,46 @@
+package numbers
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// Implements the Rule interface for maximum
+type maxBigIntRule struct {
+	max *big.Int
+}
+
+// Evaluate takes a context and big integer value and returns an error if it is not equal or less than the specified value.
+func (rule *maxBigIntRule) Evaluate(ctx context.Context, value *big.Int) errors.ValidationErrorCollection {
+	if value.Cmp(rule.max) > 0 {
+		return errors.Collection(
+			errors.Errorf(errors.CodeMax, ctx, "field must be less than %s", rule.max),
+		)
+	}
+
+	return nil
+}
+
+// Conflict returns true for any maximum rule.
+func (rule *maxBigIntRule) Conflict(x rules.Rule[*big.Int]) bool {
+	_, ok := x.(*maxBigIntRule)
+	return ok
+}
+
+// String returns the string representation of the maximum rule.
+// Example: WithMax(2)
+func (rule *maxBigIntRule) String() string {
+	return fmt.Sprintf("WithMax(%s)", rule.max)
+}
+
+// WithMax returns a new child RuleSet that is constrained to the provided maximum value.
+// The value is copied so later changes to max do not affect the rule set.
+func (v *BigIntRuleSet) WithMax(max *big.Int) *BigIntRuleSet {
+	return v.WithRule(&maxBigIntRule{
+		new(big.Int).Set(max),
+	})
+}
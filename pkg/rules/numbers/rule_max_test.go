@@ -0,0 +1,41 @@
+package numbers_test
+
+import (
+	"math/big"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules/numbers"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Values above the maximum return CodeMax.
+// - Values equal to or below the maximum pass.
+// - Works beyond the range of int64.
+func TestBigIntRuleSet_WithMax(t *testing.T) {
+	max := mustParseBigInt(t, "100000000000000000000")
+
+	ruleSet := numbers.BigInt().WithMax(max).Any()
+
+	testhelpers.MustNotApply(t, ruleSet, "100000000000000000001", errors.CodeMax)
+	testhelpers.MustApplyFunc(t, ruleSet, "100000000000000000000", max, checkBigIntEqual)
+	testhelpers.MustApplyFunc(t, ruleSet, "-100000000000000000000", mustParseBigInt(t, "-100000000000000000000"), checkBigIntEqual)
+}
+
+// Requirements:
+// - Only one maximum can exist on a rule set.
+// - Most recent maximum is used.
+func TestBigIntRuleSet_WithMaxConflict(t *testing.T) {
+	ruleSet := numbers.BigInt().WithMax(big.NewInt(10)).WithMin(big.NewInt(0))
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), 15, errors.CodeMax)
+
+	ruleSet2 := ruleSet.WithMax(big.NewInt(20))
+	testhelpers.MustApplyFunc(t, ruleSet2.Any(), 15, big.NewInt(15), checkBigIntEqual)
+
+	expected := "BigIntRuleSet.WithMin(0).WithMax(20)"
+	if s := ruleSet2.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
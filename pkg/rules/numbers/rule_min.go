@@ -0,0 +1,46 @@
+package numbers
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// Implements the Rule interface for minimum
+type minBigIntRule struct {
+	min *big.Int
+}
+
+// Evaluate takes a context and big integer value and returns an error if it is not equal or greater than the specified value.
+func (rule *minBigIntRule) Evaluate(ctx context.Context, value *big.Int) errors.ValidationErrorCollection {
+	if value.Cmp(rule.min) < 0 {
+		return errors.Collection(
+			errors.Errorf(errors.CodeMin, ctx, "field must be greater than %s", rule.min),
+		)
+	}
+
+	return nil
+}
+
+// Conflict returns true for any minimum rule.
+func (rule *minBigIntRule) Conflict(x rules.Rule[*big.Int]) bool {
+	_, ok := x.(*minBigIntRule)
+	return ok
+}
+
+// String returns the string representation of the minimum rule.
+// Example: WithMin(2)
+func (rule *minBigIntRule) String() string {
+	return fmt.Sprintf("WithMin(%s)", rule.min)
+}
+
+// WithMin returns a new child RuleSet that is constrained to the provided minimum value.
+// The value is copied so later changes to min do not affect the rule set.
+func (v *BigIntRuleSet) WithMin(min *big.Int) *BigIntRuleSet {
+	return v.WithRule(&minBigIntRule{
+		new(big.Int).Set(min),
+	})
+}
@@ -0,0 +1,46 @@
+package numbers_test
+
+import (
+	"math/big"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules/numbers"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Values below the minimum return CodeMin.
+// - Values equal to or above the minimum pass.
+// - Works beyond the range of int64.
+func TestBigIntRuleSet_WithMin(t *testing.T) {
+	min := mustParseBigInt(t, "100000000000000000000")
+
+	ruleSet := numbers.BigInt().WithMin(min).Any()
+
+	testhelpers.MustNotApply(t, ruleSet, "99999999999999999999", errors.CodeMin)
+	testhelpers.MustApplyFunc(t, ruleSet, "100000000000000000000", min, checkBigIntEqual)
+	testhelpers.MustApplyFunc(t, ruleSet, "100000000000000000001", mustParseBigInt(t, "100000000000000000001"), checkBigIntEqual)
+}
+
+// Requirements:
+// - Only one minimum can exist on a rule set.
+// - Most recent minimum is used.
+// - Changing the argument after the call does not change the rule.
+func TestBigIntRuleSet_WithMinConflict(t *testing.T) {
+	min := big.NewInt(10)
+
+	ruleSet := numbers.BigInt().WithMin(min).WithMax(big.NewInt(20))
+	min.SetInt64(0)
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), 5, errors.CodeMin)
+
+	ruleSet2 := ruleSet.WithMin(big.NewInt(5))
+	testhelpers.MustApplyFunc(t, ruleSet2.Any(), 5, big.NewInt(5), checkBigIntEqual)
+	testhelpers.MustNotApply(t, ruleSet.Any(), 5, errors.CodeMin)
+
+	expected := "BigIntRuleSet.WithMax(20).WithMin(5)"
+	if s := ruleSet2.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
@@ -2,34 +2,149 @@ package rules
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"proto.zip/studio/validate/internal/util"
 	"proto.zip/studio/validate/pkg/errors"
 	"proto.zip/studio/validate/pkg/rulecontext"
 )
 
 const annotation = "validate"
 
+// rulesAnnotation is the struct tag that names registered rule sets for a field.
+const rulesAnnotation = "rules"
+
+// structMapping stores the input key, output field name and registered rule set names for a single struct field.
+type structMapping struct {
+	key    string
+	field  string
+	rules  []string
+	tagged bool
+}
+
+// structMappingCache caches the parsed struct mappings for each struct type.
+// Parsing the mappings requires walking every field and tag so it is only done once per type.
+var structMappingCache sync.Map
+
+// structMappingParses counts the number of times struct mappings were parsed rather than loaded from the cache.
+var structMappingParses atomic.Int64
+
+// structMappings returns the key to field mappings for a struct type in field order.
+// The results are cached and must not be modified.
+func structMappings(outputType reflect.Type) []structMapping {
+	if cached, ok := structMappingCache.Load(outputType); ok {
+		return cached.([]structMapping)
+	}
+
+	structMappingParses.Add(1)
+	mappings := make([]structMapping, 0, outputType.NumField())
+	mapped := make(map[string]bool)
+
+	for i := 0; i < outputType.NumField(); i++ {
+		field := outputType.Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		tagValue, ok := field.Tag.Lookup(annotation)
+
+		// Ignore empty tags if they exist
+		if ok && tagValue == "" {
+			continue
+		}
+
+		var key string
+		if tagValue == "" {
+			key = field.Name
+
+			// Don't allow the property names name to override the tagged mapping
+			_, ok := mapped[key]
+			if ok {
+				continue
+			}
+		} else {
+			key = tagValue
+		}
+
+		mappings = append(mappings, structMapping{
+			key:    key,
+			field:  field.Name,
+			rules:  parseRuleNames(field.Tag.Get(rulesAnnotation)),
+			tagged: tagValue != "",
+		})
+
+		mapped[key] = true
+	}
+
+	actual, _ := structMappingCache.LoadOrStore(outputType, mappings)
+	return actual.([]structMapping)
+}
+
+// parseRuleNames splits a "rules" annotation into the names of registered rule sets.
+func parseRuleNames(tagValue string) []string {
+	if tagValue == "" {
+		return nil
+	}
+
+	var names []string
+	for _, name := range strings.Split(tagValue, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
 // Implementation of RuleSet for objects and maps.
 type ObjectRuleSet[T any, TK comparable, TV any] struct {
 	NoConflict[T]
 	allowUnknown bool
+	dropUnknown  bool
 	key          Rule[TK]
 	rule         RuleSet[TV]
 	objRule      Rule[T]
+	phase        int
 	mapping      TK
 	outputType   reflect.Type
 	ptr          bool
 	required     bool
+	withNil      bool
 	parent       *ObjectRuleSet[T, TK, TV]
 	label        string
 	condition    Conditional[T, TK]
 	refs         *refTracker[TK]
 	bucket       TK
 	json         bool
+	jsonOptions  JsonOptions
+	yaml         bool
+	query        bool
+	atLeastOne   []TK
+	concurrency  int
+	sequential   bool
+	partial      bool
+	explicitNull bool
+	failFast     bool
+	sortErrors   bool
+	collapsed    bool
+	suggestKeys  bool
+	maxDepth     int
+	minLen       *int
+	maxLen       *int
+	timeout      time.Duration
+	keyFunc      func(ctx context.Context, obj T, key TK) bool
+	values       bool
+	requiredIf   bool
+	readOnly     bool
+	readOnlyMode ReadOnlyMode
+	tagRules     bool
+	tagRule      bool
+	computed     func(ctx context.Context, obj T) (TV, error)
 }
 
 // Struct returns a RuleSet that can be used to validate an struct of an
@@ -38,6 +153,25 @@ type ObjectRuleSet[T any, TK comparable, TV any] struct {
 // Using the "validate" annotation you can may input values to different
 // properties of the object. This is useful for converting unstructured maps
 // created from Json and converting to an object.
+//
+// The "validate" annotation is only a key mapping and does not add any validation. Fields may also have a "rules"
+// annotation with the names of rule sets added with Register, separated by commas. Each named rule set is added for
+// the key as if WithKey had been called:
+//
+//	type User struct {
+//		Email string `validate:"email" rules:"email"`
+//		Name  string `rules:"name"`
+//	}
+//
+// Since rule sets from annotations are added when Struct is called, any rule sets added later with WithKey for the
+// same key are evaluated in addition to them rather than replacing them.
+//
+// WithTagRules also adds registered rule sets, but it reads the names from the "validate" annotation instead. It is
+// meant for existing structs whose keys already match registered names, so keys that are not registered are only
+// used as mappings and the rule sets are replaced by a later WithKey. Prefer the "rules" annotation for new structs
+// since a misspelled name is caught when Struct is called. Both can be used on the same struct.
+//
+// This function panics if the "rules" annotation names a rule set that has not been registered.
 func Struct[T any]() *ObjectRuleSet[T, string, any] {
 	var empty [0]T
 
@@ -60,45 +194,27 @@ func Struct[T any]() *ObjectRuleSet[T, string, any] {
 		panic(fmt.Errorf("invalid output type for object rule set: %v", kind))
 	}
 
-	mapped := make(map[string]bool)
-
-	for i := 0; i < ruleSet.outputType.NumField(); i++ {
-		field := ruleSet.outputType.Field(i)
-
-		if !field.IsExported() {
-			continue
-		}
-
-		tagValue, ok := field.Tag.Lookup(annotation)
-		emptyTag := tagValue == ""
-
-		// Ignore empty tags if they exist
-		if ok && emptyTag {
-			continue
-		}
-
-		var key string
-		if emptyTag {
-			key = field.Name
-
-			// Don't allow the property names name to override the tagged mapping
-			_, ok := mapped[key]
-			if ok {
-				continue
-			}
-		} else {
-			key = tagValue
-		}
+	mappings := structMappings(ruleSet.outputType)
 
+	for _, m := range mappings {
 		ruleSet = &ObjectRuleSet[T, string, any]{
 			parent:     ruleSet,
-			key:        Constant[string](key),
-			mapping:    field.Name,
+			key:        Constant[string](m.key),
+			mapping:    m.field,
 			outputType: ruleSet.outputType,
 			ptr:        ruleSet.ptr,
 		}
+	}
 
-		mapped[key] = true
+	// Registered rule sets are added once all the mappings exist
+	for _, m := range mappings {
+		for _, name := range m.rules {
+			registered, ok := Registered(name)
+			if !ok {
+				panic(fmt.Errorf("no rule set registered for name %q on field: %s", name, m.field))
+			}
+			ruleSet = ruleSet.WithKey(m.key, registered)
+		}
 	}
 
 	return ruleSet
@@ -128,31 +244,61 @@ func Map[TK comparable, TV any]() *ObjectRuleSet[map[TK]TV, TK, TV] {
 func (v *ObjectRuleSet[T, TK, TV]) withParent() *ObjectRuleSet[T, TK, TV] {
 	return &ObjectRuleSet[T, TK, TV]{
 		allowUnknown: v.allowUnknown,
+		dropUnknown:  v.dropUnknown,
 		required:     v.required,
+		withNil:      v.withNil,
 		outputType:   v.outputType,
 		ptr:          v.ptr,
 		parent:       v,
 		refs:         v.refs,
 		json:         v.json,
+		jsonOptions:  v.jsonOptions,
+		yaml:         v.yaml,
+		query:        v.query,
+		concurrency:  v.concurrency,
+		sequential:   v.sequential,
+		partial:      v.partial,
+		explicitNull: v.explicitNull,
+		failFast:     v.failFast,
+		sortErrors:   v.sortErrors,
+		collapsed:    v.collapsed,
+		suggestKeys:  v.suggestKeys,
+		maxDepth:     v.maxDepth,
+		minLen:       v.minLen,
+		maxLen:       v.maxLen,
+		timeout:      v.timeout,
+		tagRules:     v.tagRules,
 	}
 }
 
-// WithUnknown returns a new RuleSet with the "unknown" flag set.
+// WithCollapsedUnknownErrors returns a new RuleSet that returns a single errors.CodeUnexpected error for all the
+// unknown keys instead of one error per key. The error is at the path of the object and the paths of the unknown
+// keys are listed in order in the "keys" metadata, which can be read with errors.MetaOf.
 //
-// By default if the validator fines an unknown key on a map it will return an error.
-// Setting the unknown flag will allow keys that aren't defined to be present in the map.
-// This is useful for parsing arbitrary Json where additional keys may be included.
-func (v *ObjectRuleSet[T, TK, TV]) WithUnknown() *ObjectRuleSet[T, TK, TV] {
-	if v.allowUnknown {
+// This is useful when the errors are shown to the user as a single message. It has no effect if unknown keys are
+// allowed. Errors for read only keys are not collapsed.
+func (v *ObjectRuleSet[T, TK, TV]) WithCollapsedUnknownErrors() *ObjectRuleSet[T, TK, TV] {
+	if v.collapsed {
 		return v
 	}
 
 	newRuleSet := v.withParent()
-	newRuleSet.allowUnknown = true
-	newRuleSet.label = "WithUnknown()"
+	newRuleSet.collapsed = true
+	newRuleSet.label = "WithCollapsedUnknownErrors()"
 	return newRuleSet
 }
 
+// WithUnknown returns a new RuleSet with the "unknown" flag set.
+//
+// By default if the validator fines an unknown key on a map it will return an error.
+// Setting the unknown flag will allow keys that aren't defined to be present in the map.
+// This is useful for parsing arbitrary Json where additional keys may be included.
+//
+// This is the same as WithUnknownMode(UnknownKeep).
+func (v *ObjectRuleSet[T, TK, TV]) WithUnknown() *ObjectRuleSet[T, TK, TV] {
+	return v.withUnknownMode(UnknownKeep, "WithUnknown()")
+}
+
 // fullMapping is a helper function that returns the full object field mappings as a map.
 func (v *ObjectRuleSet[T, TK, TV]) fullMapping() map[TK]TK {
 	mapping := make(map[TK]TK)
@@ -160,7 +306,11 @@ func (v *ObjectRuleSet[T, TK, TV]) fullMapping() map[TK]TK {
 
 	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
 		if currentRuleSet.key != nil && currentRuleSet.mapping != *empty {
-			mapping[currentRuleSet.key.(*ConstantRuleSet[TK]).Value()] = currentRuleSet.mapping
+			// Newer mappings take precedence which matches mappingFor
+			key := currentRuleSet.key.(*ConstantRuleSet[TK]).Value()
+			if _, ok := mapping[key]; !ok {
+				mapping[key] = currentRuleSet.mapping
+			}
 		}
 	}
 	return mapping
@@ -178,6 +328,38 @@ func (v *ObjectRuleSet[T, TK, TV]) mappingFor(ctx context.Context, key TK) (TK,
 	return empty, false
 }
 
+// WithRenameKey returns a new RuleSet that maps an input key to a struct field.
+//
+// This is equivalent to using the "validate" annotation and is useful for structs that you cannot modify. Existing
+// mappings, including those from annotations, continue to work. If the input key is already mapped, the new mapping
+// takes precedence.
+//
+// Since WithKey looks up the field when it is called, WithRenameKey must be called before any WithKey calls that
+// use the input key.
+//
+// This method panics if the rule set is not for a struct or if the field does not exist or is not exported.
+func (v *ObjectRuleSet[T, TK, TV]) WithRenameKey(inputKey TK, fieldName string) *ObjectRuleSet[T, TK, TV] {
+	if v.outputType.Kind() == reflect.Map {
+		panic(fmt.Errorf("keys can only be renamed for structs: %s", toPath(inputKey)))
+	}
+
+	field, ok := v.outputType.FieldByName(fieldName)
+	if !ok {
+		panic(fmt.Errorf("missing destination mapping for field: %s", fieldName))
+	}
+	if !field.IsExported() {
+		panic(fmt.Errorf("field is not exported: %s", fieldName))
+	}
+
+	newRuleSet := v.withParent()
+	newRuleSet.key = Constant[TK](inputKey)
+
+	// Struct targets always have string as the key
+	newRuleSet.mapping = any(fieldName).(TK)
+
+	return newRuleSet
+}
+
 // WithKey returns a new RuleSet with a validation rule for the specified key.
 //
 // If more than one call is made with the same key than all will be evaluated. However, the order
@@ -215,6 +397,93 @@ func (v *ObjectRuleSet[T, TK, TV]) WithDynamicKey(keyRule Rule[TK], ruleSet Rule
 	)
 }
 
+// WithConditionalDynamicKey behaves like WithDynamicKey except the rule set is only evaluated if the condition is met.
+//
+// If the only dynamic rules for a key are conditional, the key will be considered unknown if no conditions match.
+//
+// This method will panic immediately if a circular dependency is detected.
+func (v *ObjectRuleSet[T, TK, TV]) WithConditionalDynamicKey(keyRule Rule[TK], condition Conditional[T, TK], ruleSet RuleSet[TV]) *ObjectRuleSet[T, TK, TV] {
+	var empty TK
+
+	return v.withKeyHelper(
+		keyRule,
+		empty,
+		condition,
+		ruleSet,
+	)
+}
+
+// WithKeyFunc returns a new RuleSet with a validation rule for any key where the function returns true.
+//
+// Unlike WithDynamicKey, the function is passed the object so the set of matching keys can be computed at runtime
+// from other values. For example the allowed keys may be listed in a separate field of the input.
+//
+// To make sure the object is as complete as possible, key functions are called after all the other key rules,
+// including conditional keys, have been evaluated. The object passed to the function only contains the values
+// that have been set by those rules.
+//
+// Keys that are matched by a key function are no longer considered "unknown". Like dynamic keys, key functions
+// only apply when the input is a map.
+//
+// Key functions cannot be used as a dependency in a conditional since they are not evaluated until after all
+// conditions. A conditional that depends on a key that is only matched by a key function will not wait for it.
+func (v *ObjectRuleSet[T, TK, TV]) WithKeyFunc(fn func(ctx context.Context, obj T, key TK) bool, ruleSet RuleSet[TV]) *ObjectRuleSet[T, TK, TV] {
+	newRuleSet := v.withParent()
+
+	newRuleSet.keyFunc = fn
+	newRuleSet.rule = ruleSet
+	newRuleSet.label = fmt.Sprintf("WithKeyFunc(<func>, %s)", ruleSet)
+
+	return newRuleSet
+}
+
+// WithValueRuleSet returns a new RuleSet that validates the value of every key that does not have a rule set added
+// with WithKey or WithConditionalKey. This is useful for maps where every value has the same rules and is similar to
+// WithItemRuleSet on slices.
+//
+// Keys with an explicit rule set take precedence and are not passed to the value rule set. Dynamic keys and key
+// functions do not take precedence, so if a key matches one of them both rule sets are evaluated. Every key covered
+// by the value rule set is considered known and will not trigger an unknown key error.
+//
+// If this method is called more than once, only the most recent value rule set is used. Like dynamic keys, the value
+// rule set only applies when the input is a map. For structs you must set a dynamic key bucket using
+// WithDynamicBucket.
+func (v *ObjectRuleSet[T, TK, TV]) WithValueRuleSet(ruleSet RuleSet[TV]) *ObjectRuleSet[T, TK, TV] {
+	newRuleSet := v.withParent()
+
+	newRuleSet.values = true
+	newRuleSet.rule = ruleSet
+	newRuleSet.label = fmt.Sprintf("WithValueRuleSet(%s)", ruleSet)
+
+	return newRuleSet
+}
+
+// valueRuleSet returns the rule set that contains the most recent value rule set or nil if there is none.
+func (v *ObjectRuleSet[T, TK, TV]) valueRuleSet() *ObjectRuleSet[T, TK, TV] {
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if currentRuleSet.values {
+			return currentRuleSet
+		}
+	}
+	return nil
+}
+
+// valueKeys returns the keys of a map input that are not covered by an explicit key rule set.
+func (v *ObjectRuleSet[T, TK, TV]) valueKeys(inValue reflect.Value) []TK {
+	explicit := make(map[TK]bool)
+	for _, key := range v.Keys() {
+		explicit[key] = true
+	}
+
+	keys := make([]TK, 0)
+	for _, mapKeyValue := range v.mapKeys(inValue) {
+		if key, ok := mapKeyValue.Interface().(TK); ok && !explicit[key] {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
 // WithDynamicBucket tells the Rule Set to put matching keys into specific buckets. A bucket is expected to be a
 // map with the key type (string for structs targets or variable for map) and a value type that matches the expected
 // value.
@@ -284,6 +553,54 @@ func (v *ObjectRuleSet[T, TK, TV]) KeyRules() []Rule[TK] {
 	return keys
 }
 
+// Keys returns the constant keys that have rule sets associated with them in the order they were first added.
+// Dynamic keys and key functions are not included. Use KeyRules to get the dynamic key rules.
+//
+// This is useful for generating documentation or schemas.
+func (v *ObjectRuleSet[T, TK, TV]) Keys() []TK {
+	all := make([]TK, 0)
+
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if currentRuleSet.rule == nil {
+			continue
+		}
+		if c, ok := exactConstant(currentRuleSet.key); ok {
+			all = append(all, c.Value())
+		}
+	}
+
+	// Rule sets are stored newest first
+	keys := make([]TK, 0, len(all))
+	seen := make(map[TK]bool)
+	for i := len(all) - 1; i >= 0; i-- {
+		if !seen[all[i]] {
+			seen[all[i]] = true
+			keys = append(keys, all[i])
+		}
+	}
+
+	return keys
+}
+
+// KeyRequired returns true if any unconditional rule set for the key is required.
+//
+// Conditional keys are not included since they are only required if the condition is met. Read only keys are never
+// required. WithPartial is also not taken into account.
+func (v *ObjectRuleSet[T, TK, TV]) KeyRequired(key TK) bool {
+	if _, ok := v.readOnlyKeys()[key]; ok {
+		return false
+	}
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if currentRuleSet.rule == nil || currentRuleSet.condition != nil {
+			continue
+		}
+		if c, ok := exactConstant(currentRuleSet.key); ok && c.Value() == key && currentRuleSet.rule.Required() {
+			return true
+		}
+	}
+	return false
+}
+
 // WithConditionalKey returns a new Rule with a validation rule for the specified key.
 //
 // It takes as an argument a Rule that is used to evaluate the entire object or map. If it returns a nil error then
@@ -298,6 +615,12 @@ func (v *ObjectRuleSet[T, TK, TV]) KeyRules() []Rule[TK] {
 //
 // If one or more of the fields has an error then the conditional rule will not be run.
 //
+// The conditional may depend on dynamic keys, in which case it waits for every input key that matches the key rule.
+// This method panics if the key matches one of those key rules since it would have to wait on itself.
+//
+// Conditions only see values set by other key rule sets. If the conditional depends on a key that has no rule set,
+// usually because of a typo, Apply returns a CodeInternal error naming the missing key.
+//
 // WithRule and WithRuleFunc are both evaluated after any keys or conditional keys. Because of this, it is not possible to
 // have a conditional key that is dependent on data that is modified in those
 //
@@ -305,6 +628,24 @@ func (v *ObjectRuleSet[T, TK, TV]) KeyRules() []Rule[TK] {
 //
 // This method will panic immediately if a circular dependency is detected.
 func (v *ObjectRuleSet[T, TK, TV]) WithConditionalKey(key TK, condition Conditional[T, TK], ruleSet RuleSet[TV]) *ObjectRuleSet[T, TK, TV] {
+	destKey := v.destKeyFor(key)
+
+	// Explicit rule sets replace the ones added by WithTagRules
+	if v.tagRules {
+		v = v.withoutTagRule(key)
+	}
+
+	return v.withKeyHelper(
+		Constant[TK](key),
+		destKey,
+		condition,
+		ruleSet,
+	)
+}
+
+// destKeyFor returns the struct field that a key is mapped to or the zero value for maps.
+// It panics if the output is a struct and the key is not mapped to an exported field.
+func (v *ObjectRuleSet[T, TK, TV]) destKeyFor(key TK) TK {
 	var destKey TK
 
 	// Only check mapping if output type is a struct (not a map)
@@ -331,12 +672,7 @@ func (v *ObjectRuleSet[T, TK, TV]) WithConditionalKey(key TK, condition Conditio
 		}
 	}
 
-	return v.withKeyHelper(
-		Constant[TK](key),
-		destKey,
-		condition,
-		ruleSet,
-	)
+	return destKey
 }
 
 // withKeyHelper returns a new rule set with the appropriate keys, conditions, and mappings set.
@@ -388,6 +724,22 @@ func (v *ObjectRuleSet[T, TK, TV]) WithRequired() *ObjectRuleSet[T, TK, TV] {
 	return newRuleSet
 }
 
+// WithNil returns a new child rule set that accepts a nil input, such as a JSON null or a nil struct pointer. No keys
+// are evaluated, including required keys, and the output is left unchanged. Without WithNil a nil input returns an
+// errors.CodeType error.
+//
+// To accept null values for individual keys use WithExplicitNull instead.
+func (v *ObjectRuleSet[T, TK, TV]) WithNil() *ObjectRuleSet[T, TK, TV] {
+	if v.withNil {
+		return v
+	}
+
+	newRuleSet := v.withParent()
+	newRuleSet.withNil = true
+	newRuleSet.label = "WithNil()"
+	return newRuleSet
+}
+
 // contextErrorToValidation takes a context error and returns a validation error.
 func contextErrorToValidation(ctx context.Context) errors.ValidationError {
 	switch ctx.Err() {
@@ -416,11 +768,18 @@ func wait(ctx context.Context, wg *sync.WaitGroup, errorsCh chan errors.Validati
 	for {
 		select {
 		case err := <-errorsCh:
-			allErrors = append(allErrors, err...)
+			allErrors = appendErrors(allErrors, err)
 		case <-ctx.Done():
 			if listenForCancelled {
-				wg.Wait()
-				return append(allErrors, contextErrorToValidation(ctx))
+				// Keep receiving until every job exits so none of them are blocked sending errors.
+				for {
+					select {
+					case err := <-errorsCh:
+						allErrors = appendErrors(allErrors, err)
+					case <-done:
+						return append(allErrors, contextErrorToValidation(ctx))
+					}
+				}
 			}
 		case <-done:
 			return allErrors
@@ -438,16 +797,19 @@ func done(ctx context.Context) bool {
 	}
 }
 
-// evaluateKeyRule evaluates a single key rule.
+// evaluateKeyRule evaluates a single key rule and returns any errors.
+// The boolean return value is false if the rule was skipped because the condition was not met or the context
+// was canceled.
 // Note that this function is meant to be called on the rule set that contains the rule.
-func (ruleSet *ObjectRuleSet[T, TK, TV]) evaluateKeyRule(ctx context.Context, out *T, wg *sync.WaitGroup, outValueMutex *sync.Mutex, errorsCh chan errors.ValidationErrorCollection, key TK, inFieldValue reflect.Value, s setter[TK], counters *counterSet[TK], dynamicBuckets []*ObjectRuleSet[T, TK, TV]) {
-	defer wg.Done()
+// Since the partial and explicit null flags are only set on child rule sets they must be passed in from the rule set
+// being evaluated.
+func (ruleSet *ObjectRuleSet[T, TK, TV]) evaluateKeyRule(ctx context.Context, out *T, outValueMutex *sync.Mutex, key TK, inFieldValue reflect.Value, s setter[TK], counters *counterSet[TK], dynamicBuckets []*ObjectRuleSet[T, TK, TV], partial, explicitNull bool) (bool, errors.ValidationErrorCollection) {
 	counters.Lock(key)
 	defer counters.Unlock(key)
 
 	// Don't keep evaluating if the context has been canceled.
 	if done(ctx) {
-		return
+		return false, nil
 	}
 
 	// Exit early if the condition is not met.
@@ -462,24 +824,41 @@ func (ruleSet *ObjectRuleSet[T, TK, TV]) evaluateKeyRule(ctx context.Context, ou
 		}()
 
 		if !ok {
-			return
+			return false, nil
 		}
 	}
 
-	if inFieldValue.Kind() == reflect.Invalid {
-		if ruleSet.rule.Required() {
-			errorsCh <- errors.Collection(
+	// Computed keys only set a value if there is none in the input.
+	if ruleSet.computed != nil {
+		return true, ruleSet.evaluateComputedKey(ctx, out, outValueMutex, key, inFieldValue, s, explicitNull)
+	}
+
+	// Some rule sets treat values such as empty strings the same as missing keys.
+	if inFieldValue.Kind() == reflect.Invalid || isAbsent(ruleSet.rule, inFieldValue.Interface()) {
+		if ruleSet.rule.Required() && !partial {
+			return true, errors.Collection(
 				errors.Errorf(errors.CodeRequired, ctx, "field is required"),
 			)
 		}
-		return
+		return true, nil
 	}
 
+	// Explicit nulls skip the rule and the zero value is set instead.
+	null := explicitNull && util.IsNil(inFieldValue.Interface())
+	if null && ruleSet.rule.Required() {
+		return true, errors.Collection(
+			errors.Errorf(errors.CodeRequired, ctx, "field must not be null"),
+		)
+	}
+
+	// Warnings do not prevent the value from being set
 	var val TV
-	errs := ruleSet.rule.Apply(ctx, inFieldValue.Interface(), &val)
-	if errs != nil {
-		errorsCh <- errs
-		return
+	var errs errors.ValidationErrorCollection
+	if !null {
+		errs = ruleSet.rule.Apply(ctx, inFieldValue.Interface(), &val)
+		if errs.HasErrors() {
+			return true, errs
+		}
 	}
 
 	outValueMutex.Lock()
@@ -496,6 +875,68 @@ func (ruleSet *ObjectRuleSet[T, TK, TV]) evaluateKeyRule(ctx context.Context, ou
 	if !bucketMatched {
 		s.Set(key, val)
 	}
+	return true, errs
+}
+
+// keyMatcher is implemented by key rules that can check if a key matches without building errors.
+type keyMatcher[TK any] interface {
+	matchKey(ctx context.Context, key TK) bool
+}
+
+// matchKey returns true if the key matches the key rule.
+func matchKey[TK any](ctx context.Context, keyRule Rule[TK], key TK) bool {
+	if matcher, ok := keyRule.(keyMatcher[TK]); ok {
+		return matcher.matchKey(ctx, key)
+	}
+	return keyRule.Evaluate(ctx, key) == nil
+}
+
+// matchDynamicKeys returns the input keys that match each dynamic key rule set, in the order they should be evaluated.
+// Rule sets with no matching keys are not included.
+//
+// Each key is only evaluated once against each dynamic key rule set. This matters for inputs with many keys since
+// a key rule that does not match usually has to build an error. Key rules that implement keyMatcher avoid this.
+func (v *ObjectRuleSet[T, TK, TV]) matchDynamicKeys(ctx context.Context, inValue reflect.Value) map[*ObjectRuleSet[T, TK, TV]][]TK {
+	var keys []TK
+	var matches map[*ObjectRuleSet[T, TK, TV]][]TK
+
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if currentRuleSet.key == nil || currentRuleSet.rule == nil {
+			continue
+		}
+		if _, ok := exactConstant(currentRuleSet.key); ok {
+			continue
+		}
+
+		// The input keys are only converted if there is at least one dynamic key rule.
+		if keys == nil {
+			keys = make([]TK, 0, inValue.Len())
+			for _, mapKeyValue := range v.mapKeys(inValue) {
+				if key, ok := mapKeyValue.Interface().(TK); ok {
+					keys = append(keys, key)
+				}
+			}
+			matches = make(map[*ObjectRuleSet[T, TK, TV]][]TK)
+		}
+
+		for _, key := range keys {
+			if matchKey(ctx, currentRuleSet.key, key) {
+				matches[currentRuleSet] = append(matches[currentRuleSet], key)
+			}
+		}
+	}
+
+	return matches
+}
+
+// mapKeys returns the keys of a map input.
+// Keys are sorted when sequential evaluation is enabled so they are evaluated in a stable order.
+func (v *ObjectRuleSet[T, TK, TV]) mapKeys(inValue reflect.Value) []reflect.Value {
+	keys := inValue.MapKeys()
+	if v.sequential {
+		sortMapKeys(keys)
+	}
+	return keys
 }
 
 // keyValue is a helper function that returns the name of a key for use in mapping and conditions
@@ -514,32 +955,60 @@ func (v *ObjectRuleSet[T, TK, TV]) keyValue(key TK, currentRuleSet *ObjectRuleSe
 		inFieldValue = inValue.FieldByName(keyStr)
 	}
 
+	if v.query && currentRuleSet.rule != nil {
+		inFieldValue = queryValue(inFieldValue, currentRuleSet.rule)
+	}
+
 	return inFieldValue
 }
 
 // evaluateKeyRules evaluates the rules for each key and called evaluateKeyRule.
-func (v *ObjectRuleSet[T, TK, TV]) evaluateKeyRules(ctx context.Context, out *T, inValue reflect.Value, s setter[TK], fromMap, fromSame bool) errors.ValidationErrorCollection {
+// The stop function is called whenever a key returns errors.
+func (v *ObjectRuleSet[T, TK, TV]) evaluateKeyRules(ctx context.Context, out *T, inValue reflect.Value, s setter[TK], fromMap, fromSame bool, stop func()) errors.ValidationErrorCollection {
 	allErrors := errors.Collection()
 	var emptyKey TK
 
 	// Tracks which keys are known so we can create errors for unknown keys.
 	knownKeys := newKnownKeys[TK]((!v.allowUnknown || s.Map()) && fromMap)
+	knownKeys.sorted = v.sequential
+	knownKeys.collapsed = v.collapsed
+	if !v.allowUnknown {
+		knownKeys.candidates = v.suggestionCandidates()
+	}
+
+	// Dynamic keys only make sense if the source is a map.
+	// The input keys are matched once since both the counters and the jobs need the matches.
+	var dynamicMatches map[*ObjectRuleSet[T, TK, TV]][]TK
+	if fromMap {
+		dynamicMatches = v.matchDynamicKeys(ctx, inValue)
+	}
+
+	// Read only keys are never evaluated so they are removed before anything is counted.
+	readOnly := v.readOnlyKeys()
+	for ruleSet, keys := range dynamicMatches {
+		dynamicMatches[ruleSet] = withoutReadOnly(keys, readOnly)
+	}
+	if fromMap && readOnly != nil {
+		allErrors = appendErrors(allErrors, v.evaluateReadOnly(ctx, readOnly, inValue, knownKeys))
+	}
 
 	// Add each key to the counter.
 	// We need this because conditional keys cannot run until all rule sets are run since rule sets are able
 	// to mutate values.
 	// For dynamic keys we must increment for all matching keys.
+	//
+	// Rule sets that are evaluated inline have no conditions so nothing waits on the counters.
+	inline := v.inlineKeys()
 	counters := newCounterSet[TK]()
-	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
-		if currentRuleSet.key != nil && currentRuleSet.rule != nil {
-			if c, ok := currentRuleSet.key.(*ConstantRuleSet[TK]); ok {
-				counters.Increment(c.Value())
-			} else if fromMap {
-				// Dynamic keys only make sense if the source is a map.
-				for _, mapKeyValue := range inValue.MapKeys() {
-					key, ok := mapKeyValue.Interface().(TK)
-
-					if ok && currentRuleSet.key.Evaluate(ctx, key) == nil {
+	if !inline {
+		for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+			if currentRuleSet.key != nil && currentRuleSet.rule != nil {
+				if c, ok := exactConstant(currentRuleSet.key); ok {
+					if _, ok := readOnly[c.Value()]; !ok {
+						counters.Increment(c.Value())
+					}
+				} else {
+					for _, key := range dynamicMatches[currentRuleSet] {
 						counters.Increment(key)
 					}
 				}
@@ -547,9 +1016,16 @@ func (v *ObjectRuleSet[T, TK, TV]) evaluateKeyRules(ctx context.Context, out *T,
 		}
 	}
 
-	// Handle concurrency for the rule evaluation
-	errorsCh := make(chan errors.ValidationErrorCollection)
-	defer close(errorsCh)
+	// Keys without an explicit rule set are evaluated with the value rule set.
+	valueRuleSet := v.valueRuleSet()
+	var valueKeys []TK
+	if fromMap && valueRuleSet != nil {
+		valueKeys = withoutReadOnly(v.valueKeys(inValue), readOnly)
+		for _, key := range valueKeys {
+			counters.Increment(key)
+		}
+	}
+
 	var outValueMutex sync.Mutex
 
 	// Pre caching a list of dynamic buckets lets us avoid extra loops.
@@ -561,41 +1037,123 @@ func (v *ObjectRuleSet[T, TK, TV]) evaluateKeyRules(ctx context.Context, out *T,
 		}
 	}
 
-	// Wait for all the rules to finish
-	var wg sync.WaitGroup
-
-	// Loop through all the rule sets and evaluate the rules
+	// Collect all the rules to evaluate
+	jobs := make([]*keyJob[T, TK, TV], 0)
 	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
-		if currentRuleSet.rule == nil {
+		// Key functions are collected separately once the other keys are done.
+		if currentRuleSet.rule == nil || currentRuleSet.keyFunc != nil || currentRuleSet.values {
 			continue
 		}
 
-		if c, ok := currentRuleSet.key.(*ConstantRuleSet[TK]); ok {
+		if c, ok := exactConstant(currentRuleSet.key); ok {
 			key := c.Value()
+			if _, ok := readOnly[key]; ok {
+				continue
+			}
 			inFieldValue := v.keyValue(key, currentRuleSet, inValue, fromMap, fromSame)
-			knownKeys.Add(key)
+			if currentRuleSet.computed != nil {
+				// Computing a value does not make the input key known.
+				inFieldValue = computedInput(inFieldValue, fromMap)
+			} else {
+				knownKeys.Add(key)
+			}
 			subContext := rulecontext.WithPathString(ctx, toPath(key))
-			wg.Add(1)
-			go currentRuleSet.evaluateKeyRule(subContext, out, &wg, &outValueMutex, errorsCh, key, inFieldValue, s, counters, nil)
+			jobs = append(jobs, &keyJob[T, TK, TV]{
+				ctx:          subContext,
+				ruleSet:      currentRuleSet,
+				key:          key,
+				inFieldValue: inFieldValue,
+			})
 
-		} else if fromMap {
-			// Dynamic keys only make sense if the source is a map.
-			for _, mapKeyValue := range inValue.MapKeys() {
-				key, ok := mapKeyValue.Interface().(TK)
+		} else {
+			for _, key := range dynamicMatches[currentRuleSet] {
+				inFieldValue := v.keyValue(key, currentRuleSet, inValue, fromMap, fromSame)
+				subContext := rulecontext.WithPathString(ctx, toPath(key))
 
-				if ok && currentRuleSet.key.Evaluate(ctx, key) == nil {
-					inFieldValue := v.keyValue(key, currentRuleSet, inValue, fromMap, fromSame)
-					subContext := rulecontext.WithPathString(ctx, toPath(key))
+				// Conditional dynamic keys are only known if the condition is met.
+				if currentRuleSet.condition == nil {
 					knownKeys.Add(key)
-					wg.Add(1)
-					go currentRuleSet.evaluateKeyRule(subContext, out, &wg, &outValueMutex, errorsCh, key, inFieldValue, s, counters, dynamicBuckets)
 				}
+
+				jobs = append(jobs, &keyJob[T, TK, TV]{
+					ctx:            subContext,
+					ruleSet:        currentRuleSet,
+					key:            key,
+					inFieldValue:   inFieldValue,
+					dynamicBuckets: dynamicBuckets,
+					dynamic:        true,
+				})
 			}
 		}
 	}
 
-	// Unknown fields are not concurrent for now so we need to wait for all rule evaluations to finish
-	ruleErrors := wait(ctx, &wg, errorsCh, true)
+	for _, key := range valueKeys {
+		knownKeys.Add(key)
+		jobs = append(jobs, &keyJob[T, TK, TV]{
+			ctx:            rulecontext.WithPathString(ctx, toPath(key)),
+			ruleSet:        valueRuleSet,
+			key:            key,
+			inFieldValue:   v.keyValue(key, valueRuleSet, inValue, fromMap, fromSame),
+			dynamicBuckets: dynamicBuckets,
+			dynamic:        true,
+		})
+	}
+
+	var knownKeysMutex sync.Mutex
+	evaluate := func(job *keyJob[T, TK, TV]) errors.ValidationErrorCollection {
+		met, errs := job.ruleSet.evaluateKeyRule(job.ctx, out, &outValueMutex, job.key, job.inFieldValue, s, counters, job.dynamicBuckets, v.partial, v.explicitNull)
+
+		if met && job.dynamic && job.ruleSet.condition != nil {
+			knownKeysMutex.Lock()
+			knownKeys.Add(job.key)
+			knownKeysMutex.Unlock()
+		}
+
+		if errs.HasErrors() {
+			stop()
+		}
+
+		return errs
+	}
+
+	runJobs := func(jobs []*keyJob[T, TK, TV]) errors.ValidationErrorCollection {
+		if inline {
+			return evaluateKeyJobsInline(ctx, jobs, evaluate)
+		}
+		if v.sequential {
+			return evaluateKeyJobsSequential(ctx, jobs, evaluate)
+		}
+
+		// Handle concurrency for the rule evaluation
+		errorsCh := make(chan errors.ValidationErrorCollection)
+		defer close(errorsCh)
+
+		var wg sync.WaitGroup
+		wg.Add(len(jobs))
+
+		runKeyJobs(jobs, v.concurrency, func(job *keyJob[T, TK, TV]) {
+			defer wg.Done()
+			if errs := evaluate(job); errs != nil {
+				errorsCh <- errs
+			}
+		})
+
+		// Unknown fields are not concurrent for now so we need to wait for all rule evaluations to finish
+		return wait(ctx, &wg, errorsCh, true)
+	}
+
+	ruleErrors := runJobs(jobs)
+
+	// Key functions are passed the object so they run once everything else is done.
+	if fromMap && !done(ctx) {
+		funcJobs := v.keyFuncJobs(ctx, out, inValue, readOnly, counters, dynamicBuckets)
+		for _, job := range funcJobs {
+			knownKeys.Add(job.key)
+		}
+		if len(funcJobs) > 0 {
+			ruleErrors = appendErrors(ruleErrors, runJobs(funcJobs))
+		}
+	}
 
 	// Throw all applicable unknown keys into dynamic buckets.
 	// Keys in dynamic buckets should not trigger an unknown key error.
@@ -617,49 +1175,164 @@ func (v *ObjectRuleSet[T, TK, TV]) evaluateKeyRules(ctx context.Context, out *T,
 	if !v.allowUnknown {
 		// If allowUnknown is not set we want to error for each unknown value
 		knownKeyErrors := knownKeys.Check(ctx, inValue)
-		allErrors = append(allErrors, knownKeyErrors...)
-	} else if fromMap && s.Map() {
+		allErrors = appendErrors(allErrors, knownKeyErrors)
+	} else if fromMap && s.Map() && !v.dropUnknown {
 		// If allowUnknown is set and the output is a map we want to assign each key to the map output.
 		for _, key := range knownKeys.Unknown(inValue) {
 			s.Set(key, inValue.MapIndex(reflect.ValueOf(key)).Interface())
 		}
 	}
 
-	return append(allErrors, ruleErrors...)
+	return appendErrors(allErrors, ruleErrors)
 }
 
-// evaluateObjectRules evaluates the object
-func (v *ObjectRuleSet[T, TK, TV]) evaluateObjectRules(ctx context.Context, out *T) errors.ValidationErrorCollection {
+// inlineKeyLimit is the largest number of key rule sets that are evaluated inline on the calling goroutine.
+// Above this the cost of starting a goroutine per key is small compared to the work so keys are evaluated
+// concurrently. It is a variable so the benchmarks can compare both paths.
+var inlineKeyLimit = 16
+
+// inlineKeys returns true if the key rule sets can be evaluated inline without the counters, channels and
+// goroutines that are needed for conditional and dynamic keys.
+//
+// This is only the case if every key rule set has a constant key and there are no conditions, dynamic keys, key
+// functions, value rule sets or buckets. Since no key depends on another, evaluating the keys one at a time in
+// declaration order returns the same result.
+func (v *ObjectRuleSet[T, TK, TV]) inlineKeys() bool {
+	var emptyKey TK
+	count := 0
+
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if currentRuleSet.condition != nil || currentRuleSet.keyFunc != nil || currentRuleSet.values || currentRuleSet.bucket != emptyKey {
+			return false
+		}
+		if currentRuleSet.rule == nil {
+			continue
+		}
+		if _, ok := exactConstant(currentRuleSet.key); !ok {
+			return false
+		}
+		count++
+		if count > inlineKeyLimit {
+			return false
+		}
+	}
+
+	return true
+}
+
+// keyFuncJobs returns a job for each input key that matches a key function.
+// It must only be called after all other key rules have finished since the functions are passed the object.
+func (v *ObjectRuleSet[T, TK, TV]) keyFuncJobs(ctx context.Context, out *T, inValue reflect.Value, readOnly map[TK]ReadOnlyMode, counters *counterSet[TK], dynamicBuckets []*ObjectRuleSet[T, TK, TV]) []*keyJob[T, TK, TV] {
+	jobs := make([]*keyJob[T, TK, TV], 0)
+
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if currentRuleSet.keyFunc == nil || currentRuleSet.rule == nil {
+			continue
+		}
+
+		for _, mapKeyValue := range v.mapKeys(inValue) {
+			key, ok := mapKeyValue.Interface().(TK)
+			if !ok {
+				continue
+			}
+			if _, ok := readOnly[key]; ok {
+				continue
+			}
+
+			subContext := rulecontext.WithPathString(ctx, toPath(key))
+			if !currentRuleSet.keyFunc(subContext, *out, key) {
+				continue
+			}
+
+			counters.Increment(key)
+			jobs = append(jobs, &keyJob[T, TK, TV]{
+				ctx:            subContext,
+				ruleSet:        currentRuleSet,
+				key:            key,
+				inFieldValue:   inValue.MapIndex(mapKeyValue),
+				dynamicBuckets: dynamicBuckets,
+				dynamic:        true,
+			})
+		}
+	}
+
+	return jobs
+}
+
+// evaluateObjectRules evaluates the object rules one phase at a time.
+// The stop function is called whenever a rule returns errors.
+func (v *ObjectRuleSet[T, TK, TV]) evaluateObjectRules(ctx context.Context, out *T, stop func()) errors.ValidationErrorCollection {
+	allErrors := errors.Collection()
+
+	for i, objRules := range v.objectRulePhases() {
+		// Once the context is done the remaining phases are skipped.
+		if i > 0 && done(ctx) {
+			break
+		}
+
+		if v.sequential {
+			allErrors = appendErrors(allErrors, evaluateObjectRulesSequential(ctx, objRules, out, stop))
+		} else {
+			allErrors = appendErrors(allErrors, evaluateObjectRulesConcurrent(ctx, objRules, out, stop))
+		}
+	}
+
+	return allErrors
+}
+
+// evaluateObjectRulesConcurrent evaluates the object rules for a single phase in parallel.
+func evaluateObjectRulesConcurrent[T any](ctx context.Context, objRules []Rule[T], out *T, stop func()) errors.ValidationErrorCollection {
 	var wg sync.WaitGroup
 	var outValueMutex sync.Mutex
 	errorsCh := make(chan errors.ValidationErrorCollection)
 	defer close(errorsCh)
 
-	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
-		if currentRuleSet.objRule != nil {
+	for _, objRule := range objRules {
+		if done(ctx) {
+			break
+		}
+
+		wg.Add(1)
+		go func(objRule Rule[T]) {
+			outValueMutex.Lock()
+			defer outValueMutex.Unlock()
+			defer wg.Done()
+
 			if done(ctx) {
-				break
+				return
 			}
 
-			wg.Add(1)
-			go func(objRule Rule[T]) {
-				outValueMutex.Lock()
-				defer outValueMutex.Unlock()
-				defer wg.Done()
-
-				if done(ctx) {
-					return
+			if err := objRule.Evaluate(ctx, *out); err != nil {
+				// Warnings do not stop the other rules
+				if err.HasErrors() {
+					stop()
 				}
+				errorsCh <- err
+			}
 
-				if err := objRule.Evaluate(ctx, *out); err != nil {
-					errorsCh <- err
-				}
+		}(objRule)
+	}
 
-			}(currentRuleSet.objRule)
+	return wait(ctx, &wg, errorsCh, !done(ctx))
+}
+
+// evaluateObjectRulesSequential evaluates the object rules for a single phase on the calling goroutine in order.
+func evaluateObjectRulesSequential[T any](ctx context.Context, objRules []Rule[T], out *T, stop func()) errors.ValidationErrorCollection {
+	allErrors := errors.Collection()
+	for _, objRule := range objRules {
+		if done(ctx) {
+			return append(allErrors, contextErrorToValidation(ctx))
+		}
+
+		if errs := objRule.Evaluate(ctx, *out); errs != nil {
+			if errs.HasErrors() {
+				stop()
+			}
+			allErrors = appendErrors(allErrors, errs)
 		}
 	}
 
-	return wait(ctx, &wg, errorsCh, !done(ctx))
+	return allErrors
 }
 
 // newSetter creates a new setter for the rule set
@@ -679,6 +1352,61 @@ func (ruleSet *ObjectRuleSet[T, TK, TV]) newSetter(outValue reflect.Value) sette
 // Apply performs a validation of a RuleSet against a value and assigns the result to the output parameter.
 // It returns a ValidationErrorCollection if any validation errors occur.
 func (v *ObjectRuleSet[T, TK, TV]) Apply(ctx context.Context, value any, output any) errors.ValidationErrorCollection {
+	return v.apply(ctx, value, output, nil)
+}
+
+// ApplyWithPresentKeys behaves like Apply and also sets present to the keys that were supplied by the input.
+// This is useful for PATCH style updates where only the fields the client sent should be changed, since a zero value
+// in the output can't tell a missing key apart from one that was sent with a zero value.
+//
+// For map inputs every key is included, including keys with an explicit null value. For struct inputs every key with
+// a rule set is included since all the fields are always present. Values that a key rule set treats as missing, such
+// as empty strings with StringRuleSet.WithEmptyAsNil, are not included.
+//
+// Only the keys of this object are included and not the keys of nested objects. The map is set even if validation
+// errors are returned, unless the input could not be read as an object at all.
+//
+// This method panics if present is nil.
+func (v *ObjectRuleSet[T, TK, TV]) ApplyWithPresentKeys(ctx context.Context, value any, output any, present *map[TK]bool) errors.ValidationErrorCollection {
+	if present == nil {
+		panic(fmt.Errorf("present keys must be a non-nil pointer"))
+	}
+	return v.apply(ctx, value, output, present)
+}
+
+// presentKeys returns the keys that were supplied by the input.
+func (v *ObjectRuleSet[T, TK, TV]) presentKeys(ctx context.Context, inValue reflect.Value, fromMap, fromSame bool) map[TK]bool {
+	present := make(map[TK]bool)
+
+	var keys []TK
+	if fromMap {
+		for _, mapKeyValue := range inValue.MapKeys() {
+			if key, ok := mapKeyValue.Interface().(TK); ok {
+				keys = append(keys, key)
+			}
+		}
+	} else {
+		keys = v.Keys()
+	}
+
+	for _, key := range keys {
+		if !v.keyAbsent(ctx, key, inValue, fromMap, fromSame) {
+			present[key] = true
+		}
+	}
+	return present
+}
+
+// apply implements Apply and ApplyWithPresentKeys. If present is not nil it is set to the keys supplied by the input.
+func (v *ObjectRuleSet[T, TK, TV]) apply(ctx context.Context, value any, output any, present *map[TK]bool) errors.ValidationErrorCollection {
+	// Nil is only allowed with WithNil
+	if util.IsNil(value) {
+		if v.withNil {
+			return nil
+		}
+		return errors.Collection(errors.NewCoercionError(ctx, "object or map", "nil"))
+	}
+
 	// Ensure output is a non-nil pointer
 	rv := reflect.ValueOf(output)
 	if rv.Kind() != reflect.Ptr || rv.IsNil() {
@@ -687,6 +1415,19 @@ func (v *ObjectRuleSet[T, TK, TV]) Apply(ctx context.Context, value any, output
 		))
 	}
 
+	// Stop descending if the input is nested too deeply.
+	ctx, depthErr := enterDepth(ctx, v.maxDepth)
+	if depthErr != nil {
+		return errors.Collection(depthErr)
+	}
+
+	// The caller deadline is kept if it is sooner.
+	if v.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, v.timeout)
+		defer cancel()
+	}
+
 	// If this is true we need to assign the output at the end of the Apply since we can't assign it directly initially.
 	assignLater := false
 
@@ -780,24 +1521,29 @@ func (v *ObjectRuleSet[T, TK, TV]) Apply(ctx context.Context, value any, output
 	// Convert strings to JSON if necessary
 	if v.json {
 		var result map[string]interface{}
-		coerced := false
-		attempted := false
 
-		if inKind == reflect.String {
-			attempted = true
-			if err := json.Unmarshal([]byte(inValue.String()), &result); err == nil {
-				coerced = true
-			}
-		} else if inKind == reflect.Slice && inValue.Type().Elem().Kind() == reflect.Uint8 {
-			attempted = true
-			if err := json.Unmarshal(inValue.Bytes(), &result); err == nil {
-				coerced = true
-			}
+		// Input that is not Json may still be Yaml
+		attempted, err := decodeJsonInput(inValue, &result, v.jsonOptions)
+		if err != nil && !v.yaml {
+			return errors.Collection(
+				errors.NewCoercionError(ctx, "object, map, or JSON string", inKind.String()),
+			)
 		}
 
-		if !coerced && attempted {
+		if attempted && err == nil {
+			inValue = reflect.ValueOf(result)
+			inKind = inValue.Kind()
+		}
+	}
+
+	// Convert strings from Yaml if necessary
+	if v.yaml {
+		var result map[string]any
+
+		attempted, err := decodeYamlInput(inValue, &result)
+		if err != nil {
 			return errors.Collection(
-				errors.NewCoercionError(ctx, "object, map, or JSON string", inKind.String()),
+				errors.NewCoercionError(ctx, "object, map, or YAML string", inKind.String()),
 			)
 		}
 
@@ -816,17 +1562,77 @@ func (v *ObjectRuleSet[T, TK, TV]) Apply(ctx context.Context, value any, output
 		)
 	}
 
+	if present != nil {
+		*present = v.presentKeys(ctx, inValue, fromMap, fromSame)
+	}
+
 	allErrors := errors.Collection()
 
+	// Count the entries first so large inputs are rejected before any work is done.
+	if fromMap {
+		lenErrs, tooMany := v.evaluateLen(ctx, inValue.Len())
+		if tooMany {
+			return lenErrs
+		}
+		allErrors = appendErrors(allErrors, lenErrs)
+	}
+
+	// A condition that depends on a key without a rule set would never see a value.
+	if err := v.checkDependencies(ctx); err != nil {
+		return errors.Collection(err)
+	}
+
+	// In fail fast mode the remaining rules are cancelled as soon as one of them fails.
+	// Nested rule sets inherit the mode through the context.
+	failFast := v.failFast || rulecontext.FailFast(ctx)
+	stop := func() {}
+	if failFast {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(rulecontext.WithFailFast(ctx))
+		defer cancel()
+		stop = cancel
+	}
+
 	// Evaluate key rules
-	keyErrs := v.evaluateKeyRules(ctx, out, inValue, s, fromMap, fromSame)
-	allErrors = append(allErrors, keyErrs...)
+	keyErrs := v.evaluateKeyRules(ctx, out, inValue, s, fromMap, fromSame, stop)
+	allErrors = appendErrors(allErrors, keyErrs)
 
-	// Evaluate object rules
-	valErrs := v.evaluateObjectRules(ctx, out)
-	allErrors = append(allErrors, valErrs...)
+	if failFast && allErrors.HasErrors() {
+		return allErrors.Errors()[:1]
+	}
 
-	if len(allErrors) > 0 {
+	// Evaluate conditional requirements
+	requiredErrs := v.evaluateRequiredIf(ctx, out, inValue, fromMap, fromSame)
+	allErrors = appendErrors(allErrors, requiredErrs)
+
+	if failFast && allErrors.HasErrors() {
+		return allErrors.Errors()[:1]
+	}
+
+	// Evaluate key group rules
+	groupErrs := v.evaluateKeyGroups(ctx, inValue, fromMap, fromSame)
+	allErrors = appendErrors(allErrors, groupErrs)
+
+	if failFast && allErrors.HasErrors() {
+		return allErrors.Errors()[:1]
+	}
+
+	// Evaluate object rules. Field comparisons need to know which keys were supplied by the input.
+	objCtx := withPresentKeys(ctx, func() map[TK]bool {
+		return v.presentKeys(ctx, inValue, fromMap, fromSame)
+	})
+	valErrs := v.evaluateObjectRules(objCtx, out, stop)
+	allErrors = appendErrors(allErrors, valErrs)
+
+	if failFast && allErrors.HasErrors() {
+		return allErrors.Errors()[:1]
+	}
+
+	if v.sortErrors {
+		allErrors = allErrors.Sorted()
+	}
+
+	if allErrors.HasErrors() {
 		return allErrors
 	}
 
@@ -834,6 +1640,10 @@ func (v *ObjectRuleSet[T, TK, TV]) Apply(ctx context.Context, value any, output
 		elem.Set(reflect.ValueOf(out).Elem())
 	}
 
+	// Only warnings remain at this point
+	if len(allErrors) > 0 {
+		return allErrors
+	}
 	return nil
 }
 
@@ -848,6 +1658,9 @@ func (ruleSet *ObjectRuleSet[T, TK, TV]) Evaluate(ctx context.Context, value T)
 }
 
 // WithJson allows the input to be a Json encoded string.
+//
+// Numbers are decoded as float64 and duplicate keys are allowed with the last value winning, the same as
+// json.Unmarshal. Use WithJsonOptions to change this.
 func (v *ObjectRuleSet[T, TK, TV]) WithJson() *ObjectRuleSet[T, TK, TV] {
 	if v.json {
 		return v
@@ -858,6 +1671,174 @@ func (v *ObjectRuleSet[T, TK, TV]) WithJson() *ObjectRuleSet[T, TK, TV] {
 	return newRuleSet
 }
 
+// WithConcurrencyLimit returns a new RuleSet that evaluates at most n keys at the same time.
+//
+// By default each key is evaluated in its own goroutine. For inputs with a large number of keys, such as maps
+// from untrusted sources, this can be used to bound the number of goroutines. A limit of 1 evaluates keys one at
+// a time which can be useful for debugging.
+//
+// Rule sets with a small number of keys that are all constant, with no conditional or dynamic keys, are always
+// evaluated on the calling goroutine since starting a goroutine per key costs more than the work it saves.
+//
+// Conditional keys are still evaluated after the keys they depend on.
+//
+// This method will panic if n is less than 1.
+func (v *ObjectRuleSet[T, TK, TV]) WithConcurrencyLimit(n int) *ObjectRuleSet[T, TK, TV] {
+	if n < 1 {
+		panic(fmt.Errorf("concurrency limit must be at least 1, got: %d", n))
+	}
+
+	newRuleSet := v.withParent()
+	newRuleSet.concurrency = n
+	newRuleSet.label = fmt.Sprintf("WithConcurrencyLimit(%d)", n)
+	return newRuleSet
+}
+
+// WithSequential returns a new RuleSet that evaluates all key and object rules on the calling goroutine.
+//
+// By default keys and object rules are evaluated concurrently. In sequential mode keys are evaluated in the order
+// they were declared, with dynamic keys sorted, and conditional keys are evaluated after the keys they depend on.
+// Object rules are evaluated by phase and then in the order they were added. The context is checked between each rule.
+//
+// Because of this the order of the returned errors is stable which can be useful for debugging and for custom
+// rules with side effects.
+func (v *ObjectRuleSet[T, TK, TV]) WithSequential() *ObjectRuleSet[T, TK, TV] {
+	if v.sequential {
+		return v
+	}
+
+	newRuleSet := v.withParent()
+	newRuleSet.sequential = true
+	newRuleSet.label = "WithSequential()"
+	return newRuleSet
+}
+
+// WithPartial returns a new RuleSet that does not return errors for missing required keys.
+//
+// This is useful for PATCH style updates where only the keys that are being changed are sent. The same rule set
+// can then be used for both full and partial updates. Keys that are present are still fully validated and unknown
+// keys are handled the same way.
+//
+// Only the keys of this rule set are affected. Nested object rule sets still require their keys unless they also
+// have WithPartial set.
+//
+// Values that the key rule set treats as missing, such as empty strings with StringRuleSet.WithEmptyAsNil, are
+// skipped in the same way.
+func (v *ObjectRuleSet[T, TK, TV]) WithPartial() *ObjectRuleSet[T, TK, TV] {
+	if v.partial {
+		return v
+	}
+
+	newRuleSet := v.withParent()
+	newRuleSet.partial = true
+	newRuleSet.label = "WithPartial()"
+	return newRuleSet
+}
+
+// WithExplicitNull returns a new RuleSet that distinguishes keys that are present with a null value from keys that
+// are missing. This is useful for PATCH style updates where sending null clears a value and omitting the key leaves
+// it unchanged.
+//
+// A key is null if the input value is a nil interface, such as a JSON null, or a nil pointer. Null keys are not
+// passed to the key rule set. Instead the zero value is assigned to the output:
+//
+//   - Map outputs have the key set to the zero value of the map value type, while missing keys are not set.
+//   - Struct outputs have the field set to its zero value, so pointer fields are set to nil, while fields for
+//     missing keys are left untouched.
+//
+// Required keys return a CodeRequired error if they are null, even when WithPartial is set since the key was
+// sent.
+//
+// Only the keys of this rule set are affected. Without this mode null values are passed to the key rule set.
+func (v *ObjectRuleSet[T, TK, TV]) WithExplicitNull() *ObjectRuleSet[T, TK, TV] {
+	if v.explicitNull {
+		return v
+	}
+
+	newRuleSet := v.withParent()
+	newRuleSet.explicitNull = true
+	newRuleSet.label = "WithExplicitNull()"
+	return newRuleSet
+}
+
+// WithFailFast returns a new RuleSet that stops evaluating at the first error.
+//
+// By default every key and rule is evaluated so that all the errors can be returned, which is useful for forms.
+// In fail fast mode the remaining keys and rules are cancelled through the context as soon as one of them fails
+// and the returned collection contains a single error. This trades error completeness for latency and is useful
+// for high throughput checks where only pass or fail is needed.
+//
+// Which error is returned is not deterministic unless WithSequential is also set.
+//
+// Nested rule sets inherit the mode through the context.
+func (v *ObjectRuleSet[T, TK, TV]) WithFailFast() *ObjectRuleSet[T, TK, TV] {
+	if v.failFast {
+		return v
+	}
+
+	newRuleSet := v.withParent()
+	newRuleSet.failFast = true
+	newRuleSet.label = "WithFailFast()"
+	return newRuleSet
+}
+
+// WithSortedErrors returns a new RuleSet that sorts the returned errors by path and then by code.
+//
+// Keys are evaluated concurrently so by default the order of the errors is not deterministic. Sorting is useful
+// for snapshot tests and for displaying errors in a list. Numeric path segments such as slice indexes are
+// compared by value so "/items/2" comes before "/items/10".
+func (v *ObjectRuleSet[T, TK, TV]) WithSortedErrors() *ObjectRuleSet[T, TK, TV] {
+	if v.sortErrors {
+		return v
+	}
+
+	newRuleSet := v.withParent()
+	newRuleSet.sortErrors = true
+	newRuleSet.label = "WithSortedErrors()"
+	return newRuleSet
+}
+
+// WithTimeout returns a new RuleSet that limits how long Apply may run, regardless of the context passed in by the
+// caller. This is useful when a rule calls an external service.
+//
+// The timeout is a ceiling, not a floor. Apply derives a child context with the deadline so if the caller's context
+// has a sooner deadline or is cancelled, that still ends validation first. When the deadline passes, the rules that
+// have not finished return a CodeTimeout error. Rules should check the context they are passed to stop early.
+//
+// The timer starts when Apply is called. Nested rule sets with their own timeout can shorten the time available to
+// them but can not extend it. Calling WithTimeout again replaces the timeout.
+//
+// This method will panic if d is not positive.
+func (v *ObjectRuleSet[T, TK, TV]) WithTimeout(d time.Duration) *ObjectRuleSet[T, TK, TV] {
+	if d <= 0 {
+		panic(fmt.Errorf("timeout must be positive, got: %s", d))
+	}
+
+	newRuleSet := v.withParent()
+	newRuleSet.timeout = d
+	newRuleSet.label = fmt.Sprintf("WithTimeout(%s)", d)
+	return newRuleSet
+}
+
+// WithMaxDepth returns a new RuleSet that limits how deeply nested the input may be.
+//
+// The depth is tracked on the context and increases by one for each nested object or slice, including this one.
+// When the limit is exceeded a CodeMax error is returned at the path of the value and validation does not
+// descend any further. This protects public APIs from deeply nested input. Nested rule sets may set a lower limit
+// but can not raise it.
+//
+// This method will panic if n is less than 1.
+func (v *ObjectRuleSet[T, TK, TV]) WithMaxDepth(n int) *ObjectRuleSet[T, TK, TV] {
+	if n < 1 {
+		panic(fmt.Errorf("max depth must be at least 1, got: %d", n))
+	}
+
+	newRuleSet := v.withParent()
+	newRuleSet.maxDepth = n
+	newRuleSet.label = fmt.Sprintf("WithMaxDepth(%d)", n)
+	return newRuleSet
+}
+
 // WithRule returns a new child rule set with a rule added to the list of
 // rules to evaluate. WithRule takes an implementation of the Rule interface
 // for the given object type.
@@ -886,10 +1867,10 @@ func (v *ObjectRuleSet[T, TK, TV]) Any() RuleSet[any] {
 
 // String returns a string representation of the rule set suitable for debugging.
 func (ruleSet *ObjectRuleSet[T, TK, TV]) String() string {
-	// Pass through mappings with no rules
+	// Pass through mappings with no rules and rule sets added by WithTagRules
 	empty := new(TK)
 
-	if ruleSet.mapping != *empty && ruleSet.rule == nil {
+	if (ruleSet.mapping != *empty && ruleSet.rule == nil) || ruleSet.tagRule {
 		return ruleSet.parent.String()
 	}
 
@@ -901,7 +1882,7 @@ func (ruleSet *ObjectRuleSet[T, TK, TV]) String() string {
 				label = fmt.Sprintf("WithConditionalKey(\"%s\", %s, %s)", toPath(ruleSet.key), ruleSet.condition, ruleSet.rule)
 			} else {
 				path := "<dynamic>"
-				if c, ok := ruleSet.key.(*ConstantRuleSet[TK]); ok {
+				if c, ok := exactConstant(ruleSet.key); ok {
 					path = toQuotedPath(c.Value())
 				}
 
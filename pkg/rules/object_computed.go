@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"proto.zip/studio/validate/internal/util"
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// computedDependencies is the condition for a computed key.
+// It is always met and is only used to wait for the keys the computed value depends on.
+type computedDependencies[T any, TK comparable] struct {
+	NoConflict[T]
+	keys []TK
+}
+
+// Evaluate always returns nil since computed keys are not conditional.
+func (c *computedDependencies[T, TK]) Evaluate(ctx context.Context, value T) errors.ValidationErrorCollection {
+	return nil
+}
+
+// KeyRules returns a constant key rule for each dependency.
+func (c *computedDependencies[T, TK]) KeyRules() []Rule[TK] {
+	rules := make([]Rule[TK], len(c.keys))
+	for i, key := range c.keys {
+		rules[i] = Constant[TK](key)
+	}
+	return rules
+}
+
+// String returns the quoted paths of the dependencies separated by commas.
+func (c *computedDependencies[T, TK]) String() string {
+	paths := make([]string, len(c.keys))
+	for i, key := range c.keys {
+		paths[i] = toQuotedPath(key)
+	}
+	return strings.Join(paths, ", ")
+}
+
+// WithComputedKey returns a new RuleSet that sets the value of a key by calling a function when the key is missing
+// from the input. This is useful for defaults that depend on other values, such as a display name built from a first
+// and last name.
+//
+// The function is passed the output object and is called once all the rule sets for the keys in dependsOn have been
+// evaluated. Keys that are missing or have an error are not set on the object so the function should check for them.
+// Keys that are not listed in dependsOn may not be set yet when the function is called. The object must not be
+// modified and, for structs, the returned value must be assignable to the field.
+//
+// If the function returns a ValidationError it is returned as is. Any other error is returned as an
+// errors.CodeUnknown error at the path of the key.
+//
+// A nil value in the input is treated the same as a missing key unless WithExplicitNull is used. Since struct inputs
+// always have every field, the value is computed if the field has the zero value.
+//
+// Computed keys do not validate the input. Use WithKey for the same key to validate values that are present. A key
+// that only has a computed value is still unknown if it is in the input.
+//
+// This method panics if the function is nil or a circular dependency is detected.
+func (v *ObjectRuleSet[T, TK, TV]) WithComputedKey(key TK, fn func(ctx context.Context, obj T) (TV, error), dependsOn ...TK) *ObjectRuleSet[T, TK, TV] {
+	if fn == nil {
+		panic(fmt.Errorf("computed function must not be nil for key: %s", toPath(key)))
+	}
+
+	destKey := v.destKeyFor(key)
+
+	var condition Conditional[T, TK]
+	dependencies := &computedDependencies[T, TK]{keys: dependsOn}
+	if len(dependsOn) > 0 {
+		condition = dependencies
+	}
+
+	newRuleSet := v.withKeyHelper(Constant[TK](key), destKey, condition, Interface[TV]())
+	newRuleSet.computed = fn
+
+	if len(dependsOn) > 0 {
+		newRuleSet.label = fmt.Sprintf("WithComputedKey(%s, <func>, %s)", toQuotedPath(key), dependencies)
+	} else {
+		newRuleSet.label = fmt.Sprintf("WithComputedKey(%s, <func>)", toQuotedPath(key))
+	}
+
+	return newRuleSet
+}
+
+// computedInput returns the input value for a computed key.
+// Struct fields are always present so zero values are returned as missing.
+func computedInput(inFieldValue reflect.Value, fromMap bool) reflect.Value {
+	if !fromMap && inFieldValue.IsValid() && inFieldValue.IsZero() {
+		return reflect.Value{}
+	}
+	return inFieldValue
+}
+
+// evaluateComputedKey calls the computed function and sets the result if the key is missing from the input.
+// It must only be called once the dependencies have been evaluated.
+func (ruleSet *ObjectRuleSet[T, TK, TV]) evaluateComputedKey(ctx context.Context, out *T, outValueMutex *sync.Mutex, key TK, inFieldValue reflect.Value, s setter[TK], explicitNull bool) errors.ValidationErrorCollection {
+	if inFieldValue.IsValid() && (explicitNull || !util.IsNil(inFieldValue.Interface())) {
+		return nil
+	}
+
+	// The lock is held while the function runs since maps share the output with other keys.
+	outValueMutex.Lock()
+	defer outValueMutex.Unlock()
+
+	val, err := ruleSet.computed(ctx, *out)
+	if err != nil {
+		if validationErr, ok := err.(errors.ValidationError); ok {
+			return errors.Collection(validationErr)
+		}
+		return errors.Collection(errors.Errorf(errors.CodeUnknown, ctx, "%s", err.Error()))
+	}
+
+	s.Set(key, val)
+	return nil
+}
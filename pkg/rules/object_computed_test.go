@@ -0,0 +1,172 @@
+package rules_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+type computedTest struct {
+	FirstName   string `validate:"firstName"`
+	LastName    string `validate:"lastName"`
+	DisplayName string `validate:"displayName"`
+}
+
+// displayName returns the first and last name separated by a space.
+func displayName(ctx context.Context, obj *computedTest) (any, error) {
+	return obj.FirstName + " " + obj.LastName, nil
+}
+
+// Requirements:
+// - The value is computed from the dependencies when the key is missing.
+// - The dependencies are evaluated before the function is called.
+// - A value in the input is kept.
+// - Serializes to WithComputedKey(...).
+func TestWithComputedKey(t *testing.T) {
+	ruleSet := rules.Struct[*computedTest]().
+		WithKey("firstName", rules.String().WithTransform(trimTransform).Any()).
+		WithKey("lastName", rules.String().WithTransform(trimTransform).Any()).
+		WithKey("displayName", rules.String().Any()).
+		WithComputedKey("displayName", displayName, "firstName", "lastName")
+
+	var out *computedTest
+	if err := ruleSet.Apply(context.Background(), map[string]any{"firstName": " Ada ", "lastName": "Lovelace "}, &out); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	} else if out.DisplayName != "Ada Lovelace" {
+		t.Errorf("Expected display name to be %q, got: %q", "Ada Lovelace", out.DisplayName)
+	}
+
+	out = nil
+	if err := ruleSet.Apply(context.Background(), map[string]any{"firstName": "Ada", "lastName": "Lovelace", "displayName": "Countess"}, &out); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	} else if out.DisplayName != "Countess" {
+		t.Errorf("Expected display name to be %q, got: %q", "Countess", out.DisplayName)
+	}
+
+	expected := `.WithComputedKey("displayName", <func>, "firstName", "lastName")`
+	if s := ruleSet.String(); !strings.HasSuffix(s, expected) {
+		t.Errorf("Expected rule set to end with %s, got %s", expected, s)
+	}
+}
+
+// trimTransform trims whitespace from string inputs.
+func trimTransform(ctx context.Context, value any) (any, error) {
+	if str, ok := value.(string); ok {
+		return strings.TrimSpace(str), nil
+	}
+	return value, nil
+}
+
+// Requirements:
+// - Works with maps.
+// - Explicit nulls are not computed when WithExplicitNull is used.
+// - Other keys can depend on a computed key.
+func TestWithComputedKey_Map(t *testing.T) {
+	ruleSet := rules.StringMap[any]().
+		WithKey("a", rules.Int().Any()).
+		WithKey("b", rules.Int().Any()).
+		WithComputedKey("b", func(ctx context.Context, obj map[string]any) (any, error) {
+			return obj["a"].(int) * 2, nil
+		}, "a").
+		WithConditionalKey("c", rules.StringMap[any]().WithUnknown().WithKey("b", rules.Int().WithMin(10).Any()), rules.Int().WithRequired().Any())
+
+	testhelpers.MustApplyFunc(t, ruleSet.Any(), map[string]any{"a": 2}, map[string]any{"a": 2, "b": 4}, checkDeepEqual)
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"a": 5}, errors.CodeRequired)
+	testhelpers.MustApplyFunc(t, ruleSet.Any(), map[string]any{"a": 5, "c": 1}, map[string]any{"a": 5, "b": 10, "c": 1}, checkDeepEqual)
+
+	testhelpers.MustApplyFunc(t, ruleSet.WithExplicitNull().Any(), map[string]any{"a": 2, "b": nil}, map[string]any{"a": 2, "b": nil}, checkDeepEqual)
+}
+
+// Requirements:
+// - Errors from the function are returned at the path of the key.
+// - Validation errors are returned as is.
+// - Dependencies with errors are not set on the object.
+func TestWithComputedKey_Error(t *testing.T) {
+	ruleSet := rules.StringMap[any]().
+		WithKey("a", rules.Int().WithMax(10).Any()).
+		WithComputedKey("b", func(ctx context.Context, obj map[string]any) (any, error) {
+			a, ok := obj["a"]
+			if !ok {
+				return 0, nil
+			}
+			if a.(int) == 0 {
+				return nil, errors.Errorf(errors.CodeRange, ctx, "a must not be zero")
+			}
+			return nil, fmt.Errorf("failed")
+		}, "a")
+
+	err := ruleSet.Apply(context.Background(), map[string]any{"a": 1}, new(map[string]any))
+	if len(err) != 1 {
+		t.Fatalf("Expected 1 error, got: %s", err)
+	}
+	if err[0].Code() != errors.CodeUnknown || err[0].Path() != "/b" {
+		t.Errorf("Expected an unknown error at /b, got: %s at %s", err[0].Code(), err[0].Path())
+	}
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"a": 0}, errors.CodeRange)
+
+	err = ruleSet.Apply(context.Background(), map[string]any{"a": 11}, new(map[string]any))
+	if len(err) != 1 || err[0].Code() != errors.CodeMax {
+		t.Errorf("Expected a single max error, got: %s", err)
+	}
+}
+
+// Requirements:
+// - Struct inputs with a zero value are computed.
+// - Struct inputs with a value are not computed.
+// - Keys without dependencies are computed.
+// - A key with only a computed value is unknown in the input.
+func TestWithComputedKey_Struct(t *testing.T) {
+	ruleSet := rules.Struct[computedTest]().
+		WithComputedKey("displayName", func(ctx context.Context, obj computedTest) (any, error) {
+			return "Anonymous", nil
+		})
+
+	var out computedTest
+	if err := ruleSet.Apply(context.Background(), computedTest{}, &out); err.HasErrors() {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	} else if out.DisplayName != "Anonymous" {
+		t.Errorf("Expected display name to be %q, got: %q", "Anonymous", out.DisplayName)
+	}
+
+	out = computedTest{}
+	if err := ruleSet.Apply(context.Background(), computedTest{DisplayName: "Ada"}, &out); err.HasErrors() {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	} else if out.DisplayName != "" {
+		t.Errorf("Expected display name to not be set, got: %q", out.DisplayName)
+	}
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"displayName": "Ada"}, errors.CodeUnexpected)
+}
+
+// Requirements:
+// - Panics if the function is nil.
+// - Panics if the struct has no mapping for the key.
+// - Panics on circular dependencies.
+func TestWithComputedKey_Panic(t *testing.T) {
+	mustPanic := func(name string, fn func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("Expected panic for %s", name)
+			}
+		}()
+		fn()
+	}
+
+	mustPanic("nil function", func() {
+		rules.Struct[*computedTest]().WithComputedKey("displayName", nil)
+	})
+	mustPanic("missing mapping", func() {
+		rules.Struct[*computedTest]().WithComputedKey("z", displayName)
+	})
+	mustPanic("circular dependency", func() {
+		rules.Struct[*computedTest]().
+			WithComputedKey("firstName", displayName, "displayName").
+			WithComputedKey("displayName", displayName, "firstName")
+	})
+}
@@ -0,0 +1,65 @@
+package rules
+
+import (
+	"context"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// hasKeyRuleSet returns true if a key rule set, dynamic key or value rule set will be evaluated for the key.
+// Key functions are not included since they run after all the conditions.
+func (v *ObjectRuleSet[T, TK, TV]) hasKeyRuleSet(ctx context.Context, key TK) bool {
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if currentRuleSet.values {
+			return true
+		}
+		if currentRuleSet.rule == nil || currentRuleSet.keyFunc != nil || currentRuleSet.key == nil {
+			continue
+		}
+		if c, ok := exactConstant(currentRuleSet.key); ok {
+			if c.Value() == key {
+				return true
+			}
+		} else if currentRuleSet.key.Evaluate(ctx, key) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// checkDependencies returns an error if a condition depends on a constant key that has no rule set.
+//
+// Conditions only see values that have been set by a key rule set so the dependency would always be missing. This is
+// almost always a typo in the key name. Dependencies on dynamic keys are not checked since they may match any key.
+func (v *ObjectRuleSet[T, TK, TV]) checkDependencies(ctx context.Context) errors.ValidationError {
+	// The ref tracker is only set once a condition has been added.
+	if v.refs == nil {
+		return nil
+	}
+
+	checkCtx := context.Background()
+
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if currentRuleSet.condition == nil {
+			continue
+		}
+
+		for _, dependsOn := range currentRuleSet.condition.KeyRules() {
+			c, ok := exactConstant(dependsOn)
+			if !ok || v.hasKeyRuleSet(checkCtx, c.Value()) {
+				continue
+			}
+
+			name := currentRuleSet.key.String()
+			if key, ok := exactConstant(currentRuleSet.key); ok {
+				name = toQuotedPath(key.Value())
+			}
+
+			return errors.Errorf(
+				errors.CodeInternal, ctx,
+				"condition for key %s depends on key %s which has no rule set", name, toQuotedPath(c.Value()),
+			)
+		}
+	}
+	return nil
+}
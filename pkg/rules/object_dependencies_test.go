@@ -0,0 +1,33 @@
+package rules_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Returns a CodeInternal error if a condition depends on a key with no rule set.
+// - The error names the missing key.
+// - Dependencies added after the conditional key are allowed.
+// - Dependencies covered by dynamic keys or value rule sets are allowed.
+func TestConditionalKeyMissingDependency(t *testing.T) {
+	condition := rules.StringMap[any]().WithUnknown().WithKey("tpye", rules.Constant[any]("a").Any())
+
+	ruleSet := rules.StringMap[any]().
+		WithKey("type", rules.String().Any()).
+		WithConditionalKey("value", condition, rules.Int().Any())
+
+	err := testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"type": "a", "value": 1}, errors.CodeInternal)
+	if err != nil && !strings.Contains(err.Error(), `"tpye"`) {
+		t.Errorf("Expected error to name the missing key, got: %s", err)
+	}
+
+	testhelpers.MustApplyAny(t, ruleSet.WithKey("tpye", rules.String().Any()).Any(), map[string]any{"type": "a", "value": 1})
+	testhelpers.MustApplyAny(t, ruleSet.WithDynamicKey(rules.String().WithRegexp(regexp.MustCompile("^t"), ""), rules.Any()).Any(), map[string]any{"type": "a", "value": 1})
+	testhelpers.MustApplyAny(t, ruleSet.WithValueRuleSet(rules.Any()).Any(), map[string]any{"type": "a", "value": 1})
+}
@@ -0,0 +1,17 @@
+package rules
+
+import "fmt"
+
+// WithJsonOptions allows the input to be a Json encoded string, like WithJson, and sets the options used to
+// decode it. Input that can not be decoded with the options returns a coercion error.
+func (v *ObjectRuleSet[T, TK, TV]) WithJsonOptions(options JsonOptions) *ObjectRuleSet[T, TK, TV] {
+	if v.json && v.jsonOptions == options {
+		return v
+	}
+
+	newRuleSet := v.withParent()
+	newRuleSet.json = true
+	newRuleSet.jsonOptions = options
+	newRuleSet.label = fmt.Sprintf("WithJsonOptions(%+v)", options)
+	return newRuleSet
+}
@@ -0,0 +1,78 @@
+package rules_test
+
+import (
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Large integers lose precision with WithJson.
+// - Large integers keep their exact value with UseNumber.
+// - Floats still work with UseNumber.
+func TestWithJsonOptionsUseNumber(t *testing.T) {
+	j := `{"id": 9007199254740993, "score": 1.5}`
+	ruleSet := rules.StringMap[any]().
+		WithKey("id", rules.Int64().Any()).
+		WithKey("score", rules.Float64().Any())
+
+	// 2^53 + 1 is rounded to 2^53 as a float64
+	var output map[string]any
+	if errs := ruleSet.WithJson().Apply(context.Background(), j, &output); errs != nil {
+		t.Fatalf("Expected errors to be nil, got: %s", errs)
+	} else if id := output["id"]; id != int64(9007199254740992) {
+		t.Errorf("Expected id to lose precision, got: %v", id)
+	}
+
+	output = nil
+	errs := ruleSet.WithJsonOptions(rules.JsonOptions{UseNumber: true}).Apply(context.Background(), j, &output)
+	if errs != nil {
+		t.Fatalf("Expected errors to be nil, got: %s", errs)
+	}
+	if id := output["id"]; id != int64(9007199254740993) {
+		t.Errorf("Expected id to be 9007199254740993, got: %v", id)
+	}
+	if score := output["score"]; score != 1.5 {
+		t.Errorf("Expected score to be 1.5, got: %v", score)
+	}
+}
+
+// Requirements:
+// - Duplicate keys are allowed by default.
+// - Duplicate keys return a type error with DisallowDuplicateKeys, including in nested objects and arrays.
+// - Trailing data returns a type error.
+func TestWithJsonOptionsDuplicateKeys(t *testing.T) {
+	ruleSet := rules.StringMap[any]().
+		WithKey("x", rules.Int().Any()).
+		WithKey("y", rules.Any())
+	strict := ruleSet.WithJsonOptions(rules.JsonOptions{DisallowDuplicateKeys: true})
+
+	testhelpers.MustApplyAny(t, ruleSet.WithJson().Any(), `{"x": 1, "x": 2}`)
+	testhelpers.MustApplyAny(t, strict.Any(), `{"x": 1, "y": {"a": 1, "b": [{"a": 1}, {"a": 2}]}}`)
+
+	testhelpers.MustNotApply(t, strict.Any(), `{"x": 1, "x": 2}`, errors.CodeType)
+	testhelpers.MustNotApply(t, strict.Any(), `{"x": 1, "y": {"a": 1, "a": 2}}`, errors.CodeType)
+	testhelpers.MustNotApply(t, strict.Any(), `{"y": [{"a": 1, "a": 2}]}`, errors.CodeType)
+	testhelpers.MustNotApply(t, strict.Any(), `{"x": 1} {}`, errors.CodeType)
+	testhelpers.MustNotApply(t, strict.Any(), `{"x": 1`, errors.CodeType)
+}
+
+// Requirements:
+// - WithJsonOptions is idempotent for the same options.
+// - Serializes to WithJsonOptions(...).
+func TestWithJsonOptionsString(t *testing.T) {
+	options := rules.JsonOptions{UseNumber: true}
+	ruleSet := rules.StringMap[any]().WithJsonOptions(options)
+
+	if ruleSet.WithJsonOptions(options) != ruleSet {
+		t.Error("Expected WithJsonOptions to return the same rule set for the same options")
+	}
+
+	expected := ".WithJsonOptions({UseNumber:true DisallowDuplicateKeys:false})"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
@@ -3,6 +3,7 @@ package rules
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"proto.zip/studio/validate/pkg/errors"
@@ -131,3 +132,88 @@ func TestRefTrackerNegative(t *testing.T) {
 	c.Lock()
 	c.Unlock()
 }
+
+type testStructTenFields struct {
+	A, B, C, D, E, F, G, H, I, J int
+}
+
+// BenchmarkConstantKeys compares evaluating a 10 field struct from a map on the concurrent path with the inline
+// path used for rule sets that only have constant keys.
+func BenchmarkConstantKeys(b *testing.B) {
+	ruleSet := Struct[testStructTenFields]()
+	input := make(map[string]any)
+	for _, key := range []string{"A", "B", "C", "D", "E", "F", "G", "H", "I", "J"} {
+		ruleSet = ruleSet.WithKey(key, Int().WithMin(0).WithMax(100).Any())
+		input[key] = 50
+	}
+
+	defaultLimit := inlineKeyLimit
+	run := func(b *testing.B, limit int) {
+		original := inlineKeyLimit
+		inlineKeyLimit = limit
+		defer func() { inlineKeyLimit = original }()
+
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var out testStructTenFields
+			if err := ruleSet.Apply(context.Background(), input, &out); err != nil {
+				b.Fatalf("Expected errors to be empty, got: %s", err)
+			}
+		}
+	}
+
+	b.Run("Concurrent", func(b *testing.B) { run(b, 0) })
+	b.Run("Inline", func(b *testing.B) { run(b, defaultLimit) })
+}
+
+// Requirements:
+// - Levenshtein distance counts insertions, deletions and substitutions.
+// - Runes are compared rather than bytes.
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"usernmae", "username", 2},
+		{"naïve", "naive", 1},
+	}
+
+	for _, tt := range tests {
+		if d := levenshtein(tt.a, tt.b); d != tt.expected {
+			t.Errorf("Expected distance between %q and %q to be %d, got: %d", tt.a, tt.b, tt.expected, d)
+		}
+	}
+}
+
+type testCacheStruct struct {
+	Name string
+}
+
+// Requirements:
+// - Struct parses the mappings for a type once and stores them in the cache.
+// - Later calls to Struct for the same type load the mappings from the cache.
+func TestStructMappingCache(t *testing.T) {
+	outputType := reflect.TypeOf(testCacheStruct{})
+	structMappingCache.Delete(outputType)
+	before := structMappingParses.Load()
+
+	Struct[testCacheStruct]()
+	Struct[*testCacheStruct]()
+	Struct[testCacheStruct]()
+
+	if parses := structMappingParses.Load() - before; parses != 1 {
+		t.Errorf("Expected mappings to be parsed once, got: %d", parses)
+	}
+
+	cached, ok := structMappingCache.Load(outputType)
+	if !ok {
+		t.Fatalf("Expected mappings for %v to be cached", outputType)
+	}
+	if mappings := cached.([]structMapping); len(mappings) != 1 || mappings[0].key != "Name" {
+		t.Errorf("Expected a single mapping for Name, got: %v", mappings)
+	}
+}
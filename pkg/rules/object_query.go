@@ -0,0 +1,63 @@
+package rules
+
+import "reflect"
+
+// sliceOutput is implemented by rule sets that can report if they produce a slice or array.
+type sliceOutput interface {
+	outputsSlice() bool
+}
+
+// WithQuerySemantics returns a new RuleSet that validates url.Values and other maps of slices the way query strings
+// and form values are usually read. If a key has exactly one value, the value is passed to the rule set for the key
+// on its own so scalar rule sets can be used without wrapping them in a slice rule set:
+//
+//	ruleSet := rules.StringMap[any]().
+//		WithKey("age", rules.Int().Any()).
+//		WithKey("tag", rules.Slice[string]().Any()).
+//		WithQuerySemantics()
+//
+// Keys with a slice or array rule set always get every value, even if there is only one. Keys with more than one
+// value are passed as is so a scalar rule set returns a coercion error.
+func (v *ObjectRuleSet[T, TK, TV]) WithQuerySemantics() *ObjectRuleSet[T, TK, TV] {
+	if v.query {
+		return v
+	}
+
+	newRuleSet := v.withParent()
+	newRuleSet.query = true
+	newRuleSet.label = "WithQuerySemantics()"
+	return newRuleSet
+}
+
+// queryValue returns the only item of a slice with one item unless the rule set expects a slice.
+// Any other value is returned as is.
+func queryValue[TV any](value reflect.Value, ruleSet RuleSet[TV]) reflect.Value {
+	items := value
+	if items.Kind() == reflect.Interface {
+		items = items.Elem()
+	}
+
+	if items.Kind() != reflect.Slice || items.Len() != 1 || items.Type().Elem().Kind() == reflect.Uint8 {
+		return value
+	}
+
+	if expectsSlice(ruleSet) {
+		return value
+	}
+	return items.Index(0)
+}
+
+// expectsSlice returns true if the rule set produces a slice or array.
+func expectsSlice[TV any](ruleSet RuleSet[TV]) bool {
+	var empty [0]TV
+
+	switch reflect.TypeOf(empty).Elem().Kind() {
+	case reflect.Slice, reflect.Array:
+		return true
+	case reflect.Interface:
+		if s, ok := any(ruleSet).(sliceOutput); ok {
+			return s.outputsSlice()
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+package rules_test
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// queryParams is the output of the query tests.
+type queryParams struct {
+	Age  int      `validate:"age"`
+	Name string   `validate:"name"`
+	Tags []string `validate:"tag"`
+}
+
+// Requirements:
+// - Single values are passed to scalar rule sets on their own.
+// - Slice rule sets get every value, even if there is only one.
+// - Repeated keys with a scalar rule set return a type error.
+// - Without query semantics single values are not unwrapped.
+func TestWithQuerySemantics(t *testing.T) {
+	base := rules.Struct[queryParams]().
+		WithKey("age", rules.Int().WithMin(18).Any()).
+		WithKey("name", rules.String().Any()).
+		WithKey("tag", rules.Slice[string]().WithItemRuleSet(rules.String().WithMinLen(2)).Any())
+	ruleSet := base.WithQuerySemantics()
+
+	query, err := url.ParseQuery("age=21&name=alice&tag=go&tag=web")
+	if err != nil {
+		t.Fatalf("Expected parse error to be nil, got: %s", err)
+	}
+
+	var output queryParams
+	if errs := ruleSet.Apply(context.Background(), query, &output); errs != nil {
+		t.Fatalf("Expected errors to be nil, got: %s", errs)
+	}
+	expected := queryParams{Age: 21, Name: "alice", Tags: []string{"go", "web"}}
+	if !reflect.DeepEqual(output, expected) {
+		t.Errorf("Expected output to be %v, got: %v", expected, output)
+	}
+
+	output = queryParams{}
+	if errs := ruleSet.Apply(context.Background(), url.Values{"tag": {"go"}}, &output); errs != nil {
+		t.Fatalf("Expected errors to be nil, got: %s", errs)
+	} else if !reflect.DeepEqual(output.Tags, []string{"go"}) {
+		t.Errorf("Expected tags to be [go], got: %v", output.Tags)
+	}
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), url.Values{"age": {"12"}}, errors.CodeMin)
+	testhelpers.MustNotApply(t, ruleSet.Any(), url.Values{"age": {"21", "22"}}, errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet.Any(), url.Values{"tag": {"go", "x"}}, errors.CodeMin)
+	testhelpers.MustNotApply(t, base.Any(), url.Values{"age": {"21"}}, errors.CodeType)
+}
+
+// Requirements:
+// - Works with maps of any values.
+// - Serializes to WithQuerySemantics().
+func TestWithQuerySemanticsMap(t *testing.T) {
+	ruleSet := rules.StringMap[any]().
+		WithKey("page", rules.Int().Any()).
+		WithQuerySemantics()
+
+	testhelpers.MustApplyFunc(t, ruleSet.Any(), map[string]any{"page": []string{"2"}}, map[string]any{"page": 2}, checkDeepEqual)
+	testhelpers.MustApplyFunc(t, ruleSet.Any(), map[string]any{"page": 3}, map[string]any{"page": 3}, checkDeepEqual)
+
+	expected := ".WithKey(\"page\", IntRuleSet[int].Any()).WithQuerySemantics()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+
+	if ruleSet.WithQuerySemantics() != ruleSet {
+		t.Error("Expected WithQuerySemantics to return the same rule set when already set")
+	}
+}
@@ -0,0 +1,227 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+)
+
+// Implements the Rule interface for comparing two fields of the same object.
+type fieldCompareRule[T any, TK comparable] struct {
+	NoConflict[T]
+	keyA  TK
+	keyB  TK
+	destA TK
+	destB TK
+	cmp   func(ctx context.Context, a, b any) errors.ValidationErrorCollection
+	label string
+}
+
+// presentKeysKey is the context key for the keys supplied by the input of the object being applied.
+type presentKeysKey struct{}
+
+// withPresentKeys returns a context that lets object rules look up the keys supplied by the input.
+// The keys are only computed the first time they are needed.
+func withPresentKeys[TK comparable](ctx context.Context, fn func() map[TK]bool) context.Context {
+	return context.WithValue(ctx, presentKeysKey{}, sync.OnceValue(fn))
+}
+
+// keyPresent returns false if the key was not supplied by the input.
+// Keys are assumed to be present if the context does not have the present keys.
+func keyPresent[TK comparable](ctx context.Context, key TK) bool {
+	fn, ok := ctx.Value(presentKeysKey{}).(func() map[TK]bool)
+	if !ok {
+		return true
+	}
+	return fn()[key]
+}
+
+// fieldValue returns the output value for the destination key or false if the value is missing.
+func (rule *fieldCompareRule[T, TK]) fieldValue(value reflect.Value, dest TK) (any, bool) {
+	var field reflect.Value
+
+	if value.Kind() == reflect.Map {
+		field = value.MapIndex(reflect.ValueOf(dest))
+	} else {
+		field = value.FieldByName(any(dest).(string))
+	}
+
+	for field.Kind() == reflect.Pointer || field.Kind() == reflect.Interface {
+		if field.IsNil() {
+			return nil, false
+		}
+		field = field.Elem()
+	}
+
+	if !field.IsValid() {
+		return nil, false
+	}
+
+	return field.Interface(), true
+}
+
+// Evaluate takes a context and object value and returns an error if the comparison fails.
+// The comparison is skipped if either of the keys is missing from the input or either of the values is nil.
+func (rule *fieldCompareRule[T, TK]) Evaluate(ctx context.Context, value T) errors.ValidationErrorCollection {
+	if !keyPresent(ctx, rule.keyA) || !keyPresent(ctx, rule.keyB) {
+		return nil
+	}
+
+	objValue := reflect.Indirect(reflect.ValueOf(value))
+	if !objValue.IsValid() {
+		return nil
+	}
+
+	a, okA := rule.fieldValue(objValue, rule.destA)
+	b, okB := rule.fieldValue(objValue, rule.destB)
+
+	if !okA || !okB {
+		return nil
+	}
+
+	return rule.cmp(rulecontext.WithPathString(ctx, toPath(rule.keyB)), a, b)
+}
+
+// String returns the string representation of the comparison rule.
+// Example: WithFieldLessThan("a", "b")
+func (rule *fieldCompareRule[T, TK]) String() string {
+	return rule.label
+}
+
+// compareOrdered compares two values of an ordered type and returns -1, 0, or 1.
+// The second return value is false if the values cannot be compared.
+func compareOrdered(a, b any) (int, bool) {
+	if timeA, ok := a.(time.Time); ok {
+		if timeB, ok := b.(time.Time); ok {
+			return timeA.Compare(timeB), true
+		}
+		return 0, false
+	}
+
+	valueA := reflect.ValueOf(a)
+	valueB := reflect.ValueOf(b)
+
+	switch {
+	case valueA.CanInt() && valueB.CanInt():
+		return compare3(valueA.Int(), valueB.Int()), true
+	case valueA.CanUint() && valueB.CanUint():
+		return compare3(valueA.Uint(), valueB.Uint()), true
+	case valueA.CanFloat() && valueB.CanFloat():
+		return compare3(valueA.Float(), valueB.Float()), true
+	case valueA.Kind() == reflect.String && valueB.Kind() == reflect.String:
+		return compare3(valueA.String(), valueB.String()), true
+	}
+
+	return 0, false
+}
+
+// compare3 returns -1 if a is less than b, 1 if a is greater than b and 0 if they are equal.
+func compare3[T integer | floating | ~string](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// fieldDestination returns the output destination for a key and panics if the key has no mapping.
+func (v *ObjectRuleSet[T, TK, TV]) fieldDestination(key TK) TK {
+	if v.outputType.Kind() == reflect.Map {
+		return key
+	}
+
+	destKey, ok := v.mappingFor(context.Background(), key)
+	if !ok {
+		panic(fmt.Errorf("missing mapping for key: %s", toPath(key)))
+	}
+	return destKey
+}
+
+// withFieldCompare returns a new rule set with a field comparison rule added.
+// The label is a format string that takes the quoted keys as arguments.
+func (v *ObjectRuleSet[T, TK, TV]) withFieldCompare(keyA, keyB TK, label string, cmp func(ctx context.Context, a, b any) errors.ValidationErrorCollection) *ObjectRuleSet[T, TK, TV] {
+	return v.WithRule(&fieldCompareRule[T, TK]{
+		keyA:  keyA,
+		keyB:  keyB,
+		destA: v.fieldDestination(keyA),
+		destB: v.fieldDestination(keyB),
+		cmp:   cmp,
+		label: fmt.Sprintf(label, toQuotedPath(keyA), toQuotedPath(keyB)),
+	})
+}
+
+// orderedCompare returns a comparison function that returns an error at keyB if the result of the comparison
+// does not satisfy the check function.
+func orderedCompare[TK comparable](keyA TK, msg string, check func(int) bool) func(ctx context.Context, a, b any) errors.ValidationErrorCollection {
+	return func(ctx context.Context, a, b any) errors.ValidationErrorCollection {
+		result, ok := compareOrdered(a, b)
+		if !ok {
+			return errors.Collection(
+				errors.Errorf(errors.CodeInternal, ctx, "cannot compare %T to %T", a, b),
+			)
+		}
+
+		if !check(result) {
+			return errors.Collection(
+				errors.Errorf(errors.CodeMin, ctx, msg, toPath(keyA)),
+			)
+		}
+		return nil
+	}
+}
+
+// WithFieldLessThan returns a new RuleSet that requires the output value of keyA to be less than the output value of keyB.
+//
+// The comparison runs after all the keys are validated and errors are reported at the path of keyB.
+// If either key is missing from the input or either value is nil then the comparison is skipped, even if a struct
+// field still holds a zero value. Keys are missing in the same cases as ApplyWithPresentKeys, so every field of a
+// struct input is present. Use WithRequired on the key rule sets if the values must be present.
+//
+// Integers, unsigned integers, floating point numbers, strings, and time.Time values are supported. Comparing
+// values of any other type returns an errors.CodeInternal error.
+//
+// This method panics if either key does not have a mapping on a struct output.
+func (v *ObjectRuleSet[T, TK, TV]) WithFieldLessThan(keyA, keyB TK) *ObjectRuleSet[T, TK, TV] {
+	return v.withFieldCompare(keyA, keyB, "WithFieldLessThan(%s, %s)", orderedCompare(keyA, "field must be greater than %s", func(result int) bool {
+		return result < 0
+	}))
+}
+
+// WithFieldLessThanOrEqual behaves like WithFieldLessThan except the values are also allowed to be equal.
+func (v *ObjectRuleSet[T, TK, TV]) WithFieldLessThanOrEqual(keyA, keyB TK) *ObjectRuleSet[T, TK, TV] {
+	return v.withFieldCompare(keyA, keyB, "WithFieldLessThanOrEqual(%s, %s)", orderedCompare(keyA, "field must be greater than or equal to %s", func(result int) bool {
+		return result <= 0
+	}))
+}
+
+// WithFieldCompare returns a new RuleSet that calls the compare function with the output values of keyA and keyB.
+//
+// If the function returns an error then a validation error is reported at the path of keyB. If the returned error
+// is an errors.ValidationError then the code is preserved, otherwise errors.CodePattern is used.
+//
+// Like WithFieldLessThan, the comparison runs after all the keys are validated and is skipped if either key is missing
+// from the input or either value is nil.
+func (v *ObjectRuleSet[T, TK, TV]) WithFieldCompare(keyA, keyB TK, cmp func(a, b any) error) *ObjectRuleSet[T, TK, TV] {
+	return v.withFieldCompare(keyA, keyB, "WithFieldCompare(%s, %s, ...)", func(ctx context.Context, a, b any) errors.ValidationErrorCollection {
+		err := cmp(a, b)
+		if err == nil {
+			return nil
+		}
+
+		code := errors.CodePattern
+		if verr, ok := err.(errors.ValidationError); ok {
+			code = verr.Code()
+		}
+
+		return errors.Collection(
+			errors.Errorf(code, ctx, "%s", err.Error()),
+		)
+	})
+}
@@ -0,0 +1,199 @@
+package rules_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+type testCompareStruct struct {
+	Start    time.Time `validate:"start"`
+	End      time.Time `validate:"end"`
+	MinUsers int       `validate:"minUsers"`
+	MaxUsers *int      `validate:"maxUsers"`
+}
+
+// Requirements:
+// - Passes when the first value is less than the second.
+// - Fails when the values are equal or the first is greater.
+// - Error path points to the second key.
+func TestWithFieldLessThan(t *testing.T) {
+	ruleSet := rules.StringMap[int]().
+		WithKey("a", rules.Int()).
+		WithKey("b", rules.Int()).
+		WithFieldLessThan("a", "b")
+
+	testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{"a": 1, "b": 2})
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"a": 2, "b": 2}, errors.CodeMin)
+
+	err := testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"a": 3, "b": 2}, errors.CodeMin)
+	if err != nil {
+		if path := err.(errors.ValidationErrorCollection).First().Path(); path != "/b" {
+			t.Errorf("Expected error path to be /b, got: %s", path)
+		}
+	}
+}
+
+// Requirements:
+// - Equal values pass.
+func TestWithFieldLessThanOrEqual(t *testing.T) {
+	ruleSet := rules.StringMap[int]().
+		WithKey("a", rules.Int()).
+		WithKey("b", rules.Int()).
+		WithFieldLessThanOrEqual("a", "b")
+
+	testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{"a": 2, "b": 2})
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"a": 3, "b": 2}, errors.CodeMin)
+}
+
+// Requirements:
+// - Comparison is skipped if either value is missing.
+func TestWithFieldLessThanMissing(t *testing.T) {
+	ruleSet := rules.StringMap[int]().
+		WithKey("a", rules.Int()).
+		WithKey("b", rules.Int()).
+		WithFieldLessThan("a", "b")
+
+	testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{"a": 3})
+}
+
+// Requirements:
+// - Struct fields are looked up using the key mappings.
+// - Times are compared.
+// - Pointers are dereferenced and nil pointers skip the comparison.
+func TestWithFieldLessThanStruct(t *testing.T) {
+	ruleSet := rules.Struct[testCompareStruct]().
+		WithKey("start", rules.Any()).
+		WithKey("end", rules.Any()).
+		WithKey("minUsers", rules.Int().Any()).
+		WithKey("maxUsers", rules.Int().Any()).
+		WithFieldLessThan("start", "end").
+		WithFieldLessThanOrEqual("minUsers", "maxUsers")
+
+	now := time.Now()
+	later := now.Add(time.Hour)
+
+	var out testCompareStruct
+	err := ruleSet.Apply(context.Background(), map[string]any{"start": now, "end": later, "minUsers": 5, "maxUsers": 5}, &out)
+	if err != nil {
+		t.Errorf("Expected error to be nil, got: %s", err)
+	}
+
+	err = ruleSet.Apply(context.Background(), map[string]any{"start": later, "end": now, "minUsers": 5, "maxUsers": 4}, &out)
+	if err == nil {
+		t.Error("Expected error to not be nil")
+	} else if len(err) != 2 {
+		t.Errorf("Expected 2 errors, got: %d", len(err))
+	} else if err.For("/end") == nil || err.For("/maxUsers") == nil {
+		t.Errorf("Expected errors for /end and /maxUsers, got: %s", err)
+	}
+
+	var outMissing testCompareStruct
+	err = ruleSet.Apply(context.Background(), map[string]any{"start": now, "end": later, "minUsers": 5}, &outMissing)
+	if err != nil {
+		t.Errorf("Expected error to be nil, got: %s", err)
+	}
+}
+
+// Requirements:
+// - Comparison is skipped if a key is missing from the input even if the struct field has a zero value.
+// - Struct inputs have every key present so zero values are compared.
+func TestWithFieldLessThanStructMissing(t *testing.T) {
+	ruleSet := rules.Struct[testCompareStruct]().
+		WithKey("start", rules.Any()).
+		WithKey("end", rules.Any()).
+		WithFieldLessThan("start", "end")
+
+	now := time.Now()
+
+	var out testCompareStruct
+	if err := ruleSet.Apply(context.Background(), map[string]any{"start": now}, &out); err != nil {
+		t.Errorf("Expected error to be nil, got: %s", err)
+	}
+	if err := ruleSet.Apply(context.Background(), map[string]any{"end": now}, &out); err != nil {
+		t.Errorf("Expected error to be nil, got: %s", err)
+	}
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), testCompareStruct{Start: now}, errors.CodeMin)
+}
+
+// Requirements:
+// - Incomparable values return an internal error.
+func TestWithFieldLessThanIncomparable(t *testing.T) {
+	ruleSet := rules.StringMap[any]().
+		WithKey("a", rules.Int().Any()).
+		WithKey("b", rules.String().Any()).
+		WithFieldLessThan("a", "b")
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"a": 1, "b": "2"}, errors.CodeInternal)
+}
+
+// Requirements:
+// - Compare function receives both values.
+// - Validation error codes are preserved.
+// - Other errors use CodePattern.
+func TestWithFieldCompare(t *testing.T) {
+	ruleSet := rules.StringMap[string]().
+		WithKey("password", rules.String()).
+		WithKey("confirm", rules.String()).
+		WithFieldCompare("password", "confirm", func(a, b any) error {
+			if a != b {
+				return fmt.Errorf("passwords do not match")
+			}
+			return nil
+		})
+
+	testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{"password": "abc", "confirm": "abc"})
+
+	err := testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"password": "abc", "confirm": "xyz"}, errors.CodePattern)
+	if err != nil {
+		first := err.(errors.ValidationErrorCollection).First()
+		if first.Path() != "/confirm" {
+			t.Errorf("Expected error path to be /confirm, got: %s", first.Path())
+		}
+		if first.Error() != "passwords do not match" {
+			t.Errorf("Expected error message to be preserved, got: %s", first)
+		}
+	}
+
+	ruleSet = rules.StringMap[string]().
+		WithKey("a", rules.String()).
+		WithKey("b", rules.String()).
+		WithFieldCompare("a", "b", func(a, b any) error {
+			return errors.Errorf(errors.CodeForbidden, context.Background(), "error")
+		})
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"a": "abc", "b": "xyz"}, errors.CodeForbidden)
+}
+
+// Requirements:
+// - Panics if a key is not mapped on a struct.
+func TestWithFieldLessThanMissingMapping(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+
+	rules.Struct[testCompareStruct]().WithFieldLessThan("start", "missing")
+}
+
+// Requirements:
+// - Serializes the keys.
+func TestWithFieldCompareString(t *testing.T) {
+	ruleSet := rules.StringMap[int]().
+		WithFieldLessThan("a", "b").
+		WithFieldLessThanOrEqual("c", "d").
+		WithFieldCompare("e", "f", func(a, b any) error { return nil })
+
+	expected := `WithFieldLessThan("a", "b").WithFieldLessThanOrEqual("c", "d").WithFieldCompare("e", "f", ...)`
+	if s := ruleSet.String(); !strings.HasSuffix(s, expected) {
+		t.Errorf("Expected rule set to end with %s, got %s", expected, s)
+	}
+}
@@ -0,0 +1,54 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// Implements the Rule interface for an object rule that is only evaluated when a condition is met.
+type conditionalObjectRule[T any, TK comparable] struct {
+	NoConflict[T]
+	condition Conditional[T, TK]
+	rule      Rule[T]
+}
+
+// Evaluate takes a context and object value and evaluates the rule if the condition returns no errors.
+// Errors from the condition are never returned.
+func (rule *conditionalObjectRule[T, TK]) Evaluate(ctx context.Context, value T) errors.ValidationErrorCollection {
+	if rule.condition.Evaluate(ctx, value) != nil {
+		return nil
+	}
+	return rule.rule.Evaluate(ctx, value)
+}
+
+// String returns the string representation of the conditional rule.
+// Example: WithConditionalRule(ObjectRuleSet[...], WithRuleFunc(...))
+func (rule *conditionalObjectRule[T, TK]) String() string {
+	return fmt.Sprintf("WithConditionalRule(%s, %s)", rule.condition, rule.rule)
+}
+
+// WithConditionalRule returns a new child rule set with an object rule that is only evaluated if the condition is met.
+//
+// Object rules run after all keys have been evaluated so the condition sees the validated and converted values. Like
+// WithConditionalKey, errors returned from the condition are only used to decide if the rule runs and are never
+// returned from Apply or Evaluate.
+//
+// If nil is passed in as the condition then this method behaves identical to WithRule.
+//
+// This method panics if the rule is nil.
+func (v *ObjectRuleSet[T, TK, TV]) WithConditionalRule(condition Conditional[T, TK], rule Rule[T]) *ObjectRuleSet[T, TK, TV] {
+	if rule == nil {
+		panic(fmt.Errorf("rule must not be nil"))
+	}
+
+	if condition == nil {
+		return v.WithRule(rule)
+	}
+
+	return v.WithRule(&conditionalObjectRule[T, TK]{
+		condition: condition,
+		rule:      rule,
+	})
+}
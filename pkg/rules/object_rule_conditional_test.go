@@ -0,0 +1,74 @@
+package rules_test
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - The rule is only evaluated when the condition is met.
+// - The condition sees the validated values.
+// - Errors from the condition are not returned.
+func TestWithConditionalRule(t *testing.T) {
+	var calls atomic.Int32
+
+	condition := rules.StringMap[any]().
+		WithKey("type", rules.Constant[any]("business").Any()).
+		WithUnknown()
+
+	ruleSet := rules.StringMap[any]().
+		WithKey("type", rules.String().Any()).
+		WithKey("vat", rules.String().Any()).
+		WithConditionalRule(condition, rules.RuleFunc[map[string]any](func(ctx context.Context, value map[string]any) errors.ValidationErrorCollection {
+			calls.Add(1)
+			if vat, _ := value["vat"].(string); vat == "" {
+				return errors.Collection(errors.Errorf(errors.CodeRequired, ctx, "vat is required for businesses"))
+			}
+			return nil
+		}))
+
+	testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{"type": "person"})
+	if n := calls.Load(); n != 0 {
+		t.Errorf("Expected rule to not be called, got %d calls", n)
+	}
+
+	testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{"type": "business", "vat": "GB123"})
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"type": "business"}, errors.CodeRequired)
+	if n := calls.Load(); n != 2 {
+		t.Errorf("Expected rule to be called 2 times, got %d", n)
+	}
+}
+
+// Requirements:
+// - Serializes as WithConditionalRule.
+// - A nil condition behaves like WithRule.
+// - Panics on a nil rule.
+func TestWithConditionalRuleString(t *testing.T) {
+	fn := rules.RuleFunc[map[string]any](func(ctx context.Context, value map[string]any) errors.ValidationErrorCollection {
+		return nil
+	})
+
+	ruleSet := rules.StringMap[any]().WithConditionalRule(rules.StringMap[any](), fn)
+	if s := ruleSet.String(); !strings.Contains(s, "WithConditionalRule(") {
+		t.Errorf("Expected string to contain WithConditionalRule, got: %s", s)
+	}
+
+	plain := rules.StringMap[any]().WithConditionalRule(nil, fn)
+	if s, expected := plain.String(), rules.StringMap[any]().WithRule(fn).String(); s != expected {
+		t.Errorf("Expected %s, got: %s", expected, s)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic")
+		}
+	}()
+
+	rules.StringMap[any]().WithConditionalRule(rules.StringMap[any](), nil)
+}
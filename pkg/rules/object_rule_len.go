@@ -0,0 +1,57 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// WithMinLen returns a new RuleSet that requires a map input to have at least min entries.
+//
+// The entries of the input are counted after JSON strings are parsed and before any keys are evaluated. If there
+// are too few, an errors.CodeMin error is returned for the object and the keys are still evaluated. Struct inputs
+// always have the same fields so they are not counted. This is intended for map rule sets.
+//
+// This method will panic if min is negative.
+func (v *ObjectRuleSet[T, TK, TV]) WithMinLen(min int) *ObjectRuleSet[T, TK, TV] {
+	if min < 0 {
+		panic(fmt.Errorf("min length must not be negative, got: %d", min))
+	}
+
+	newRuleSet := v.withParent()
+	newRuleSet.minLen = &min
+	newRuleSet.label = fmt.Sprintf("WithMinLen(%d)", min)
+	return newRuleSet
+}
+
+// WithMaxLen returns a new RuleSet that limits a map input to at most max entries. This protects public endpoints
+// from inputs with a very large number of keys.
+//
+// The entries of the input are counted after JSON strings are parsed and before any keys are evaluated. If there
+// are too many, a single errors.CodeMax error is returned for the object and none of the keys are evaluated. Struct
+// inputs always have the same fields so they are not counted. This is intended for map rule sets.
+//
+// This method will panic if max is negative.
+func (v *ObjectRuleSet[T, TK, TV]) WithMaxLen(max int) *ObjectRuleSet[T, TK, TV] {
+	if max < 0 {
+		panic(fmt.Errorf("max length must not be negative, got: %d", max))
+	}
+
+	newRuleSet := v.withParent()
+	newRuleSet.maxLen = &max
+	newRuleSet.label = fmt.Sprintf("WithMaxLen(%d)", max)
+	return newRuleSet
+}
+
+// evaluateLen returns an error if the number of entries is out of bounds.
+// The boolean is true if there are too many entries and the keys should not be evaluated.
+func (v *ObjectRuleSet[T, TK, TV]) evaluateLen(ctx context.Context, n int) (errors.ValidationErrorCollection, bool) {
+	if v.maxLen != nil && n > *v.maxLen {
+		return errors.Collection(errors.Errorf(errors.CodeMax, ctx, "object must have at most %d keys", *v.maxLen)), true
+	}
+	if v.minLen != nil && n < *v.minLen {
+		return errors.Collection(errors.Errorf(errors.CodeMin, ctx, "object must have at least %d keys", *v.minLen)), false
+	}
+	return nil, false
+}
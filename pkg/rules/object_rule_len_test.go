@@ -0,0 +1,79 @@
+package rules_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Maps with a number of entries within the bounds pass.
+// - Too few entries return a min error at the object path.
+// - Too many entries return a single max error at the object path without evaluating the keys.
+// - Entries of JSON strings are counted after parsing.
+// - Serializes to WithMinLen(...) and WithMaxLen(...).
+func TestObjectWithLen(t *testing.T) {
+	ruleSet := rules.StringMap[int]().
+		WithDynamicKey(rules.String(), rules.Int().WithMax(10)).
+		WithMinLen(1).
+		WithMaxLen(2)
+
+	testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{"a": 1})
+	testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{"a": 1, "b": 2})
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{}, errors.CodeMin)
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"a": 1, "b": 2, "c": 3}, errors.CodeMax)
+
+	var out map[string]int
+	errs := ruleSet.Apply(context.Background(), map[string]any{"a": 100, "b": 100, "c": 100}, &out)
+	if len(errs) != 1 || errs[0].Code() != errors.CodeMax || errs[0].Path() != "" {
+		t.Errorf("Expected one max error at the root, got: %s", errs)
+	}
+
+	testhelpers.MustNotApply(t, ruleSet.WithJson().Any(), `{"a": 1, "b": 2, "c": 3}`, errors.CodeMax)
+	testhelpers.MustApplyAny(t, ruleSet.WithJson().Any(), `{"a": 1}`)
+
+	expected := ".WithMinLen(1).WithMaxLen(2)"
+	if s := ruleSet.String(); !strings.HasSuffix(s, expected) {
+		t.Errorf("Expected rule set to end with %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Struct inputs are not counted.
+func TestObjectWithLen_Struct(t *testing.T) {
+	ruleSet := rules.Struct[*readOnlyTest]().
+		WithKey("id", rules.String().Any()).
+		WithKey("name", rules.String().Any()).
+		WithMaxLen(1)
+
+	var out *readOnlyTest
+	if err := ruleSet.Apply(context.Background(), &readOnlyTest{ID: "a", Name: "b"}, &out); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	}
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"id": "a", "name": "b"}, errors.CodeMax)
+}
+
+// Requirements:
+// - The bounds are included in the schema.
+func TestObjectWithLenJSONSchema(t *testing.T) {
+	expected := `{"maxProperties":5,"minProperties":1,"properties":{},"type":"object"}`
+	if s := mustJSONSchema(t, rules.StringMap[any]().WithUnknown().WithMinLen(1).WithMaxLen(5)); s != expected {
+		t.Errorf("Expected schema to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Panics if the length is negative.
+func TestObjectWithLen_Negative(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic")
+		}
+	}()
+
+	rules.StringMap[any]().WithMaxLen(-1)
+}
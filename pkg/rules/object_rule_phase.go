@@ -0,0 +1,49 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+)
+
+// WithRulePhase returns a new child rule set with an object rule that is evaluated in the given phase.
+//
+// Object rules run after all the keys have been evaluated. Rules added with WithRule and WithRuleFunc are in phase 0.
+// Phases run in ascending order and every rule in a phase finishes before any rule in the next phase starts, so a
+// rule can read values written by rules in earlier phases. Rules in the same phase may run concurrently and in any
+// order, and never run at the same time as rules in other phases.
+//
+// Later phases still run if an earlier phase returns errors, unless fail fast is enabled or the context is cancelled.
+// Phases may be negative to run before the default phase.
+func (v *ObjectRuleSet[T, TK, TV]) WithRulePhase(phase int, rule Rule[T]) *ObjectRuleSet[T, TK, TV] {
+	newRuleSet := v.WithRule(rule)
+	newRuleSet.phase = phase
+	newRuleSet.label = fmt.Sprintf("WithRulePhase(%d, %s)", phase, rule)
+	return newRuleSet
+}
+
+// objectRulePhases returns the object rules grouped by phase in ascending order.
+// Within each phase the rules are ordered from parent to child.
+func (v *ObjectRuleSet[T, TK, TV]) objectRulePhases() [][]Rule[T] {
+	byPhase := make(map[int][]Rule[T])
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if currentRuleSet.objRule != nil {
+			byPhase[currentRuleSet.phase] = append(byPhase[currentRuleSet.phase], currentRuleSet.objRule)
+		}
+	}
+
+	phases := make([]int, 0, len(byPhase))
+	for phase := range byPhase {
+		phases = append(phases, phase)
+	}
+	sort.Ints(phases)
+
+	result := make([][]Rule[T], 0, len(phases))
+	for _, phase := range phases {
+		objRules := byPhase[phase]
+		for i, j := 0, len(objRules)-1; i < j; i, j = i+1, j-1 {
+			objRules[i], objRules[j] = objRules[j], objRules[i]
+		}
+		result = append(result, objRules)
+	}
+	return result
+}
@@ -0,0 +1,82 @@
+package rules_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Phases run in ascending order regardless of the order they were added.
+// - Every rule in a phase finishes before the next phase starts.
+// - WithRule is phase 0.
+// - Works for both concurrent and sequential rule sets.
+func TestWithRulePhase(t *testing.T) {
+	var mu sync.Mutex
+	var order []string
+
+	record := func(name string, delay time.Duration) rules.RuleFunc[map[string]any] {
+		return func(ctx context.Context, value map[string]any) errors.ValidationErrorCollection {
+			time.Sleep(delay)
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, name)
+			return nil
+		}
+	}
+
+	ruleSet := rules.StringMap[any]().
+		WithRulePhase(2, record("last", 0)).
+		WithRule(record("default", 20*time.Millisecond)).
+		WithRulePhase(1, record("second-a", 20*time.Millisecond)).
+		WithRulePhase(1, record("second-b", 0)).
+		WithRulePhase(-1, record("first", 0))
+
+	for _, r := range []*rules.ObjectRuleSet[map[string]any, string, any]{ruleSet, ruleSet.WithSequential()} {
+		order = nil
+		testhelpers.MustApplyAny(t, r.Any(), map[string]any{})
+
+		got := strings.Join(order, ",")
+		if got != "first,default,second-a,second-b,last" && got != "first,default,second-b,second-a,last" {
+			t.Errorf("Expected rules to run in phase order, got: %s", got)
+		}
+	}
+}
+
+// Requirements:
+// - Later phases run when an earlier phase fails.
+// - Later phases do not run in fail fast mode once a rule fails.
+// - Serializes as WithRulePhase.
+func TestWithRulePhaseErrors(t *testing.T) {
+	var called bool
+
+	ruleSet := rules.StringMap[any]().
+		WithRuleFunc(func(ctx context.Context, value map[string]any) errors.ValidationErrorCollection {
+			return errors.Collection(errors.Errorf(errors.CodeUnexpected, ctx, "first phase failed"))
+		}).
+		WithRulePhase(1, rules.RuleFunc[map[string]any](func(ctx context.Context, value map[string]any) errors.ValidationErrorCollection {
+			called = true
+			return nil
+		}))
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{}, errors.CodeUnexpected)
+	if !called {
+		t.Error("Expected later phase to run")
+	}
+
+	called = false
+	testhelpers.MustNotApply(t, ruleSet.WithFailFast().Any(), map[string]any{}, errors.CodeUnexpected)
+	if called {
+		t.Error("Expected later phase to be skipped in fail fast mode")
+	}
+
+	if s := ruleSet.String(); !strings.HasSuffix(s, ".WithRulePhase(1, WithRuleFunc(...))") {
+		t.Errorf("Expected string to end with WithRulePhase, got: %s", s)
+	}
+}
@@ -0,0 +1,120 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+)
+
+// ReadOnlyMode is used to specify what happens when the input includes a read only key.
+//
+// These values are not guaranteed to be unchanged between versions. Don't use for serialization or cross-process communication.
+type ReadOnlyMode int
+
+const (
+	ReadOnlyError ReadOnlyMode = iota // Default. Return an errors.CodeUnexpected error for the key.
+	ReadOnlyDrop                      // Silently discard the key.
+)
+
+// String returns the string value for the read only mode. Useful for debugging.
+func (m ReadOnlyMode) String() string {
+	switch m {
+	case ReadOnlyError:
+		return "ReadOnlyError"
+	case ReadOnlyDrop:
+		return "ReadOnlyDrop"
+	}
+	return "Unknown"
+}
+
+// WithReadOnlyKey returns a new RuleSet that does not accept the key on input. This is useful for keys such as
+// identifiers and timestamps that are set by the server and returned to the client but must not be set by the client.
+//
+// With ReadOnlyError, a map input that includes the key returns an errors.CodeUnexpected error for the key. With
+// ReadOnlyDrop the key is discarded. In both modes the key is never written to the output, rule sets added for the
+// key with WithKey are not evaluated and the key is never required. Struct inputs always contain every field so for
+// them the key is dropped regardless of the mode.
+//
+// If WithReadOnlyKey is called more than once for the same key, the most recent mode is used.
+//
+// This method will panic if the rule set is for a struct and the key has no mapping.
+func (v *ObjectRuleSet[T, TK, TV]) WithReadOnlyKey(key TK, mode ReadOnlyMode) *ObjectRuleSet[T, TK, TV] {
+	if v.outputType.Kind() != reflect.Map {
+		if _, ok := v.mappingFor(context.Background(), key); !ok {
+			panic(fmt.Errorf("missing mapping for key: %s", toPath(key)))
+		}
+	}
+
+	newRuleSet := v.withParent()
+	newRuleSet.key = Constant[TK](key)
+	newRuleSet.readOnly = true
+	newRuleSet.readOnlyMode = mode
+	newRuleSet.label = fmt.Sprintf("WithReadOnlyKey(%s, %s)", toQuotedPath(key), mode)
+	return newRuleSet
+}
+
+// readOnlyKeys returns the mode of each read only key or nil if there are none.
+func (v *ObjectRuleSet[T, TK, TV]) readOnlyKeys() map[TK]ReadOnlyMode {
+	var keys map[TK]ReadOnlyMode
+
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if !currentRuleSet.readOnly {
+			continue
+		}
+		if keys == nil {
+			keys = make(map[TK]ReadOnlyMode)
+		}
+
+		// Rule sets are stored newest first so the first mode found wins.
+		key := currentRuleSet.key.(*ConstantRuleSet[TK]).Value()
+		if _, ok := keys[key]; !ok {
+			keys[key] = currentRuleSet.readOnlyMode
+		}
+	}
+
+	return keys
+}
+
+// evaluateReadOnly marks each read only key in a map input as known and returns an error for each one that is not
+// dropped.
+func (v *ObjectRuleSet[T, TK, TV]) evaluateReadOnly(ctx context.Context, readOnly map[TK]ReadOnlyMode, inValue reflect.Value, knownKeys *knownKeys[TK]) errors.ValidationErrorCollection {
+	allErrors := errors.Collection()
+
+	for _, mapKeyValue := range v.mapKeys(inValue) {
+		key, ok := mapKeyValue.Interface().(TK)
+		if !ok {
+			continue
+		}
+		mode, ok := readOnly[key]
+		if !ok {
+			continue
+		}
+
+		knownKeys.Add(key)
+		if mode == ReadOnlyError {
+			subContext := rulecontext.WithPathString(ctx, toPath(key))
+			allErrors = append(allErrors, errors.Errorf(errors.CodeUnexpected, subContext, "field is read only"))
+		}
+	}
+
+	return allErrors
+}
+
+// withoutReadOnly returns the keys that are not read only.
+// The original slice is returned if there are no read only keys.
+func withoutReadOnly[TK comparable](keys []TK, readOnly map[TK]ReadOnlyMode) []TK {
+	if len(readOnly) == 0 {
+		return keys
+	}
+
+	filtered := make([]TK, 0, len(keys))
+	for _, key := range keys {
+		if _, ok := readOnly[key]; !ok {
+			filtered = append(filtered, key)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,109 @@
+package rules_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+type readOnlyTest struct {
+	ID   string `validate:"id"`
+	Name string `validate:"name"`
+}
+
+// Requirements:
+// - ReadOnlyError returns an unexpected error for the key when it is included.
+// - The key is optional and rule sets for the key are not evaluated.
+// - Serializes to WithReadOnlyKey(...).
+func TestWithReadOnlyKey(t *testing.T) {
+	ruleSet := rules.Struct[*readOnlyTest]().
+		WithKey("id", rules.String().WithRequired().WithMinLen(10).Any()).
+		WithKey("name", rules.String().Any()).
+		WithReadOnlyKey("id", rules.ReadOnlyError)
+
+	testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{"name": "a"})
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"id": "abc", "name": "a"}, errors.CodeUnexpected)
+
+	var out *readOnlyTest
+	if err := ruleSet.Apply(context.Background(), map[string]any{"id": "abc"}, &out); len(err) != 1 || err[0].Path() != "/id" {
+		t.Errorf("Expected one error at /id, got: %s", err)
+	}
+
+	expected := `.WithReadOnlyKey("id", ReadOnlyError)`
+	if s := ruleSet.String(); !strings.HasSuffix(s, expected) {
+		t.Errorf("Expected rule set to end with %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - ReadOnlyDrop discards the key without an error.
+// - The key is not written to map or struct outputs.
+// - The key is not considered unknown.
+// - The most recent mode is used.
+func TestWithReadOnlyKey_Drop(t *testing.T) {
+	mapRuleSet := rules.StringMap[any]().
+		WithKey("name", rules.String().Any()).
+		WithReadOnlyKey("id", rules.ReadOnlyError).
+		WithReadOnlyKey("id", rules.ReadOnlyDrop)
+
+	var mapOut map[string]any
+	if err := mapRuleSet.Apply(context.Background(), map[string]any{"id": "abc", "name": "a"}, &mapOut); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	} else if _, ok := mapOut["id"]; ok {
+		t.Errorf("Expected id to be dropped, got: %v", mapOut)
+	}
+
+	if err := mapRuleSet.WithUnknown().Apply(context.Background(), map[string]any{"id": "abc"}, &mapOut); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	} else if _, ok := mapOut["id"]; ok {
+		t.Errorf("Expected id to be dropped with unknown keys, got: %v", mapOut)
+	}
+
+	structRuleSet := rules.Struct[*readOnlyTest]().
+		WithKey("id", rules.String().Any()).
+		WithKey("name", rules.String().Any()).
+		WithReadOnlyKey("id", rules.ReadOnlyDrop)
+
+	var out *readOnlyTest
+	if err := structRuleSet.Apply(context.Background(), map[string]any{"id": "abc", "name": "a"}, &out); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	} else if out.ID != "" || out.Name != "a" {
+		t.Errorf("Expected only name to be set, got: %v", out)
+	}
+
+	if err := structRuleSet.Apply(context.Background(), &readOnlyTest{ID: "abc", Name: "a"}, &out); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	} else if out.ID != "" {
+		t.Errorf("Expected id to be dropped from struct input, got: %s", out.ID)
+	}
+}
+
+// Requirements:
+// - Read only keys are never required.
+func TestWithReadOnlyKey_Required(t *testing.T) {
+	ruleSet := rules.StringMap[any]().
+		WithKey("id", rules.String().WithRequired().Any()).
+		WithRequiredIf("id", nil).
+		WithReadOnlyKey("id", rules.ReadOnlyError)
+
+	if ruleSet.KeyRequired("id") {
+		t.Error("Expected id to not be required")
+	}
+	testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{})
+}
+
+// Requirements:
+// - Panics if the struct has no mapping for the key.
+func TestWithReadOnlyKey_MissingMapping(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic")
+		}
+	}()
+
+	rules.Struct[*readOnlyTest]().WithReadOnlyKey("z", rules.ReadOnlyDrop)
+}
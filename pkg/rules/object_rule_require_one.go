@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"proto.zip/studio/validate/internal/util"
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// WithRequireAtLeastOne returns a new RuleSet that requires at least one of the provided keys to be present
+// in the input.
+//
+// Only the presence of the keys is checked, the values are not considered. Keys that are sorted into dynamic
+// buckets are still considered present. If none of the keys are present then a single errors.CodeRequired error
+// is returned for the object itself rather than for any individual key. The keys in the group are listed in the
+// "keys" metadata of the error, which can be read with errors.MetaOf.
+//
+// For struct inputs every exported field is always considered present.
+//
+// This method panics if no keys are provided.
+func (v *ObjectRuleSet[T, TK, TV]) WithRequireAtLeastOne(keys ...TK) *ObjectRuleSet[T, TK, TV] {
+	if len(keys) == 0 {
+		panic(fmt.Errorf("at least one key is required"))
+	}
+
+	newRuleSet := v.withParent()
+	newRuleSet.atLeastOne = append([]TK(nil), keys...)
+	newRuleSet.label = util.StringsToRuleOutput("WithRequireAtLeastOne", keys)
+	return newRuleSet
+}
+
+// hasKey returns true if the key exists in the input value.
+func (v *ObjectRuleSet[T, TK, TV]) hasKey(ctx context.Context, key TK, inValue reflect.Value, fromMap, fromSame bool) bool {
+	if fromMap {
+		return inValue.MapIndex(reflect.ValueOf(key)).IsValid()
+	}
+
+	if fromSame {
+		mapping, ok := v.mappingFor(ctx, key)
+		if !ok {
+			return false
+		}
+		return inValue.FieldByName(any(mapping).(string)).IsValid()
+	}
+
+	// We know this isn't a map so the only option for a key is a string
+	return inValue.FieldByName(any(key).(string)).IsValid()
+}
+
+// evaluateKeyGroups evaluates all the key group constraints against the input and returns any errors.
+// Errors are always for the object itself and not individual keys.
+func (v *ObjectRuleSet[T, TK, TV]) evaluateKeyGroups(ctx context.Context, inValue reflect.Value, fromMap, fromSame bool) errors.ValidationErrorCollection {
+	allErrors := errors.Collection()
+
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if len(currentRuleSet.atLeastOne) == 0 {
+			continue
+		}
+
+		found := false
+		for _, key := range currentRuleSet.atLeastOne {
+			if v.hasKey(ctx, key, inValue, fromMap, fromSame) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			names := make([]string, len(currentRuleSet.atLeastOne))
+			keys := make([]string, len(currentRuleSet.atLeastOne))
+			for i, key := range currentRuleSet.atLeastOne {
+				names[i] = toQuotedPath(key)
+				keys[i] = toPath(key)
+			}
+
+			err := errors.Errorf(
+				errors.CodeRequired, ctx, "at least one of %s is required", strings.Join(names, ", "),
+			)
+			allErrors = append(allErrors, errors.WithMeta(err, map[string]any{"keys": keys}))
+		}
+	}
+
+	return allErrors
+}
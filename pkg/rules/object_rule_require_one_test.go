@@ -0,0 +1,111 @@
+package rules_test
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Passes when any one of the keys is present.
+// - Returns a single required error at the object root when none are present.
+// - Error message lists all the keys in the group.
+// - Error metadata lists all the keys in the group.
+func TestWithRequireAtLeastOne(t *testing.T) {
+	ruleSet := rules.StringMap[any]().
+		WithKey("email", rules.String().Any()).
+		WithKey("phone", rules.String().Any()).
+		WithKey("name", rules.String().Any()).
+		WithRequireAtLeastOne("email", "phone")
+
+	testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{"email": "a@example.com"})
+	testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{"phone": "555-5555"})
+	testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{"email": "a@example.com", "phone": "555-5555"})
+
+	err := testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"name": "abc"}, errors.CodeRequired)
+	if err == nil {
+		return
+	}
+
+	errs := err.(errors.ValidationErrorCollection)
+	if len(errs) != 1 {
+		t.Errorf("Expected 1 error, got %d: %s", len(errs), errs)
+	} else if path := errs.First().Path(); path != "" {
+		t.Errorf("Expected path to be empty, got: %s", path)
+	} else if msg := errs.Error(); !strings.Contains(msg, `"email"`) || !strings.Contains(msg, `"phone"`) {
+		t.Errorf("Expected message to contain all keys, got: %s", msg)
+	} else if keys, _ := errors.MetaOf(errs.First())["keys"].([]string); !reflect.DeepEqual(keys, []string{"email", "phone"}) {
+		t.Errorf("Expected keys metadata to be [email phone], got: %v", errors.MetaOf(errs.First()))
+	}
+}
+
+// Requirements:
+// - Only presence is checked, not the value.
+func TestWithRequireAtLeastOneIgnoresValue(t *testing.T) {
+	ruleSet := rules.StringMap[any]().
+		WithKey("a", rules.String().WithMinLen(10).Any()).
+		WithRequireAtLeastOne("a")
+
+	err := testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"a": "x"}, errors.CodeMin)
+	if err != nil {
+		if l := len(err.(errors.ValidationErrorCollection)); l != 1 {
+			t.Errorf("Expected 1 error, got: %d", l)
+		}
+	}
+}
+
+// Requirements:
+// - Keys that are sorted into dynamic buckets are considered present.
+func TestWithRequireAtLeastOneDynamicBucket(t *testing.T) {
+	ruleSet := rules.StringMap[any]().
+		WithDynamicBucket(rules.String().WithRegexp(regexp.MustCompile("^x-"), ""), "extensions").
+		WithRequireAtLeastOne("x-one", "x-two")
+
+	testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{"x-two": 2})
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"x-three": 3}, errors.CodeRequired)
+}
+
+// Requirements:
+// - Error path points at the object and not the keys when nested.
+func TestWithRequireAtLeastOneNestedPath(t *testing.T) {
+	ruleSet := rules.StringMap[any]().
+		WithKey("inner", rules.StringMap[any]().WithKey("a", rules.String().Any()).WithRequireAtLeastOne("a").Any())
+
+	var out map[string]any
+	err := ruleSet.Apply(context.Background(), map[string]any{"inner": map[string]any{}}, &out)
+
+	if err == nil {
+		t.Error("Expected error to not be nil")
+	} else if path := err.First().Path(); path != "/inner" {
+		t.Errorf("Expected path to be /inner, got: %s", path)
+	}
+}
+
+// Requirements:
+// - Serializes to WithRequireAtLeastOne(...)
+func TestWithRequireAtLeastOneString(t *testing.T) {
+	ruleSet := rules.StringMap[any]().WithRequireAtLeastOne("a", "b")
+
+	expected := `WithRequireAtLeastOne("a", "b")`
+	if s := ruleSet.String(); !strings.HasSuffix(s, expected) {
+		t.Errorf("Expected rule set to end with %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Panics when no keys are provided.
+func TestWithRequireAtLeastOnePanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+
+	rules.StringMap[any]().WithRequireAtLeastOne()
+}
@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+)
+
+// WithRequiredIf returns a new RuleSet that requires the key to be present only if the condition is met.
+//
+// Unlike WithConditionalKey, this does not add or change any rules for the value of the key. Rule sets added with
+// WithKey are still evaluated whenever the key is present, and the key rule sets should not be required themselves
+// or the key will always be required.
+//
+// The condition is evaluated after all the keys so it sees the same output that conditional keys do. If the key is
+// missing, or the value is treated as missing by one of its rule sets, an errors.CodeRequired error is returned for
+// the key. Like other required keys, nothing is returned when WithPartial is set.
+//
+// If nil is passed in as the condition then the key is always required.
+//
+// This method will panic immediately if a circular dependency is detected or if the rule set is for a struct and
+// the key has no mapping.
+func (v *ObjectRuleSet[T, TK, TV]) WithRequiredIf(key TK, condition Conditional[T, TK]) *ObjectRuleSet[T, TK, TV] {
+	if v.outputType.Kind() != reflect.Map {
+		if _, ok := v.mappingFor(context.Background(), key); !ok {
+			panic(fmt.Errorf("missing mapping for key: %s", toPath(key)))
+		}
+	}
+
+	var empty TK
+	newRuleSet := v.withKeyHelper(Constant[TK](key), empty, condition, nil)
+	newRuleSet.requiredIf = true
+	newRuleSet.label = fmt.Sprintf("WithRequiredIf(%s, %s)", toQuotedPath(key), condition)
+	return newRuleSet
+}
+
+// keyAbsent returns true if the key is missing from the input or if any of the rule sets for the key treat the
+// value as missing.
+func (v *ObjectRuleSet[T, TK, TV]) keyAbsent(ctx context.Context, key TK, inValue reflect.Value, fromMap, fromSame bool) bool {
+	if !v.hasKey(ctx, key, inValue, fromMap, fromSame) {
+		return true
+	}
+
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if currentRuleSet.rule == nil {
+			continue
+		}
+		if c, ok := exactConstant(currentRuleSet.key); ok && c.Value() == key {
+			inFieldValue := v.keyValue(key, currentRuleSet, inValue, fromMap, fromSame)
+			if inFieldValue.IsValid() && isAbsent(currentRuleSet.rule, inFieldValue.Interface()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// evaluateRequiredIf evaluates the conditional requirements and returns an error for each missing key.
+// It must be called after all the key rules have been evaluated since the conditions are passed the output.
+func (v *ObjectRuleSet[T, TK, TV]) evaluateRequiredIf(ctx context.Context, out *T, inValue reflect.Value, fromMap, fromSame bool) errors.ValidationErrorCollection {
+	if v.partial {
+		return nil
+	}
+
+	allErrors := errors.Collection()
+	readOnly := v.readOnlyKeys()
+
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if !currentRuleSet.requiredIf {
+			continue
+		}
+
+		// Read only keys are never required
+		key := currentRuleSet.key.(*ConstantRuleSet[TK]).Value()
+		if _, ok := readOnly[key]; ok {
+			continue
+		}
+		if !v.keyAbsent(ctx, key, inValue, fromMap, fromSame) {
+			continue
+		}
+
+		if currentRuleSet.condition != nil && currentRuleSet.condition.Evaluate(ctx, *out) != nil {
+			continue
+		}
+
+		subContext := rulecontext.WithPathString(ctx, toPath(key))
+		allErrors = append(allErrors, errors.Errorf(errors.CodeRequired, subContext, "field is required"))
+	}
+
+	return allErrors
+}
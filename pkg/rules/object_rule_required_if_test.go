@@ -0,0 +1,76 @@
+package rules_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+type requiredIfTest struct {
+	Type string `validate:"type"`
+	Y    string `validate:"y"`
+}
+
+// Requirements:
+// - The key is required if the condition is met.
+// - The key is optional if the condition is not met.
+// - Value rules for the key are still evaluated.
+// - The error is for the key.
+// - Serializes to WithRequiredIf(...).
+func TestWithRequiredIf(t *testing.T) {
+	ruleSet := rules.Struct[*requiredIfTest]().
+		WithKey("type", rules.String().WithRequired().WithAllowedValues("X", "Y").Any()).
+		WithKey("y", rules.String().WithMinLen(2).Any()).
+		WithRequiredIf("y", rules.Struct[*requiredIfTest]().WithKey("type", rules.String().WithRequired().WithAllowedValues("Y").Any()))
+
+	var out *requiredIfTest
+	if err := ruleSet.Apply(context.Background(), map[string]any{"type": "Y", "y": "ok"}, &out); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	} else if out.Y != "ok" {
+		t.Errorf("Expected Y to be ok, got: %s", out.Y)
+	}
+
+	testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{"type": "X"})
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"type": "Y", "y": "!"}, errors.CodeMin)
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"type": "Y"}, errors.CodeRequired)
+	if err := ruleSet.Apply(context.Background(), map[string]any{"type": "Y"}, &out); len(err) != 1 || err[0].Path() != "/y" {
+		t.Errorf("Expected one error at /y, got: %s", err)
+	}
+
+	expected := `.WithRequiredIf("y", ObjectRuleSet[*rules_test.requiredIfTest].WithKey("type", StringRuleSet.WithRequired().WithAllowedValues("Y").Any()))`
+	if s := ruleSet.String(); !strings.HasSuffix(s, expected) {
+		t.Errorf("Expected rule set to end with %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Values treated as missing by the key rule set are also required.
+// - WithPartial skips the requirement.
+// - A nil condition always requires the key.
+func TestWithRequiredIf_Absent(t *testing.T) {
+	ruleSet := rules.StringMap[any]().
+		WithKey("y", rules.String().WithEmptyAsNil().Any()).
+		WithRequiredIf("y", nil)
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{}, errors.CodeRequired)
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"y": ""}, errors.CodeRequired)
+	testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{"y": "a"})
+	testhelpers.MustApplyAny(t, ruleSet.WithPartial().Any(), map[string]any{})
+}
+
+// Requirements:
+// - Panics if the struct has no mapping for the key.
+func TestWithRequiredIf_MissingMapping(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic")
+		}
+	}()
+
+	rules.Struct[*requiredIfTest]().WithRequiredIf("z", nil)
+}
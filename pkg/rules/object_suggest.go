@@ -0,0 +1,95 @@
+package rules
+
+// WithKeySuggestions returns a new RuleSet that suggests the closest declared key for each unknown key.
+//
+// The suggestion is found by comparing the unknown key to the constant keys returned by Keys using the Levenshtein
+// distance. Keys that are too different are not suggested, so "usernmae" suggests "username" but "color" does not
+// suggest "name". The suggestion is added to the unknown key error as the "suggestion" metadata, which can be read
+// with errors.MetaOf. When used with WithCollapsedUnknownErrors, the "suggestions" metadata maps each unknown key
+// that has a suggestion to its suggestion.
+//
+// Computing the distances has a cost for every unknown key so this is opt in. It has no effect if unknown keys are
+// allowed.
+func (v *ObjectRuleSet[T, TK, TV]) WithKeySuggestions() *ObjectRuleSet[T, TK, TV] {
+	if v.suggestKeys {
+		return v
+	}
+
+	newRuleSet := v.withParent()
+	newRuleSet.suggestKeys = true
+	newRuleSet.label = "WithKeySuggestions()"
+	return newRuleSet
+}
+
+// suggestionCandidates returns the paths of the declared constant keys or nil if suggestions are disabled.
+func (v *ObjectRuleSet[T, TK, TV]) suggestionCandidates() []string {
+	if !v.suggestKeys {
+		return nil
+	}
+
+	keys := v.Keys()
+	candidates := make([]string, len(keys))
+	for i, key := range keys {
+		candidates[i] = toPath(key)
+	}
+	return candidates
+}
+
+// nearestKey returns the candidate with the smallest edit distance to the key and true, or false if no candidate is
+// close enough. A candidate is close enough if the distance is at most a third of the length of the key (and at
+// least 2) and smaller than the length of both strings. Ties go to the candidate that comes first.
+func nearestKey(key string, candidates []string) (string, bool) {
+	if len(candidates) == 0 {
+		return "", false
+	}
+
+	keyLen := len([]rune(key))
+	limit := keyLen / 3
+	if limit < 2 {
+		limit = 2
+	}
+
+	best := ""
+	bestDistance := -1
+
+	for _, candidate := range candidates {
+		distance := levenshtein(key, candidate)
+		if distance == 0 || distance > limit || distance >= keyLen || distance >= len([]rune(candidate)) {
+			continue
+		}
+		if bestDistance < 0 || distance < bestDistance {
+			best = candidate
+			bestDistance = distance
+		}
+	}
+
+	return best, bestDistance >= 0
+}
+
+// levenshtein returns the number of single character insertions, deletions and substitutions needed to change a
+// into b. Characters are compared as runes.
+func levenshtein(a, b string) int {
+	ra := []rune(a)
+	rb := []rune(b)
+
+	// Only two rows of the matrix are needed at a time.
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
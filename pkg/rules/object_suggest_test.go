@@ -0,0 +1,70 @@
+package rules_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// Requirements:
+// - The closest declared key is added to the unknown key error as a suggestion.
+// - Keys that are too different have no suggestion.
+// - Suggestions are off by default.
+// - Serializes to WithKeySuggestions().
+func TestWithKeySuggestions(t *testing.T) {
+	ruleSet := rules.StringMap[any]().
+		WithKey("username", rules.String().Any()).
+		WithKey("name", rules.String().Any()).
+		WithKeySuggestions()
+
+	var out map[string]any
+	err := ruleSet.Apply(context.Background(), map[string]any{"usernmae": "a"}, &out)
+	if len(err) != 1 || err[0].Code() != errors.CodeUnexpected {
+		t.Fatalf("Expected one unexpected error, got: %s", err)
+	}
+	if s := errors.MetaOf(err[0])["suggestion"]; s != "username" {
+		t.Errorf("Expected suggestion to be username, got: %v", s)
+	}
+
+	err = ruleSet.Apply(context.Background(), map[string]any{"color": "a"}, &out)
+	if len(err) != 1 || errors.MetaOf(err[0]) != nil {
+		t.Errorf("Expected one error without a suggestion, got: %s %v", err, errors.MetaOf(err.First()))
+	}
+
+	err = rules.StringMap[any]().WithKey("username", rules.String().Any()).Apply(context.Background(), map[string]any{"usernmae": "a"}, &out)
+	if len(err) != 1 || errors.MetaOf(err[0]) != nil {
+		t.Errorf("Expected one error without a suggestion by default, got: %s", err)
+	}
+
+	if ruleSet.WithKeySuggestions() != ruleSet {
+		t.Error("Expected WithKeySuggestions to return the same rule set")
+	}
+
+	if s := ruleSet.String(); !strings.HasSuffix(s, ".WithKeySuggestions()") {
+		t.Errorf("Expected rule set to end with WithKeySuggestions(), got %s", s)
+	}
+}
+
+// Requirements:
+// - Collapsed errors list the suggestions for each unknown key.
+func TestWithKeySuggestions_Collapsed(t *testing.T) {
+	ruleSet := rules.StringMap[any]().
+		WithKey("username", rules.String().Any()).
+		WithKey("email", rules.String().Any()).
+		WithKeySuggestions().
+		WithCollapsedUnknownErrors()
+
+	var out map[string]any
+	err := ruleSet.Apply(context.Background(), map[string]any{"usernmae": "a", "emial": "b", "color": "c"}, &out)
+	if len(err) != 1 {
+		t.Fatalf("Expected one error, got: %s", err)
+	}
+
+	suggestions, _ := errors.MetaOf(err[0])["suggestions"].(map[string]string)
+	if len(suggestions) != 2 || suggestions["usernmae"] != "username" || suggestions["emial"] != "email" {
+		t.Errorf("Expected suggestions for usernmae and emial, got: %v", suggestions)
+	}
+}
@@ -0,0 +1,76 @@
+package rules
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// WithTagRules returns a new RuleSet that treats the key in each "validate" annotation as the name of a rule set
+// added with Register. For every annotated field with a registered key, the rule set is added as if WithKey had been
+// called:
+//
+//	rules.Register("email", net.Email().Any())
+//
+//	type User struct {
+//		Email string `validate:"email"`
+//	}
+//
+//	ruleSet := rules.Struct[User]().WithTagRules()
+//
+// Keys without a registered rule set are only used as mappings. Fields without an annotation are not affected.
+//
+// Calling WithKey for a key afterwards replaces the rule set added from the annotation. Rule sets named in the "rules"
+// annotation are always kept. See Struct for details.
+//
+// This method panics if the rule set is not for a struct.
+func (v *ObjectRuleSet[T, TK, TV]) WithTagRules() *ObjectRuleSet[T, TK, TV] {
+	if v.outputType.Kind() == reflect.Map {
+		panic(fmt.Errorf("tag rules can only be used with structs"))
+	}
+	if v.tagRules {
+		return v
+	}
+
+	newRuleSet := v.withParent()
+	newRuleSet.tagRules = true
+	newRuleSet.label = "WithTagRules()"
+
+	for _, m := range structMappings(v.outputType) {
+		if !m.tagged {
+			continue
+		}
+		registered, ok := Registered(m.key)
+		if !ok {
+			continue
+		}
+
+		// Struct targets always have string as the key and any as the value
+		newRuleSet = newRuleSet.WithKey(any(m.key).(TK), any(registered).(RuleSet[TV]))
+		newRuleSet.tagRule = true
+	}
+
+	return newRuleSet
+}
+
+// withoutTagRule returns the rule set with the rule sets added by WithTagRules for the key removed.
+// Does not mutate the existing rule sets.
+func (v *ObjectRuleSet[T, TK, TV]) withoutTagRule(key TK) *ObjectRuleSet[T, TK, TV] {
+	if v == nil {
+		return nil
+	}
+
+	if v.tagRule {
+		if c, ok := exactConstant(v.key); ok && c.Value() == key {
+			return v.parent.withoutTagRule(key)
+		}
+	}
+
+	newParent := v.parent.withoutTagRule(key)
+	if newParent == v.parent {
+		return v
+	}
+
+	newRuleSet := *v
+	newRuleSet.parent = newParent
+	return &newRuleSet
+}
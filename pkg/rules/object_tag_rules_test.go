@@ -0,0 +1,67 @@
+package rules_test
+
+import (
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/rules/net"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+func init() {
+	rules.Register("email", net.Email().Any())
+}
+
+type tagRulesStruct struct {
+	Email string `validate:"email"`
+	Name  string `validate:"name"`
+	Other string
+}
+
+// Requirements:
+// - Tagged fields are validated by the registered rule set with the same name.
+// - Tags without a registered rule set are only mappings.
+// - Without WithTagRules the tag is only a mapping.
+// - WithKey replaces the rule set from the tag.
+// - Serializes to WithTagRules().
+func TestWithTagRules(t *testing.T) {
+	ruleSet := rules.Struct[tagRulesStruct]().WithTagRules()
+
+	testhelpers.MustApplyMutation(t, ruleSet.Any(), map[string]any{"email": "user@example.com"}, tagRulesStruct{Email: "user@example.com"})
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"email": "not an email"}, errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"name": "x"}, errors.CodeUnexpected)
+	testhelpers.MustNotApply(t, rules.Struct[tagRulesStruct]().Any(), map[string]any{"email": "user@example.com"}, errors.CodeUnexpected)
+
+	override := ruleSet.WithKey("email", rules.String().WithMaxLen(5).Any())
+	testhelpers.MustApplyMutation(t, override.Any(), map[string]any{"email": "x"}, tagRulesStruct{Email: "x"})
+	testhelpers.MustNotApply(t, override.Any(), map[string]any{"email": "user@example.com"}, errors.CodeMax)
+
+	if keys := ruleSet.Keys(); len(keys) != 1 || keys[0] != "email" {
+		t.Errorf("Expected keys to be [email], got: %v", keys)
+	}
+	if keys := override.Keys(); len(keys) != 1 || keys[0] != "email" {
+		t.Errorf("Expected keys to be [email], got: %v", keys)
+	}
+
+	expected := "ObjectRuleSet[rules_test.tagRulesStruct].WithTagRules()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+
+	if ruleSet.WithTagRules() != ruleSet {
+		t.Error("Expected WithTagRules to return the same rule set when already set")
+	}
+}
+
+// Requirements:
+// - Panics for maps.
+func TestWithTagRulesMap(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic")
+		}
+	}()
+
+	rules.StringMap[any]().WithTagRules()
+}
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"regexp"
 	stringsHelper "strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -629,6 +630,48 @@ func TestReturnsAllErrors(t *testing.T) {
 	} else if len(err) != 2 {
 		t.Errorf("Expected 2 errors got %d: %s", len(err), err.Error())
 	}
+
+	if e := err.FirstWithCode(errors.CodeMax); e == nil || e.Path() != "/A" {
+		t.Errorf("Expected a max error for /A, got: %v", e)
+	}
+	if e := err.FirstWithCode(errors.CodeType); e == nil || e.Path() != "/C" {
+		t.Errorf("Expected a type error for /C, got: %v", e)
+	}
+	if err.ContainsCode(errors.CodeRequired) {
+		t.Errorf("Expected no required errors, got: %s", err)
+	}
+}
+
+// Requirements:
+// - FirstPerPath keeps one error for each key when a key has more than one error.
+func TestReturnsAllErrors_FirstPerPath(t *testing.T) {
+	var out map[string]any
+
+	err := rules.StringMap[any]().
+		WithKey("A", rules.Int().WithMax(2).WithRuleFunc(func(ctx context.Context, _ int) errors.ValidationErrorCollection {
+			return errors.Collection(errors.Errorf(errors.CodePattern, ctx, "value must be even"))
+		}).Any()).
+		WithKey("B", rules.Int().Any()).
+		WithKey("C", rules.String().WithStrict().Any()).
+		Apply(context.TODO(), map[string]any{"A": 123, "B": 456, "C": 789}, &out)
+
+	if err == nil {
+		t.Fatal("Expected errors to not be nil")
+	} else if len(err) != 3 {
+		t.Fatalf("Expected 3 errors got %d: %s", len(err), err.Error())
+	}
+
+	first := err.FirstPerPath()
+	if len(first) != 2 {
+		t.Fatalf("Expected 2 errors got %d: %s", len(first), first.Error())
+	}
+
+	if a := first.For("/A"); len(a) != 1 {
+		t.Errorf("Expected 1 error for /A, got: %d", len(a))
+	}
+	if c := first.For("/C"); len(c) != 1 {
+		t.Errorf("Expected 1 error for /C, got: %d", len(c))
+	}
 }
 
 func TestObjectReturnsCorrectPaths(t *testing.T) {
@@ -670,6 +713,45 @@ func TestObjectReturnsCorrectPaths(t *testing.T) {
 	}
 }
 
+// Requirements:
+// - Errors for slice items inside of maps include the index in the path.
+// - For returns exactly the error for the index.
+func TestObjectSliceReturnsCorrectPaths(t *testing.T) {
+	ctx := rulecontext.WithPathString(context.Background(), "data")
+
+	// Prepare the output variable for Apply
+	var out map[string][]int
+
+	err := rules.StringMap[[]int]().
+		WithKey("tags", rules.Slice[int]().WithItemRuleSet(rules.Int().WithMax(5))).
+		WithKey("ids", rules.Slice[int]()).
+		Apply(ctx, map[string]any{"tags": []any{1, 2, 30}, "ids": []any{1, "x"}}, &out)
+
+	if err == nil {
+		t.Fatalf("Expected errors to not be nil")
+	} else if len(err) != 2 {
+		t.Fatalf("Expected 2 errors got %d: %s", len(err), err.Error())
+	}
+
+	errTag := err.For("/data/tags/2")
+	if errTag == nil {
+		t.Errorf("Expected error for /data/tags/2 to not be nil")
+	} else if len(errTag) != 1 {
+		t.Errorf("Expected exactly 1 error for /data/tags/2 got %d: %s", len(errTag), errTag)
+	} else if errTag.First().Path() != "/data/tags/2" {
+		t.Errorf("Expected error path to be `%s` got `%s`", "/data/tags/2", errTag.First().Path())
+	}
+
+	errId := err.For("/data/ids/1")
+	if errId == nil {
+		t.Errorf("Expected error for /data/ids/1 to not be nil")
+	} else if len(errId) != 1 {
+		t.Errorf("Expected exactly 1 error for /data/ids/1 got %d: %s", len(errId), errId)
+	} else if errId.First().Path() != "/data/ids/1" {
+		t.Errorf("Expected error path to be `%s` got `%s`", "/data/ids/1", errId.First().Path())
+	}
+}
+
 func TestMixedMap(t *testing.T) {
 	// Prepare the output variable for Apply
 	var out map[string]any
@@ -890,6 +972,68 @@ func TestTimeoutInObjectRule(t *testing.T) {
 	}
 }
 
+// Requirements:
+// - WithTimeout times out even if the caller context has no deadline.
+// - A sooner caller deadline is still respected.
+// - Rule sets that finish in time are unaffected.
+// - Serializes to WithTimeout(...).
+// - Panics if the timeout is not positive.
+func TestWithTimeout(t *testing.T) {
+	slow := func(ctx context.Context, x int) errors.ValidationErrorCollection {
+		select {
+		case <-ctx.Done():
+		case <-time.After(1 * time.Second):
+		}
+		return nil
+	}
+
+	ruleSet := rules.Struct[*testStruct]().
+		WithKey("X", rules.Int().WithRuleFunc(slow).Any()).
+		WithTimeout(50 * time.Millisecond)
+
+	var out *testStruct
+
+	start := time.Now()
+	errs := ruleSet.Apply(context.Background(), &testStruct{}, &out)
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected timeout to end validation early, took %s", elapsed)
+	}
+	if len(errs) != 1 {
+		t.Errorf("Expected 1 error, got %d: %s", len(errs), errs)
+	} else if c := errs.First().Code(); c != errors.CodeTimeout {
+		t.Errorf("Expected error to be %s, got %s (%s)", errors.CodeTimeout, c, errs.First())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start = time.Now()
+	errs = ruleSet.WithTimeout(10*time.Second).Apply(ctx, &testStruct{}, &out)
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected caller deadline to end validation early, took %s", elapsed)
+	}
+	if len(errs) != 1 || errs.First().Code() != errors.CodeTimeout {
+		t.Errorf("Expected a timeout error, got: %s", errs)
+	}
+
+	fast := rules.Struct[*testStruct]().WithKey("X", rules.Int().Any()).WithTimeout(time.Second)
+	if errs := fast.Apply(context.Background(), &testStruct{X: 1}, &out); errs != nil {
+		t.Errorf("Expected errors to be nil, got: %s", errs)
+	}
+
+	expected := "WithTimeout(50ms)"
+	if s := ruleSet.String(); !stringsHelper.HasSuffix(s, expected) {
+		t.Errorf("Expected rule set to end with %s, got %s", expected, s)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic")
+		}
+	}()
+	ruleSet.WithTimeout(0)
+}
+
 // Requirement:
 // This test is specifically for a timeout while performing an key rule (as opposed to an object rule)
 // - RuleSet times out if context does
@@ -1543,6 +1687,44 @@ func TestWithDynamicKeyToMap(t *testing.T) {
 	testhelpers.MustApplyAny(t, ruleSet.Any(), validJson)
 }
 
+// Requirements:
+// - The value rule set is applied to every key without an explicit rule set.
+// - Keys with an explicit rule set are not passed to the value rule set.
+// - Keys covered by the value rule set are known.
+// - Only the most recent value rule set is used.
+// - Serializes to WithValueRuleSet(...).
+func TestWithValueRuleSet(t *testing.T) {
+	ruleSet := rules.StringMap[int]().
+		WithKey("id", rules.Int().WithMin(100)).
+		WithValueRuleSet(rules.Int().WithMin(100)).
+		WithValueRuleSet(rules.Int().WithMax(10))
+
+	var out map[string]int
+	err := ruleSet.Apply(context.Background(), map[string]any{"id": 500, "a": 1, "b": "2"}, &out)
+	if err != nil {
+		t.Fatalf("Expected errors to be nil, got: %s", err)
+	}
+	if out["id"] != 500 || out["a"] != 1 || out["b"] != 2 {
+		t.Errorf("Expected output to be {id: 500, a: 1, b: 2}, got: %v", out)
+	}
+
+	err = ruleSet.Apply(context.Background(), map[string]any{"id": 1, "a": 50}, &out)
+	if len(err) != 2 {
+		t.Fatalf("Expected 2 errors, got: %d (%s)", len(err), err)
+	}
+	if idErr := err.For("/id"); len(idErr) != 1 || idErr[0].Code() != errors.CodeMin {
+		t.Errorf("Expected a min error at /id, got: %s", idErr)
+	}
+	if aErr := err.For("/a"); len(aErr) != 1 || aErr[0].Code() != errors.CodeMax {
+		t.Errorf("Expected a max error at /a, got: %s", aErr)
+	}
+
+	expected := `.WithValueRuleSet(IntRuleSet[int].WithMin(100)).WithValueRuleSet(IntRuleSet[int].WithMax(10))`
+	if s := ruleSet.String(); !stringsHelper.HasSuffix(s, expected) {
+		t.Errorf("Expected rule set to end with %s, got %s", expected, s)
+	}
+}
+
 // Requirements:
 // - Keys in dynamic buckets are not considered "unknown"
 // - Value is copied into all matching buckets
@@ -1884,19 +2066,69 @@ func TestDynamicKeyAsConditionalDependency(t *testing.T) {
 }
 
 // Requirements:
-// - Ref tracker should panic if you try to use a dynamic key in a conditional.
-// In the future we may change this behavior but for now it would complicate the code to much.
+// - Conditional rules that depend on a dynamic key are not run until every key matching it is evaluated.
+//
+// Like TestDynamicKeyAsConditionalDependency the dynamic key must match more than one input key to test the
+// reference counting.
 func TestDynamicKeyAsDependentConditional(t *testing.T) {
-	defer func() {
-		if r := recover(); r == nil {
-			t.Errorf("Expected panic")
+	var callCount int32 = 0
+
+	valueRule := rules.Any().WithRuleFunc(func(ctx context.Context, _ any) errors.ValidationErrorCollection {
+		time.Sleep(100 * time.Millisecond)
+		atomic.AddInt32(&callCount, 1)
+		return nil
+	})
+
+	finalValueRule := rules.Any().WithRuleFunc(func(ctx context.Context, _ any) errors.ValidationErrorCollection {
+		if count := atomic.LoadInt32(&callCount); count != 2 {
+			return errors.Collection(errors.Errorf(errors.CodeCancelled, ctx, "Expected count of %d, got %d", 2, count))
 		}
-	}()
+		return nil
+	})
 
 	keyRule := rules.String().WithRegexp(regexp.MustCompile("^__"), "")
 
-	rules.StringMap[any]().
-		WithConditionalKey("__xyz", rules.StringMap[any]().WithUnknown().WithDynamicKey(keyRule, rules.Any()), rules.Any())
+	ruleSet := rules.StringMap[any]().
+		WithJson().
+		WithDynamicKey(keyRule, valueRule).
+		WithConditionalKey("xyz", rules.StringMap[any]().WithUnknown().WithDynamicKey(keyRule, rules.Any()), finalValueRule)
+
+	testhelpers.MustApplyAny(t, ruleSet.Any(), `{"__abc": "abc", "__def": "def", "xyz": "xyz"}`)
+}
+
+// Requirements:
+// - Panics if a conditional key depends on a dynamic key that matches it.
+// - Panics if a key matching the dynamic key depends on the conditional key.
+// - Panics if a dynamic key depends on a dynamic key.
+func TestDynamicKeyAsDependentConditionalCycle(t *testing.T) {
+	keyRule := rules.String().WithRegexp(regexp.MustCompile("^__"), "")
+	dependsOnDynamic := rules.StringMap[any]().WithUnknown().WithDynamicKey(keyRule, rules.Any())
+
+	cases := map[string]func(){
+		"self": func() {
+			rules.StringMap[any]().WithConditionalKey("__xyz", dependsOnDynamic, rules.Any())
+		},
+		"constant": func() {
+			rules.StringMap[any]().
+				WithConditionalKey("__abc", rules.StringMap[any]().WithUnknown().WithKey("xyz", rules.Any()), rules.Any()).
+				WithConditionalKey("xyz", dependsOnDynamic, rules.Any())
+		},
+		"dynamic": func() {
+			rules.StringMap[any]().
+				WithConditionalDynamicKey(rules.String().WithRegexp(regexp.MustCompile("^x"), ""), dependsOnDynamic, rules.Any())
+		},
+	}
+
+	for name, fn := range cases {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Expected panic for %s", name)
+				}
+			}()
+			fn()
+		}()
+	}
 }
 
 // Bug: Passing a non-string into a Rule Set that supports Json deserialization results in empty output.
@@ -1963,3 +2195,912 @@ func TestQueryStringInput(t *testing.T) {
 		t.Errorf(`Expected "abc" to exist in output and have length 1`)
 	}
 }
+
+type testStructWide struct {
+	F01 int    `validate:"f01"`
+	F02 int    `validate:"f02"`
+	F03 int    `validate:"f03"`
+	F04 int    `validate:"f04"`
+	F05 int    `validate:"f05"`
+	F06 string `validate:"f06"`
+	F07 string `validate:"f07"`
+	F08 string `validate:"f08"`
+	F09 string `validate:"f09"`
+	F10 string `validate:"f10"`
+	F11 bool
+	F12 bool
+	F13 bool
+	F14 bool
+	F15 bool
+	F16 float64 `validate:"f16"`
+	F17 float64 `validate:"f17"`
+	F18 float64 `validate:"f18"`
+	F19 float64 `validate:"f19"`
+	F20 float64 `validate:""`
+}
+
+// Requirements:
+// - Repeated calls to Struct for the same type produce identical rule sets.
+// - Cached mappings are still used to write to the correct fields.
+func TestStructCachedMappings(t *testing.T) {
+	first := rules.Struct[testStructMapped]()
+	second := rules.Struct[testStructMapped]()
+
+	if first.String() != second.String() {
+		t.Errorf("Expected rule sets to be equal, got: %s and %s", first, second)
+	}
+
+	for i := 0; i < 2; i++ {
+		var out testStructMapped
+		err := rules.Struct[testStructMapped]().
+			WithKey("A", rules.Int().Any()).
+			WithKey("C", rules.Int().Any()).
+			Apply(context.Background(), map[string]any{"A": 1, "C": 2}, &out)
+
+		if err != nil {
+			t.Fatalf("Expected errors to be empty, got: %s", err)
+		}
+		if out.A != 1 || out.B != 2 || out.C != 0 {
+			t.Errorf("Expected A=1, B=2 and C=0, got: %+v", out)
+		}
+	}
+}
+
+func BenchmarkStruct(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		rules.Struct[testStructWide]()
+	}
+}
+
+// Requirements:
+// - Keys must match every rule of a string key rule set, not just the regular expressions.
+// - Keys that do not match are unknown.
+func TestWithDynamicKeyRegexpKeyRule(t *testing.T) {
+	keyRule := rules.String().WithRegexpString("^x_", "").WithMaxLen(5)
+	ruleSet := rules.StringMap[int]().WithDynamicKey(keyRule, rules.Int())
+
+	testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{"x_a": 1, "x_b": 2})
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"x_long": 1}, errors.CodeUnexpected)
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"y_a": 1}, errors.CodeUnexpected)
+}
+
+// BenchmarkDynamicKeys measures matching 500 input keys against 50 dynamic key rules.
+func BenchmarkDynamicKeys(b *testing.B) {
+	ruleSet := rules.StringMap[int]()
+	for i := 0; i < 50; i++ {
+		keyRule := rules.String().WithRegexpString(fmt.Sprintf("^k%02d_[0-9]+$", i), "")
+		ruleSet = ruleSet.WithDynamicKey(keyRule, rules.Int().WithMax(1000))
+	}
+
+	input := make(map[string]any)
+	for i := 0; i < 500; i++ {
+		input[fmt.Sprintf("k%02d_%d", i%50, i)] = i
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var out map[string]int
+		if err := ruleSet.Apply(context.Background(), input, &out); err != nil {
+			b.Fatalf("Expected errors to be empty, got: %s", err)
+		}
+	}
+}
+
+// BenchmarkStructErrors measures the allocations for a struct where every key returns an error.
+func BenchmarkStructErrors(b *testing.B) {
+	ruleSet := rules.Struct[testStructWide]()
+	input := make(map[string]any)
+	for i := 1; i <= 5; i++ {
+		key := fmt.Sprintf("f%02d", i)
+		ruleSet = ruleSet.WithKey(key, rules.Int().WithMin(0).WithMax(100).Any())
+		input[key] = 500
+	}
+	for i := 6; i <= 10; i++ {
+		key := fmt.Sprintf("f%02d", i)
+		ruleSet = ruleSet.WithKey(key, rules.String().WithMaxLen(3).Any())
+		input[key] = "too long"
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var out testStructWide
+		if err := ruleSet.Apply(context.Background(), input, &out); len(err) != 10 {
+			b.Fatalf("Expected 10 errors, got: %d", len(err))
+		}
+	}
+}
+
+// Requirements:
+// - WithConcurrencyLimit limits the number of keys evaluated at the same time.
+// - All keys are still evaluated.
+func TestWithConcurrencyLimit(t *testing.T) {
+	var running int32
+	var maxRunning int32
+	var calls int32
+
+	intRule := func(_ context.Context, x int) errors.ValidationErrorCollection {
+		current := atomic.AddInt32(&running, 1)
+		for {
+			seen := atomic.LoadInt32(&maxRunning)
+			if current <= seen || atomic.CompareAndSwapInt32(&maxRunning, seen, current) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		atomic.AddInt32(&calls, 1)
+		return nil
+	}
+
+	ruleSet := rules.StringMap[int]().
+		WithDynamicKey(rules.String(), rules.Int().WithRuleFunc(intRule)).
+		WithConcurrencyLimit(3)
+
+	in := make(map[string]any)
+	for i := 0; i < 50; i++ {
+		in[fmt.Sprintf("key%d", i)] = i
+	}
+
+	var out map[string]int
+	if err := ruleSet.Apply(context.Background(), in, &out); err != nil {
+		t.Fatalf("Expected errors to be empty, got: %s", err)
+	}
+
+	if calls != 50 {
+		t.Errorf("Expected 50 calls, got: %d", calls)
+	}
+	if maxRunning > 3 {
+		t.Errorf("Expected at most 3 keys to run at once, got: %d", maxRunning)
+	}
+	if len(out) != 50 {
+		t.Errorf("Expected 50 keys in the output, got: %d", len(out))
+	}
+}
+
+// Requirements:
+// - Conditional keys are evaluated after the keys they depend on when the concurrency is limited.
+// - A limit of 1 does not deadlock when conditional keys are declared before their dependencies.
+func TestWithConcurrencyLimitConditionalKey(t *testing.T) {
+	var order []string
+	record := func(key string) func(context.Context, int) errors.ValidationErrorCollection {
+		return func(_ context.Context, _ int) errors.ValidationErrorCollection {
+			order = append(order, key)
+			return nil
+		}
+	}
+
+	condB := rules.StringMap[int]().
+		WithUnknown().
+		WithKey("B", rules.Int().WithMin(4))
+
+	condC := rules.StringMap[int]().
+		WithUnknown().
+		WithKey("C", rules.Int().WithMin(4))
+
+	ruleSet := rules.StringMap[int]().
+		WithConditionalKey("A", condB, rules.Int().WithRuleFunc(record("A"))).
+		WithConditionalKey("B", condC, rules.Int().WithRuleFunc(record("B"))).
+		WithKey("C", rules.Int().WithRuleFunc(record("C"))).
+		WithConcurrencyLimit(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	var out map[string]int
+	if err := ruleSet.Apply(ctx, map[string]any{"A": 5, "B": 5, "C": 5}, &out); err != nil {
+		t.Fatalf("Expected errors to be empty, got: %s", err)
+	}
+
+	if stringsHelper.Join(order, ",") != "C,B,A" {
+		t.Errorf("Expected keys to be evaluated in the order C,B,A, got: %v", order)
+	}
+}
+
+// Requirements:
+// - WithConcurrencyLimit panics if the limit is less than 1.
+// - WithConcurrencyLimit serializes to a string.
+func TestWithConcurrencyLimitString(t *testing.T) {
+	ruleSet := rules.Struct[*testStruct]().WithConcurrencyLimit(2)
+
+	expected := "ObjectRuleSet[*rules_test.testStruct].WithConcurrencyLimit(2)"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+
+	rules.StringMap[int]().WithConcurrencyLimit(0)
+}
+
+// Requirements:
+// - WithSequential evaluates keys in the order they were declared.
+// - Dynamic keys are evaluated in sorted order.
+// - Conditional keys are evaluated after the keys they depend on.
+// - Object rules are evaluated after keys in the order they were added.
+func TestWithSequential(t *testing.T) {
+	var order []string
+	record := func(key string) func(context.Context, int) errors.ValidationErrorCollection {
+		return func(_ context.Context, _ int) errors.ValidationErrorCollection {
+			order = append(order, key)
+			return nil
+		}
+	}
+	recordObj := func(name string) func(context.Context, map[string]int) errors.ValidationErrorCollection {
+		return func(_ context.Context, _ map[string]int) errors.ValidationErrorCollection {
+			order = append(order, name)
+			return nil
+		}
+	}
+
+	condB := rules.StringMap[int]().
+		WithUnknown().
+		WithKey("B", rules.Int().WithMin(4))
+
+	ruleSet := rules.StringMap[int]().
+		WithSequential().
+		WithConditionalKey("A", condB, rules.Int().WithRuleFunc(record("A"))).
+		WithKey("B", rules.Int().WithRuleFunc(record("B"))).
+		WithDynamicKey(rules.String().WithRegexp(regexp.MustCompile("^x"), ""), rules.Int().WithRuleFunc(record("x"))).
+		WithRuleFunc(recordObj("obj1")).
+		WithKey("C", rules.Int().WithRuleFunc(record("C"))).
+		WithRuleFunc(recordObj("obj2"))
+
+	in := map[string]any{"A": 5, "B": 5, "C": 5, "x1": 1, "x2": 2, "x3": 3}
+
+	for i := 0; i < 5; i++ {
+		order = nil
+
+		var out map[string]int
+		if err := ruleSet.Apply(context.Background(), in, &out); err != nil {
+			t.Fatalf("Expected errors to be empty, got: %s", err)
+		}
+
+		expected := "B,A,x,x,x,C,obj1,obj2"
+		if actual := stringsHelper.Join(order, ","); actual != expected {
+			t.Fatalf("Expected evaluation order to be %s, got: %s", expected, actual)
+		}
+	}
+}
+
+// Requirements:
+// - Errors are returned in a stable order when WithSequential is set.
+func TestWithSequentialErrorOrder(t *testing.T) {
+	ruleSet := rules.StringMap[int]().
+		WithSequential().
+		WithKey("B", rules.Int().WithMin(10)).
+		WithKey("A", rules.Int().WithMin(10)).
+		WithDynamicKey(rules.String().WithRegexp(regexp.MustCompile("^x"), ""), rules.Int().WithMin(10))
+
+	in := map[string]any{"A": 1, "B": 1, "x2": 1, "x1": 1, "z": 1, "y": 1}
+
+	for i := 0; i < 5; i++ {
+		var out map[string]int
+		err := ruleSet.Apply(context.Background(), in, &out)
+		if err == nil {
+			t.Fatalf("Expected errors to not be empty")
+		}
+
+		var paths []string
+		for _, e := range err {
+			paths = append(paths, e.Path())
+		}
+
+		expected := "/y,/z,/B,/A,/x1,/x2"
+		if actual := stringsHelper.Join(paths, ","); actual != expected {
+			t.Fatalf("Expected error order to be %s, got: %s", expected, actual)
+		}
+	}
+}
+
+// Requirements:
+// - WithSequential stops evaluating rules when the context is cancelled.
+func TestWithSequentialCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls int32
+	cancelRule := func(_ context.Context, _ int) errors.ValidationErrorCollection {
+		atomic.AddInt32(&calls, 1)
+		cancel()
+		return nil
+	}
+
+	ruleSet := rules.StringMap[int]().
+		WithSequential().
+		WithKey("A", rules.Int().WithRuleFunc(cancelRule)).
+		WithKey("B", rules.Int().WithRuleFunc(cancelRule))
+
+	var out map[string]int
+	err := ruleSet.Apply(ctx, map[string]any{"A": 1, "B": 1}, &out)
+	if err == nil {
+		t.Fatalf("Expected errors to not be empty")
+	}
+
+	if calls != 1 {
+		t.Errorf("Expected 1 call, got: %d", calls)
+	}
+	if err.First().Code() != errors.CodeCancelled {
+		t.Errorf("Expected error code to be %s, got: %s", errors.CodeCancelled, err.First().Code())
+	}
+}
+
+// Requirements:
+// - Serializes to WithSequential()
+func TestWithSequentialString(t *testing.T) {
+	ruleSet := rules.Struct[*testStruct]().WithSequential()
+
+	expected := "ObjectRuleSet[*rules_test.testStruct].WithSequential()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Conditional dynamic keys are evaluated when the condition is met.
+// - Conditional dynamic keys are not evaluated when the condition is not met.
+// - If the only dynamic rules are conditional, the key is unknown if no conditions match.
+func TestWithConditionalDynamicKey(t *testing.T) {
+	condition := rules.StringMap[any]().
+		WithUnknown().
+		WithKey("type", rules.Constant[any]("extended"))
+
+	ruleSet := rules.StringMap[any]().
+		WithKey("type", rules.String().Any()).
+		WithConditionalDynamicKey(
+			rules.String().WithRegexp(regexp.MustCompile("^x-"), ""),
+			condition,
+			rules.Int().WithMax(10).Any(),
+		)
+
+	testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{"type": "extended", "x-a": 5})
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"type": "extended", "x-a": 50}, errors.CodeMax)
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"type": "basic", "x-a": 5}, errors.CodeUnexpected)
+	testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{"type": "basic"})
+
+	// With unknown keys allowed the value is passed through without being validated
+	testhelpers.MustApplyAny(t, ruleSet.WithUnknown().Any(), map[string]any{"type": "basic", "x-a": 50})
+
+	// An unconditional dynamic rule makes the key known even if the condition does not match
+	ruleSet = ruleSet.WithDynamicKey(rules.String().WithRegexp(regexp.MustCompile("^x-"), ""), rules.Any())
+	testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{"type": "basic", "x-a": 50})
+}
+
+// Requirements:
+// - Panics if a conditional dynamic key depends on a key it matches.
+// - Panics if a circular reference is created through a dynamic key.
+func TestWithConditionalDynamicKeyCycle(t *testing.T) {
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("Expected panic")
+			}
+		}()
+
+		condition := rules.StringMap[int]().WithKey("x-a", rules.Int())
+		rules.StringMap[int]().WithConditionalDynamicKey(rules.String().WithRegexp(regexp.MustCompile("^x-"), ""), condition, rules.Int())
+	}()
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("Expected panic")
+			}
+		}()
+
+		condX := rules.StringMap[int]().WithKey("x-a", rules.Int())
+		condB := rules.StringMap[int]().WithKey("b", rules.Int())
+
+		rules.StringMap[int]().
+			WithConditionalKey("b", condX, rules.Int()).
+			WithConditionalDynamicKey(rules.String().WithRegexp(regexp.MustCompile("^x-"), ""), condB, rules.Int())
+	}()
+}
+
+// allowedBySchema is a key function that allows keys listed in the "schema" key of the object.
+func allowedBySchema(ctx context.Context, obj map[string]any, key string) bool {
+	schema, _ := obj["schema"].([]string)
+	for _, allowed := range schema {
+		if allowed == key {
+			return true
+		}
+	}
+	return false
+}
+
+// Requirements:
+// - Key functions can use values set by other keys.
+// - Matching keys are not considered "unknown".
+// - The rule set is evaluated for each matching key.
+func TestWithKeyFunc(t *testing.T) {
+	ruleSet := rules.StringMap[any]().
+		WithKey("schema", rules.Slice[string]().Any()).
+		WithKeyFunc(allowedBySchema, rules.Int().WithMax(10).Any())
+
+	testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{"schema": []string{"a", "b"}, "a": 1, "b": 2})
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"schema": []string{"a"}, "a": 1, "b": 2}, errors.CodeUnexpected)
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"schema": []string{"a"}, "a": 50}, errors.CodeMax)
+
+	expected := ".WithKeyFunc(<func>, IntRuleSet[int].WithMax(10).Any())"
+	if s := ruleSet.String(); !stringsHelper.HasSuffix(s, expected) {
+		t.Errorf("Expected rule set to end with %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Key functions run after conditional keys.
+// - Key functions run with sequential evaluation and concurrency limits.
+func TestWithKeyFuncAfterConditional(t *testing.T) {
+	condition := rules.StringMap[any]().
+		WithUnknown().
+		WithKey("type", rules.Constant[any]("extended"))
+
+	base := rules.StringMap[any]().
+		WithKeyFunc(allowedBySchema, rules.Int().Any()).
+		WithKey("type", rules.String().Any()).
+		WithConditionalKey("schema", condition, rules.Slice[string]().Any())
+
+	for _, ruleSet := range []*rules.ObjectRuleSet[map[string]any, string, any]{base, base.WithSequential(), base.WithConcurrencyLimit(1)} {
+		testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{"type": "extended", "schema": []string{"a"}, "a": 1})
+		testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"type": "basic", "schema": []string{"a"}, "a": 1}, errors.CodeUnexpected)
+	}
+}
+
+// Requirements:
+// - Missing required keys do not return errors.
+// - Present keys are still validated.
+// - Unknown keys are still rejected.
+// - Nested rule sets are not affected.
+// - Serializes to WithPartial()
+func TestWithPartial(t *testing.T) {
+	nested := rules.StringMap[any]().WithKey("a", rules.Int().WithRequired().Any())
+
+	ruleSet := rules.Struct[*testStruct]().
+		WithKey("X", rules.Int().WithRequired().WithMax(10).Any()).
+		WithKey("Y", rules.Int().WithRequired().Any())
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"X": 1}, errors.CodeRequired)
+
+	partial := ruleSet.WithPartial().WithPartial()
+
+	var out *testStruct
+	if err := partial.Apply(context.Background(), map[string]any{"X": 1}, &out); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	} else if out.X != 1 || out.Y != 0 {
+		t.Errorf("Expected output to be {X: 1, Y: 0}, got: %+v", out)
+	}
+
+	testhelpers.MustApplyAny(t, partial.Any(), map[string]any{})
+	testhelpers.MustNotApply(t, partial.Any(), map[string]any{"X": 50}, errors.CodeMax)
+	testhelpers.MustNotApply(t, partial.Any(), map[string]any{"X": 1, "Q": 1}, errors.CodeUnexpected)
+
+	mapRuleSet := rules.StringMap[any]().WithKey("n", nested.Any()).WithPartial()
+	testhelpers.MustApplyAny(t, mapRuleSet.Any(), map[string]any{})
+	testhelpers.MustNotApply(t, mapRuleSet.Any(), map[string]any{"n": map[string]any{}}, errors.CodeRequired)
+
+	expected := "ObjectRuleSet[*rules_test.testStruct].WithKey(\"X\", IntRuleSet[int].WithRequired().WithMax(10).Any()).WithKey(\"Y\", IntRuleSet[int].WithRequired().Any()).WithPartial()"
+	if s := partial.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Keys supplied by a map input are present, including zero and null values.
+// - Missing keys and values treated as missing are not present.
+// - Every key with a rule set is present for struct inputs.
+// - Present keys are set even if there are validation errors.
+// - Panics if present is nil.
+func TestApplyWithPresentKeys(t *testing.T) {
+	type patch struct {
+		Name  string
+		Age   int
+		Email string
+	}
+
+	ruleSet := rules.Struct[patch]().
+		WithKey("Name", rules.String().Any()).
+		WithKey("Age", rules.Int().WithMax(150).Any()).
+		WithKey("Email", rules.String().WithEmptyAsNil().Any()).
+		WithExplicitNull()
+
+	check := func(input any, expected ...string) {
+		t.Helper()
+
+		var out patch
+		var present map[string]bool
+		ruleSet.ApplyWithPresentKeys(context.Background(), input, &out, &present)
+
+		if len(present) != len(expected) {
+			t.Errorf("Expected %d present keys, got: %v", len(expected), present)
+		}
+		for _, key := range expected {
+			if !present[key] {
+				t.Errorf("Expected %s to be present, got: %v", key, present)
+			}
+		}
+	}
+
+	check(map[string]any{"Name": "a", "Age": 0}, "Name", "Age")
+	check(map[string]any{"Name": nil}, "Name")
+	check(map[string]any{"Email": ""})
+	check(map[string]any{"Age": 200}, "Age")
+	check(patch{Email: "a"}, "Name", "Age", "Email")
+	check(patch{}, "Name", "Age")
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic")
+		}
+	}()
+
+	var out patch
+	ruleSet.ApplyWithPresentKeys(context.Background(), map[string]any{}, &out, nil)
+}
+
+// Requirements:
+// - Null keys are set to the zero value on map outputs and missing keys are not set.
+// - Null keys set pointer fields to nil and missing keys leave fields untouched on struct outputs.
+// - Null keys are not passed to the key rule set.
+// - Required keys return an error if they are null, even with WithPartial.
+// - Serializes to WithExplicitNull().
+func TestWithExplicitNull(t *testing.T) {
+	ruleSet := rules.StringMap[any]().
+		WithKey("name", rules.String().WithMinLen(3).Any()).
+		WithKey("age", rules.Int().Any()).
+		WithJson().
+		WithExplicitNull()
+
+	var out map[string]any
+	if err := ruleSet.Apply(context.Background(), `{"name": null}`, &out); err != nil {
+		t.Fatalf("Expected errors to be nil, got: %s", err)
+	}
+	if v, ok := out["name"]; !ok || v != nil {
+		t.Errorf("Expected name to be set to nil, got: %v (%t)", v, ok)
+	}
+	if _, ok := out["age"]; ok {
+		t.Errorf("Expected age to not be set, got: %v", out["age"])
+	}
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), `{"name": "ab"}`, errors.CodeMin)
+
+	type patch struct {
+		Name *string
+		Age  *int
+	}
+
+	name := "original"
+	age := 10
+	structOut := patch{Name: &name, Age: &age}
+
+	structRuleSet := rules.Struct[patch]().
+		WithKey("Name", rules.String().Any()).
+		WithKey("Age", rules.Int().Any()).
+		WithJson().
+		WithExplicitNull()
+
+	if err := structRuleSet.Apply(context.Background(), `{"Name": null}`, &structOut); err != nil {
+		t.Fatalf("Expected errors to be nil, got: %s", err)
+	}
+	if structOut.Name != nil {
+		t.Errorf("Expected Name to be nil, got: %s", *structOut.Name)
+	}
+	if structOut.Age == nil || *structOut.Age != 10 {
+		t.Errorf("Expected Age to be untouched, got: %v", structOut.Age)
+	}
+
+	required := rules.StringMap[any]().
+		WithKey("name", rules.String().WithRequired().Any()).
+		WithExplicitNull().
+		WithPartial()
+
+	testhelpers.MustApplyAny(t, required.Any(), map[string]any{})
+	testhelpers.MustNotApply(t, required.Any(), map[string]any{"name": nil}, errors.CodeRequired)
+
+	if ruleSet.WithExplicitNull() != ruleSet {
+		t.Error("Expected WithExplicitNull to return the same rule set")
+	}
+
+	if s := ruleSet.String(); !stringsHelper.HasSuffix(s, ".WithExplicitNull()") {
+		t.Errorf("Expected rule set to end with WithExplicitNull(), got: %s", s)
+	}
+}
+
+type testStructUntagged struct {
+	UserName string
+	Email    string `validate:"email_address"`
+	hidden   string //lint:ignore U1000 Used in reflection testing but not code
+}
+
+// Requirements:
+// - Input keys can be mapped to un-tagged fields.
+// - Tag mappings continue to work.
+// - Mappings are not included in the string representation.
+func TestWithRenameKey(t *testing.T) {
+	ruleSet := rules.Struct[testStructUntagged]().
+		WithRenameKey("user_name", "UserName").
+		WithKey("user_name", rules.String().WithMinLen(3).Any()).
+		WithKey("email_address", rules.String().Any())
+
+	var out testStructUntagged
+	err := ruleSet.Apply(context.Background(), map[string]any{"user_name": "alice", "email_address": "a@example.com"}, &out)
+	if err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	} else if out.UserName != "alice" || out.Email != "a@example.com" {
+		t.Errorf("Expected output to be set, got: %+v", out)
+	}
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"user_name": "al"}, errors.CodeMin)
+
+	expected := "ObjectRuleSet[rules_test.testStructUntagged].WithKey(\"user_name\", StringRuleSet.WithMinLen(3).Any()).WithKey(\"email_address\", StringRuleSet.Any())"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Panics if the field is missing.
+// - Panics if the field is not exported.
+// - Panics if the rule set is for a map.
+func TestWithRenameKeyPanics(t *testing.T) {
+	cases := map[string]func(){
+		"missing":    func() { rules.Struct[testStructUntagged]().WithRenameKey("a", "Missing") },
+		"unexported": func() { rules.Struct[testStructUntagged]().WithRenameKey("a", "hidden") },
+		"map":        func() { rules.StringMap[any]().WithRenameKey("a", "A") },
+	}
+
+	for name, fn := range cases {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Expected panic for %s", name)
+				}
+			}()
+			fn()
+		}()
+	}
+}
+
+// Requirements:
+// - WithFailFast returns a single error.
+// - Remaining keys are not evaluated after the first error in sequential mode.
+// - Object rules are not evaluated if a key fails.
+// - Nested rule sets inherit the mode.
+// - Valid input is unaffected.
+func TestWithFailFast(t *testing.T) {
+	var mutex sync.Mutex
+	evaluated := make([]string, 0)
+	track := func(key string) *rules.IntRuleSet[int] {
+		return rules.Int().WithMax(1).WithRuleFunc(func(_ context.Context, _ int) errors.ValidationErrorCollection {
+			mutex.Lock()
+			defer mutex.Unlock()
+			evaluated = append(evaluated, key)
+			return nil
+		})
+	}
+
+	objRuleCalled := false
+
+	ruleSet := rules.StringMap[any]().
+		WithKey("a", track("a").Any()).
+		WithKey("b", track("b").Any()).
+		WithKey("c", track("c").Any()).
+		WithRuleFunc(func(_ context.Context, _ map[string]any) errors.ValidationErrorCollection {
+			objRuleCalled = true
+			return nil
+		})
+
+	err := ruleSet.Apply(context.Background(), map[string]any{"a": 5, "b": 5, "c": 5}, new(map[string]any))
+	if len(err) != 3 {
+		t.Errorf("Expected 3 errors without fail fast, got: %d", len(err))
+	}
+
+	evaluated = evaluated[:0]
+	objRuleCalled = false
+	failFast := ruleSet.WithSequential().WithFailFast().WithFailFast()
+
+	err = failFast.Apply(context.Background(), map[string]any{"a": 5, "b": 5, "c": 5}, new(map[string]any))
+	if len(err) != 1 {
+		t.Fatalf("Expected 1 error, got: %d", len(err))
+	}
+	if err[0].Code() != errors.CodeMax {
+		t.Errorf("Expected error code to be %s, got: %s", errors.CodeMax, err[0].Code())
+	}
+	if len(evaluated) != 1 || evaluated[0] != "a" {
+		t.Errorf("Expected only key a to be evaluated, got: %v", evaluated)
+	}
+	if objRuleCalled {
+		t.Errorf("Expected object rule to not be called")
+	}
+
+	testhelpers.MustApplyAny(t, failFast.Any(), map[string]any{"a": 1, "b": 0, "c": 1})
+
+	concurrent := rules.StringMap[any]().
+		WithKey("a", rules.Int().WithMax(1).Any()).
+		WithKey("b", rules.Int().WithMax(1).Any()).
+		WithFailFast()
+
+	err = concurrent.Apply(context.Background(), map[string]any{"a": 5, "b": 5}, new(map[string]any))
+	if len(err) != 1 {
+		t.Errorf("Expected 1 error, got: %d", len(err))
+	} else if err[0].Code() != errors.CodeMax {
+		t.Errorf("Expected error code to be %s, got: %s", errors.CodeMax, err[0].Code())
+	}
+
+	nested := rules.StringMap[any]().
+		WithKey("n", rules.StringMap[any]().
+			WithKey("x", rules.String().WithMinLen(5).WithMaxLen(1).Any()).
+			WithKey("y", rules.Slice[int]().WithItemRuleSet(rules.Int().WithMax(1)).Any()).
+			Any()).
+		WithFailFast()
+
+	err = nested.Apply(context.Background(), map[string]any{"n": map[string]any{"x": "abc", "y": []int{5, 5}}}, new(map[string]any))
+	if len(err) != 1 {
+		t.Errorf("Expected 1 error, got: %d", len(err))
+	}
+
+	expected := ".WithKey(\"a\", IntRuleSet[int].WithMax(1).Any()).WithKey(\"b\", IntRuleSet[int].WithMax(1).Any()).WithFailFast()"
+	if s := concurrent.String(); !stringsHelper.HasSuffix(s, expected) {
+		t.Errorf("Expected rule set to end with %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Object rules that only return warnings do not cancel the other rules in fail fast mode.
+// - Errors from other object rules are returned instead of a cancellation.
+// - Applies to both concurrent and sequential evaluation.
+func TestWithFailFast_ObjectRuleWarning(t *testing.T) {
+	warn := func(ctx context.Context, _ map[string]any) errors.ValidationErrorCollection {
+		return errors.Collection(errors.WithSeverity(errors.Errorf(errors.CodeUnknown, ctx, "just a warning"), errors.SeverityWarning))
+	}
+	fail := func(ctx context.Context, value map[string]any) errors.ValidationErrorCollection {
+		if value["a"] == 0 {
+			return errors.Collection(errors.Errorf(errors.CodeMin, ctx, "a must not be zero"))
+		}
+		return nil
+	}
+
+	base := rules.StringMap[any]().
+		WithKey("a", rules.Int().Any()).
+		WithFailFast().
+		WithRuleFunc(warn).
+		WithRuleFunc(fail)
+
+	for name, ruleSet := range map[string]*rules.ObjectRuleSet[map[string]any, string, any]{
+		"concurrent": base,
+		"sequential": base.WithSequential(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := ruleSet.Apply(context.Background(), map[string]any{"a": 1}, new(map[string]any))
+			if err.HasErrors() {
+				t.Errorf("Expected no errors, got: %s", err)
+			} else if len(err.Warnings()) != 1 {
+				t.Errorf("Expected 1 warning, got: %d", len(err.Warnings()))
+			}
+
+			err = ruleSet.Apply(context.Background(), map[string]any{"a": 0}, new(map[string]any))
+			if errs := err.Errors(); len(errs) != 1 || errs[0].Code() != errors.CodeMin {
+				t.Errorf("Expected a single min error, got: %s", errs)
+			}
+		})
+	}
+}
+
+// Requirements:
+// - Typed context values are available to custom rules in nested rule sets.
+func TestObjectRuleSet_ContextValue(t *testing.T) {
+	tenantKey := rulecontext.Key[string]("tenant")
+
+	ruleSet := rules.StringMap[any]().WithKey("id", rules.String().WithRuleFunc(func(ctx context.Context, id string) errors.ValidationErrorCollection {
+		tenant, ok := rulecontext.Value(ctx, tenantKey)
+		if !ok || !stringsHelper.HasPrefix(id, tenant+"/") {
+			return errors.Collection(errors.Errorf(errors.CodeForbidden, ctx, "resource belongs to another tenant"))
+		}
+		return nil
+	}).Any())
+
+	ctx := rulecontext.WithValue(context.Background(), tenantKey, "acme")
+
+	var out map[string]any
+	if err := ruleSet.Apply(ctx, map[string]any{"id": "acme/1"}, &out); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	}
+
+	err := ruleSet.Apply(ctx, map[string]any{"id": "other/1"}, &out)
+	if len(err) != 1 || err[0].Code() != errors.CodeForbidden {
+		t.Errorf("Expected a forbidden error, got: %v", err)
+	}
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"id": "acme/1"}, errors.CodeForbidden)
+}
+
+// Requirements:
+// - A single unexpected error is returned at the object path.
+// - The meta lists every unknown key in order.
+// - Per key errors are the default.
+// - Serializes to WithCollapsedUnknownErrors().
+func TestObjectRuleSet_WithCollapsedUnknownErrors(t *testing.T) {
+	ruleSet := rules.StringMap[any]().
+		WithKey("a", rules.Int().Any()).
+		WithCollapsedUnknownErrors()
+
+	input := map[string]any{"a": 1, "z": 1, "x": 2, "y": 3}
+
+	var out map[string]any
+	err := ruleSet.Apply(context.TODO(), input, &out)
+	if len(err) != 1 {
+		t.Fatalf("Expected 1 error, got %d: %s", len(err), err)
+	}
+	if err[0].Code() != errors.CodeUnexpected || err[0].Path() != "" {
+		t.Errorf("Expected an unexpected error at the root, got: %s at %s", err[0].Code(), err[0].Path())
+	}
+	if keys, _ := errors.MetaOf(err[0])["keys"].([]string); stringsHelper.Join(keys, ",") != "x,y,z" {
+		t.Errorf("Expected keys to be [x y z], got: %v", errors.MetaOf(err[0]))
+	}
+
+	testhelpers.MustApplyAny(t, ruleSet.Any(), map[string]any{"a": 1})
+
+	if err := rules.StringMap[any]().WithKey("a", rules.Int().Any()).Apply(context.TODO(), input, &out); len(err) != 3 {
+		t.Errorf("Expected 3 errors by default, got %d", len(err))
+	}
+
+	if ruleSet.WithCollapsedUnknownErrors() != ruleSet {
+		t.Error("Expected WithCollapsedUnknownErrors to return the same rule set")
+	}
+
+	if s := ruleSet.String(); !stringsHelper.HasSuffix(s, ".WithCollapsedUnknownErrors()") {
+		t.Errorf("Expected rule set to end with WithCollapsedUnknownErrors(), got %s", s)
+	}
+}
+
+// Requirements:
+// - Errors are sorted by path and then code.
+// - Numeric indexes are sorted by value.
+// - Serializes to WithSortedErrors().
+func TestObjectRuleSet_WithSortedErrors(t *testing.T) {
+	ruleSet := rules.StringMap[any]().
+		WithKey("b", rules.Int().WithMax(1).Any()).
+		WithKey("a", rules.Int().WithMax(1).WithMin(5).Any()).
+		WithKey("items", rules.Slice[int]().WithItemRuleSet(rules.Int().WithMax(5)).Any()).
+		WithSortedErrors()
+
+	items := make([]any, 11)
+	for i := range items {
+		items[i] = 0
+	}
+	items[2] = 10
+	items[10] = 10
+
+	expected := []string{"/a", "/a", "/b", "/items/2", "/items/10"}
+
+	// Repeat since keys are evaluated concurrently
+	for n := 0; n < 20; n++ {
+		var out map[string]any
+		err := ruleSet.Apply(context.TODO(), map[string]any{"a": 3, "b": 3, "items": items}, &out)
+
+		if len(err) != len(expected) {
+			t.Fatalf("Expected %d errors, got: %d", len(expected), len(err))
+		}
+
+		for i, path := range expected {
+			if err[i].Path() != path {
+				t.Fatalf("Expected error %d to be at %s, got: %s", i, path, err[i].Path())
+			}
+		}
+
+		if err[0].Code() != errors.CodeMax || err[1].Code() != errors.CodeMin {
+			t.Fatalf("Expected errors at /a to be sorted by code, got: %s, %s", err[0].Code(), err[1].Code())
+		}
+	}
+
+	if ruleSet.WithSortedErrors() != ruleSet {
+		t.Error("Expected WithSortedErrors to return the same rule set")
+	}
+
+	if s := ruleSet.String(); !stringsHelper.HasSuffix(s, ".WithSortedErrors()") {
+		t.Errorf("Expected rule set to end with WithSortedErrors(), got: %s", s)
+	}
+}
@@ -0,0 +1,53 @@
+package rules
+
+import "fmt"
+
+// UnknownMode is used to specify what happens to input keys that have no rule set.
+//
+// These values are not guaranteed to be unchanged between versions. Don't use for serialization or cross-process communication.
+type UnknownMode int
+
+const (
+	UnknownError UnknownMode = iota // Default. Return an errors.CodeUnexpected error for each unknown key.
+	UnknownKeep                     // Copy unknown keys to map outputs. Struct outputs have nowhere to put them so they are ignored.
+	UnknownDrop                     // Silently discard unknown keys without an error.
+)
+
+// String returns the string value for the unknown mode. Useful for debugging.
+func (m UnknownMode) String() string {
+	switch m {
+	case UnknownError:
+		return "UnknownError"
+	case UnknownKeep:
+		return "UnknownKeep"
+	case UnknownDrop:
+		return "UnknownDrop"
+	}
+	return "Unknown"
+}
+
+// WithUnknownMode returns a new RuleSet that handles unknown keys using the mode.
+//
+// UnknownDrop is useful when proxying arbitrary JSON to a map since the keys that are not recognized are removed
+// from the output without failing validation. Keys that match a dynamic bucket are still put in the bucket in
+// both UnknownKeep and UnknownDrop modes.
+func (v *ObjectRuleSet[T, TK, TV]) WithUnknownMode(mode UnknownMode) *ObjectRuleSet[T, TK, TV] {
+	return v.withUnknownMode(mode, fmt.Sprintf("WithUnknownMode(%s)", mode))
+}
+
+// withUnknownMode returns a new RuleSet with the unknown flags set for the mode.
+// The same rule set is returned if the mode does not change.
+func (v *ObjectRuleSet[T, TK, TV]) withUnknownMode(mode UnknownMode, label string) *ObjectRuleSet[T, TK, TV] {
+	allowUnknown := mode == UnknownKeep || mode == UnknownDrop
+	dropUnknown := mode == UnknownDrop
+
+	if v.allowUnknown == allowUnknown && v.dropUnknown == dropUnknown {
+		return v
+	}
+
+	newRuleSet := v.withParent()
+	newRuleSet.allowUnknown = allowUnknown
+	newRuleSet.dropUnknown = dropUnknown
+	newRuleSet.label = label
+	return newRuleSet
+}
@@ -0,0 +1,66 @@
+package rules_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - UnknownError returns an error for each unknown key.
+// - UnknownKeep copies unknown keys to map outputs.
+// - UnknownDrop removes unknown keys from map outputs without an error.
+// - Known keys are kept in every mode.
+func TestWithUnknownMode(t *testing.T) {
+	base := rules.StringMap[any]().WithKey("a", rules.Int().Any())
+	input := map[string]any{"a": 1, "b": 2}
+
+	testhelpers.MustNotApply(t, base.WithUnknownMode(rules.UnknownError).Any(), input, errors.CodeUnexpected)
+
+	var out map[string]any
+	if err := base.WithUnknownMode(rules.UnknownKeep).Apply(context.Background(), input, &out); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	} else if len(out) != 2 || out["b"] != 2 {
+		t.Errorf("Expected unknown key to be kept, got: %v", out)
+	}
+
+	out = nil
+	if err := base.WithUnknownMode(rules.UnknownDrop).Apply(context.Background(), input, &out); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	} else if len(out) != 1 || out["a"] != 1 {
+		t.Errorf("Expected unknown key to be dropped, got: %v", out)
+	}
+}
+
+// Requirements:
+// - WithUnknown is the same as UnknownKeep.
+// - Setting the current mode returns the same rule set.
+// - The most recent mode is used.
+// - Serializes to WithUnknownMode(...).
+func TestWithUnknownMode_Alias(t *testing.T) {
+	base := rules.StringMap[any]()
+
+	keep := base.WithUnknown()
+	if keep.WithUnknownMode(rules.UnknownKeep) != keep {
+		t.Error("Expected WithUnknownMode(UnknownKeep) to return the same rule set after WithUnknown")
+	}
+	if base.WithUnknownMode(rules.UnknownError) != base {
+		t.Error("Expected WithUnknownMode(UnknownError) to return the same rule set by default")
+	}
+
+	drop := keep.WithUnknownMode(rules.UnknownDrop)
+	var out map[string]any
+	if err := drop.WithUnknown().Apply(context.Background(), map[string]any{"b": 2}, &out); err != nil || len(out) != 1 {
+		t.Errorf("Expected WithUnknown to keep unknown keys again, got: %v %s", out, err)
+	}
+	testhelpers.MustNotApply(t, drop.WithUnknownMode(rules.UnknownError).Any(), map[string]any{"b": 2}, errors.CodeUnexpected)
+
+	expected := ".WithUnknown().WithUnknownMode(UnknownDrop)"
+	if s := drop.String(); !strings.HasSuffix(s, expected) {
+		t.Errorf("Expected rule set to end with %s, got %s", expected, s)
+	}
+}
@@ -0,0 +1,85 @@
+package rules
+
+import (
+	"fmt"
+	"reflect"
+
+	"gopkg.in/yaml.v3"
+)
+
+// WithYaml allows the input to be a Yaml encoded string, byte slice or a pointer to either. This is useful for
+// validating configuration files with the same rule sets used for Json APIs.
+//
+// The document must be a mapping. Keys that are not strings, such as numbers, are converted to strings in nested
+// mappings as well so the keys can be matched by WithKey. Anchors and aliases are resolved before validation.
+// Input that is not valid Yaml or is not a mapping returns a coercion error and any other input is validated as
+// usual.
+//
+// If WithJson is also used, Json is decoded first and Yaml is only tried if the input is not valid Json.
+func (v *ObjectRuleSet[T, TK, TV]) WithYaml() *ObjectRuleSet[T, TK, TV] {
+	if v.yaml {
+		return v
+	}
+
+	newRuleSet := v.withParent()
+	newRuleSet.yaml = true
+	newRuleSet.label = "WithYaml()"
+	return newRuleSet
+}
+
+// decodeYamlInput decodes the value into out if it is a string or a byte slice.
+// It returns false if the value is any other kind and an error if it could not be decoded into a mapping.
+func decodeYamlInput(value reflect.Value, out *map[string]any) (bool, error) {
+	var data []byte
+
+	switch {
+	case value.Kind() == reflect.String:
+		data = []byte(value.String())
+	case value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.Uint8:
+		data = value.Bytes()
+	default:
+		return false, nil
+	}
+
+	var result any
+	if err := yaml.Unmarshal(data, &result); err != nil {
+		return true, err
+	}
+
+	// An empty document is the same as an empty mapping
+	if result == nil {
+		*out = nil
+		return true, nil
+	}
+
+	normalized, ok := normalizeYaml(result).(map[string]any)
+	if !ok {
+		return true, fmt.Errorf("expected Yaml mapping, got: %T", result)
+	}
+
+	*out = normalized
+	return true, nil
+}
+
+// normalizeYaml converts every mapping in the decoded Yaml value to a map with string keys.
+func normalizeYaml(value any) any {
+	switch x := value.(type) {
+	case map[string]any:
+		for k, v := range x {
+			x[k] = normalizeYaml(v)
+		}
+		return x
+	case map[any]any:
+		normalized := make(map[string]any, len(x))
+		for k, v := range x {
+			normalized[fmt.Sprint(k)] = normalizeYaml(v)
+		}
+		return normalized
+	case []any:
+		for i, v := range x {
+			x[i] = normalizeYaml(v)
+		}
+		return x
+	}
+	return value
+}
@@ -0,0 +1,111 @@
+package rules_test
+
+import (
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// yamlConfig is the output of the Yaml tests.
+type yamlConfig struct {
+	Name    string
+	Servers []map[string]any
+	Ports   map[string]any
+}
+
+// yamlRuleSet returns a rule set for nested Yaml configuration files.
+func yamlRuleSet() *rules.ObjectRuleSet[yamlConfig, string, any] {
+	server := rules.StringMap[any]().
+		WithKey("host", rules.String().WithRequired().Any()).
+		WithKey("port", rules.Int().WithMax(65535).Any())
+
+	return rules.Struct[yamlConfig]().
+		WithRenameKey("name", "Name").
+		WithRenameKey("servers", "Servers").
+		WithRenameKey("ports", "Ports").
+		WithKey("name", rules.String().Any()).
+		WithKey("servers", rules.Slice[map[string]any]().WithItemRuleSet(server).Any()).
+		WithKey("ports", rules.StringMap[any]().WithDynamicKey(rules.String(), rules.Int().Any()).Any()).
+		WithYaml()
+}
+
+// Requirements:
+// - Does not parse Yaml by default.
+// - Decodes nested Yaml from strings and byte slices.
+// - Anchors and aliases are resolved.
+// - Non string keys are converted to strings.
+func TestWithYaml(t *testing.T) {
+	y := `
+name: api
+defaults: &defaults
+  port: 8080
+servers:
+  - host: a.example.com
+    <<: *defaults
+  - host: b.example.com
+    port: 9090
+ports:
+  80: 8080
+  443: 8443
+`
+	ruleSet := yamlRuleSet().WithUnknown()
+
+	testhelpers.MustNotApply(t, rules.Struct[yamlConfig]().WithUnknown().Any(), y, errors.CodeType)
+
+	for _, input := range []any{y, &y, []byte(y)} {
+		var output yamlConfig
+		if errs := ruleSet.Apply(context.Background(), input, &output); errs != nil {
+			t.Fatalf("Expected errors to be nil, got: %s", errs)
+		}
+
+		if output.Name != "api" {
+			t.Errorf("Expected name to be api, got: %s", output.Name)
+		}
+		if len(output.Servers) != 2 {
+			t.Fatalf("Expected 2 servers, got: %d", len(output.Servers))
+		}
+		if port := output.Servers[0]["port"]; port != 8080 {
+			t.Errorf("Expected port from anchor to be 8080, got: %v", port)
+		}
+		if port := output.Servers[1]["port"]; port != 9090 {
+			t.Errorf("Expected port to be 9090, got: %v", port)
+		}
+		if port := output.Ports["443"]; port != 8443 {
+			t.Errorf("Expected port 443 to be 8443, got: %v", port)
+		}
+	}
+}
+
+// Requirements:
+// - Nested values are validated.
+// - Unknown keys are still rejected.
+// - Invalid Yaml and documents that are not mappings return a type error.
+// - Other input is validated as usual.
+// - Json is tried before Yaml.
+// - Serializes to WithYaml().
+func TestWithYamlErrors(t *testing.T) {
+	ruleSet := yamlRuleSet()
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), "servers:\n  - host: a\n    port: 70000\n", errors.CodeMax)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "servers:\n  - port: 80\n", errors.CodeRequired)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "other: 1\n", errors.CodeUnexpected)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "name: [", errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "- a\n- b\n", errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "just a string", errors.CodeType)
+
+	testhelpers.MustApplyFunc(t, ruleSet.Any(), map[string]any{"name": "api"}, yamlConfig{Name: "api"}, checkDeepEqual)
+	testhelpers.MustApplyFunc(t, ruleSet.WithJson().Any(), `{"name": "api"}`, yamlConfig{Name: "api"}, checkDeepEqual)
+	testhelpers.MustApplyFunc(t, ruleSet.WithJson().Any(), "name: api", yamlConfig{Name: "api"}, checkDeepEqual)
+
+	expected := ".WithYaml()"
+	if s := rules.StringMap[any]().WithYaml().String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+
+	if ruleSet.WithYaml() != ruleSet {
+		t.Error("Expected WithYaml to return the same rule set when already set")
+	}
+}
@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// OneOfRuleSet implements RuleSet and passes if at least one of the wrapped rule sets passes.
+type OneOfRuleSet[T any] struct {
+	NoConflict[T]
+	ruleSets []RuleSet[T]
+}
+
+// OneOf returns a rule set that tries each rule set in order and passes on the first one that succeeds.
+// The output is the value produced by the matching rule set. This is useful for values that may be in more
+// than one format, such as a value that may either be an email address or a phone number.
+//
+// If none of the rule sets pass, the errors from every attempt are returned.
+//
+// This function panics if no rule sets are provided.
+func OneOf[T any](ruleSets ...RuleSet[T]) *OneOfRuleSet[T] {
+	if len(ruleSets) == 0 {
+		panic(fmt.Errorf("at least one rule set is required"))
+	}
+
+	return &OneOfRuleSet[T]{
+		ruleSets: append([]RuleSet[T](nil), ruleSets...),
+	}
+}
+
+// Required returns true only if every rule set is required since any of them may match.
+func (v *OneOfRuleSet[T]) Required() bool {
+	for _, ruleSet := range v.ruleSets {
+		if !ruleSet.Required() {
+			return false
+		}
+	}
+	return true
+}
+
+// Apply applies each rule set in order and assigns the output of the first one that passes.
+// Outputs of rule sets that fail are discarded.
+func (v *OneOfRuleSet[T]) Apply(ctx context.Context, input, output any) errors.ValidationErrorCollection {
+	// Ensure output is a non-nil pointer
+	rv := reflect.ValueOf(output)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.Collection(
+			errors.Errorf(errors.CodeInternal, ctx, "Output must be a non-nil pointer"),
+		)
+	}
+
+	allErrors := errors.Collection()
+
+	for _, ruleSet := range v.ruleSets {
+		var out T
+		errs := ruleSet.Apply(ctx, input, &out)
+		if errs.HasErrors() {
+			allErrors = append(allErrors, errs...)
+			continue
+		}
+
+		if assignErrs := assignValue(ctx, out, output); assignErrs != nil {
+			return assignErrs
+		}
+		return errs
+	}
+
+	return allErrors
+}
+
+// Evaluate evaluates each rule set in order and returns as soon as one passes. Warnings from the rule set that
+// passed are returned.
+// If none pass, the errors from every rule set are returned.
+func (v *OneOfRuleSet[T]) Evaluate(ctx context.Context, value T) errors.ValidationErrorCollection {
+	allErrors := errors.Collection()
+
+	for _, ruleSet := range v.ruleSets {
+		errs := ruleSet.Evaluate(ctx, value)
+		if !errs.HasErrors() {
+			return errs
+		}
+		allErrors = append(allErrors, errs...)
+	}
+
+	return allErrors
+}
+
+// Any returns a new RuleSet that wraps the OneOf RuleSet in any Any rule set
+// which can then be used in nested validation.
+func (v *OneOfRuleSet[T]) Any() RuleSet[any] {
+	return WrapAny[T](v)
+}
+
+// String returns a string representation of the rule set suitable for debugging.
+func (v *OneOfRuleSet[T]) String() string {
+	return "OneOf(" + joinRuleSets(v.ruleSets) + ")"
+}
+
+// joinRuleSets returns the string representation of each rule set separated by commas.
+func joinRuleSets[T any](ruleSets []RuleSet[T]) string {
+	labels := make([]string, len(ruleSets))
+	for i, ruleSet := range ruleSets {
+		labels[i] = ruleSet.String()
+	}
+	return strings.Join(labels, ", ")
+}
@@ -0,0 +1,103 @@
+package rules_test
+
+import (
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Passes if any of the rule sets pass.
+// - Errors from every rule set are returned if none pass.
+// - Errors keep the path of the value.
+func TestOneOf(t *testing.T) {
+	ruleSet := rules.OneOf[string](
+		rules.String().WithRegexpString(`^[a-z]+$`, "letters only"),
+		rules.String().WithRegexpString(`^[0-9]+$`, "numbers only"),
+	)
+
+	testhelpers.MustApply(t, ruleSet.Any(), "abc")
+	testhelpers.MustApply(t, ruleSet.Any(), "123")
+
+	ctx := context.Background()
+	if err := ruleSet.Evaluate(ctx, "abc"); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	}
+
+	objectRuleSet := rules.StringMap[any]().WithKey("id", ruleSet.Any())
+
+	err := objectRuleSet.Apply(ctx, map[string]any{"id": "a1"}, new(map[string]any))
+	if len(err) != 2 {
+		t.Fatalf("Expected 2 errors, got: %d", len(err))
+	}
+	for _, e := range err {
+		if e.Code() != errors.CodePattern {
+			t.Errorf("Expected error code to be %s, got: %s", errors.CodePattern, e.Code())
+		}
+		if e.Path() != "/id" {
+			t.Errorf("Expected error path to be /id, got: %s", e.Path())
+		}
+	}
+
+	if err := ruleSet.Evaluate(ctx, "a1"); len(err) != 2 {
+		t.Errorf("Expected 2 errors, got: %d", len(err))
+	}
+}
+
+// Requirements:
+// - The output is the value produced by the first matching rule set.
+// - Output must be a non-nil pointer.
+func TestOneOf_Output(t *testing.T) {
+	ruleSet := rules.OneOf[int](
+		rules.Int().WithMax(10).WithStrict(),
+		rules.Int().WithMin(100),
+	)
+
+	var out int
+	if err := ruleSet.Apply(context.Background(), "150", &out); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	} else if out != 150 {
+		t.Errorf("Expected output to be 150, got: %d", out)
+	}
+
+	var anyOut any
+	if err := ruleSet.Apply(context.Background(), 5, &anyOut); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	} else if anyOut != 5 {
+		t.Errorf("Expected output to be 5, got: %v", anyOut)
+	}
+
+	if err := ruleSet.Apply(context.Background(), 5, out); err == nil {
+		t.Errorf("Expected errors to not be nil")
+	}
+}
+
+// Requirements:
+// - Required only if every rule set is required.
+// - Panics with no rule sets.
+// - Serializes to string.
+func TestOneOf_String(t *testing.T) {
+	ruleSet := rules.OneOf[int](rules.Int().WithRequired(), rules.Int().WithMin(1))
+
+	if ruleSet.Required() {
+		t.Errorf("Expected rule set to not be required")
+	}
+	if !rules.OneOf[int](rules.Int().WithRequired()).Required() {
+		t.Errorf("Expected rule set to be required")
+	}
+
+	expected := "OneOf(IntRuleSet[int].WithRequired(), IntRuleSet[int].WithMin(1))"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+	rules.OneOf[int]()
+}
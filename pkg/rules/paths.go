@@ -2,6 +2,8 @@ package rules
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
 )
 
 func toQuotedPath(key any) string {
@@ -15,3 +17,10 @@ func toQuotedPath(key any) string {
 func toPath(key any) string {
 	return fmt.Sprintf("%v", key)
 }
+
+// sortMapKeys sorts map keys by their path so they can be iterated in a stable order.
+func sortMapKeys(keys []reflect.Value) {
+	sort.Slice(keys, func(i, j int) bool {
+		return toPath(keys[i].Interface()) < toPath(keys[j].Interface())
+	})
+}
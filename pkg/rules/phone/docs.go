@@ -0,0 +1,2 @@
+// Package phone provides RuleSet implementations for telephone numbers.
+package phone
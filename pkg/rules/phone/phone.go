@@ -0,0 +1,288 @@
+package phone
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"proto.zip/studio/validate/internal/util"
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// e164Pattern matches a normalized E.164 number. Country calling codes never start with zero and the number has
+// at most 15 digits.
+var e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// callingCodePattern matches a country calling code without the plus sign.
+var callingCodePattern = regexp.MustCompile(`^[1-9][0-9]{0,2}$`)
+
+// separators are removed from the input before it is validated.
+var separators = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "")
+
+// basePhoneRuleSet is the base phone rule set. Since rule sets are immutable.
+var basePhoneRuleSet PhoneRuleSet = PhoneRuleSet{
+	label: "PhoneRuleSet",
+}
+
+// PhoneRuleSet implements the RuleSet interface for phone numbers in E.164 format, such as "+14155552671".
+//
+// Spaces, dashes, dots and parentheses are removed before the number is validated and the output is always the
+// normalized E.164 string. Only the syntax is checked. The number is not checked against national numbering plans
+// and may not be assigned or reachable.
+//
+// Numbers that are not valid return an errors.CodePattern error.
+type PhoneRuleSet struct {
+	rules.NoConflict[string]
+	required bool
+	withNil  bool
+	region   string
+	parent   *PhoneRuleSet
+	rule     rules.Rule[string]
+	label    string
+}
+
+// Phone returns the base phone RuleSet.
+func Phone() *PhoneRuleSet {
+	return &basePhoneRuleSet
+}
+
+// withParent returns a new child rule set with the flags copied from the current rule set.
+func (ruleSet *PhoneRuleSet) withParent() *PhoneRuleSet {
+	return &PhoneRuleSet{
+		required: ruleSet.required,
+		withNil:  ruleSet.withNil,
+		region:   ruleSet.region,
+		parent:   ruleSet,
+	}
+}
+
+// Required returns a boolean indicating if the value is allowed to be omitted when included in a nested object.
+func (ruleSet *PhoneRuleSet) Required() bool {
+	return ruleSet.required
+}
+
+// WithRequired returns a new rule set with the required flag set.
+// Use WithRequired when nesting a RuleSet and the a value is not allowed to be omitted.
+func (ruleSet *PhoneRuleSet) WithRequired() *PhoneRuleSet {
+	if ruleSet.required {
+		return ruleSet
+	}
+
+	newRuleSet := ruleSet.withParent()
+	newRuleSet.required = true
+	newRuleSet.label = "WithRequired()"
+	return newRuleSet
+}
+
+// WithNil returns a new child rule set that accepts a nil input, such as a JSON null or a nil *string, for optional
+// phone numbers. The number is not normalized and the output is left unchanged. By default nil returns an
+// errors.CodeType error.
+func (ruleSet *PhoneRuleSet) WithNil() *PhoneRuleSet {
+	if ruleSet.withNil {
+		return ruleSet
+	}
+
+	newRuleSet := ruleSet.withParent()
+	newRuleSet.withNil = true
+	newRuleSet.label = "WithNil()"
+	return newRuleSet
+}
+
+// WithDefaultRegion returns a new rule set that accepts numbers in national format and converts them to E.164
+// using the country calling code, such as "44" for the United Kingdom or "1" for the United States.
+//
+// Numbers without a leading plus sign are treated as national numbers. A single leading zero, the trunk prefix
+// used by many countries, is removed before the calling code is added. Numbers that start with a plus sign are
+// not changed.
+//
+// This method panics if the calling code is not 1 to 3 digits without a leading zero.
+func (ruleSet *PhoneRuleSet) WithDefaultRegion(callingCode string) *PhoneRuleSet {
+	callingCode = strings.TrimPrefix(callingCode, "+")
+	if !callingCodePattern.MatchString(callingCode) {
+		panic(fmt.Errorf("invalid country calling code: %q", callingCode))
+	}
+
+	newRuleSet := ruleSet.withParent()
+	newRuleSet.region = callingCode
+	newRuleSet.label = fmt.Sprintf("WithDefaultRegion(%q)", callingCode)
+	return newRuleSet
+}
+
+// normalize removes separators from the value and converts national numbers to E.164 if there is a default
+// region. It returns an error if the result is not a valid E.164 number.
+func (ruleSet *PhoneRuleSet) normalize(ctx context.Context, value string) (string, errors.ValidationError) {
+	number := separators.Replace(strings.TrimSpace(value))
+
+	if !strings.HasPrefix(number, "+") && ruleSet.region != "" {
+		number = "+" + ruleSet.region + strings.TrimPrefix(number, "0")
+	}
+
+	if !e164Pattern.MatchString(number) {
+		return "", errors.Errorf(errors.CodePattern, ctx, "field must be a phone number in E.164 format")
+	}
+
+	return number, nil
+}
+
+// Apply performs a validation of a RuleSet against a value and assigns the normalized E.164 number to the output
+// parameter. It returns a ValidationErrorCollection if any validation errors occur.
+func (ruleSet *PhoneRuleSet) Apply(ctx context.Context, input any, output any) errors.ValidationErrorCollection {
+	// Nil is only allowed with WithNil
+	if ruleSet.withNil && util.IsNil(input) {
+		return nil
+	}
+
+	// Attempt to cast the input to a string
+	valueStr, ok := input.(string)
+	if !ok {
+		return errors.Collection(errors.NewCoercionError(ctx, "string", reflect.ValueOf(input).Kind().String()))
+	}
+
+	number, err := ruleSet.normalize(ctx, valueStr)
+	if err != nil {
+		return errors.Collection(err)
+	}
+
+	if errs := ruleSet.evaluateRules(ctx, number); errs != nil {
+		return errs
+	}
+
+	outputVal := reflect.ValueOf(output)
+
+	// Check if the output is a non-nil pointer
+	if outputVal.Kind() != reflect.Ptr || outputVal.IsNil() {
+		return errors.Collection(errors.Errorf(
+			errors.CodeInternal, ctx, "Output must be a non-nil pointer",
+		))
+	}
+
+	// Dereference the pointer to get the actual value that needs to be set
+	outputElem := outputVal.Elem()
+
+	switch outputElem.Kind() {
+	case reflect.String:
+		outputElem.SetString(number)
+	case reflect.Interface:
+		outputElem.Set(reflect.ValueOf(number))
+	default:
+		return errors.Collection(errors.Errorf(
+			errors.CodeInternal, ctx, "Cannot assign string to %T", output,
+		))
+	}
+
+	return nil
+}
+
+// Evaluate performs a validation of a RuleSet against a string and returns a ValidationErrorCollection.
+// The value is normalized the same way as Apply before the rules are evaluated.
+func (ruleSet *PhoneRuleSet) Evaluate(ctx context.Context, value string) errors.ValidationErrorCollection {
+	number, err := ruleSet.normalize(ctx, value)
+	if err != nil {
+		return errors.Collection(err)
+	}
+
+	return ruleSet.evaluateRules(ctx, number)
+}
+
+// evaluateRules evaluates the custom rules against the normalized number.
+func (ruleSet *PhoneRuleSet) evaluateRules(ctx context.Context, number string) errors.ValidationErrorCollection {
+	allErrors := errors.Collection()
+
+	currentRuleSet := ruleSet
+	ctx = rulecontext.WithRuleSet(ctx, ruleSet)
+
+	for currentRuleSet != nil {
+		if currentRuleSet.rule != nil {
+			if errs := currentRuleSet.rule.Evaluate(ctx, number); errs != nil {
+				allErrors = append(allErrors, errs...)
+			}
+		}
+
+		currentRuleSet = currentRuleSet.parent
+	}
+
+	if len(allErrors) > 0 {
+		return allErrors
+	} else {
+		return nil
+	}
+}
+
+// noConflict returns the new array rule set with all conflicting rules removed.
+// Does not mutate the existing rule sets.
+func (ruleSet *PhoneRuleSet) noConflict(rule rules.Rule[string]) *PhoneRuleSet {
+	if ruleSet.rule != nil {
+
+		// Conflicting rules, skip this and return the parent
+		if rule.Conflict(ruleSet.rule) {
+			return ruleSet.parent.noConflict(rule)
+		}
+
+	}
+
+	if ruleSet.parent == nil {
+		return ruleSet
+	}
+
+	newParent := ruleSet.parent.noConflict(rule)
+
+	if newParent == ruleSet.parent {
+		return ruleSet
+	}
+
+	newRuleSet := newParent.withParent()
+	newRuleSet.required = ruleSet.required
+	newRuleSet.withNil = ruleSet.withNil
+	newRuleSet.region = ruleSet.region
+	newRuleSet.rule = ruleSet.rule
+	newRuleSet.label = ruleSet.label
+	return newRuleSet
+}
+
+// WithRule returns a new child rule set with a rule added to the list of
+// rules to evaluate. WithRule takes an implementation of the Rule interface
+// for the string type.
+//
+// Rules are passed the normalized E.164 number and are only evaluated if it is valid.
+//
+// Use this when implementing custom rules.
+func (ruleSet *PhoneRuleSet) WithRule(rule rules.Rule[string]) *PhoneRuleSet {
+	newRuleSet := ruleSet.noConflict(rule).withParent()
+	newRuleSet.rule = rule
+	return newRuleSet
+}
+
+// WithRuleFunc returns a new child rule set with a rule added to the list of
+// rules to evaluate. WithRuleFunc takes an implementation of the Rule interface
+// for the string type.
+//
+// Use this when implementing custom rules.
+func (v *PhoneRuleSet) WithRuleFunc(rule rules.RuleFunc[string]) *PhoneRuleSet {
+	return v.WithRule(rule)
+}
+
+// Any returns a new RuleSet that wraps the phone RuleSet in any Any rule set
+// which can then be used in nested validation.
+func (ruleSet *PhoneRuleSet) Any() rules.RuleSet[any] {
+	return rules.WrapAny[string](ruleSet)
+}
+
+// String returns a string representation of the rule set suitable for debugging.
+func (ruleSet *PhoneRuleSet) String() string {
+	label := ruleSet.label
+
+	if label == "" {
+		if ruleSet.rule != nil {
+			label = ruleSet.rule.String()
+		}
+	}
+
+	if ruleSet.parent != nil {
+		return ruleSet.parent.String() + "." + label
+	}
+	return label
+}
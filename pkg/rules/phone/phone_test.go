@@ -0,0 +1,121 @@
+package phone_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules/phone"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Default configuration doesn't return errors on valid value.
+// - Implements interface.
+func TestPhoneRuleSet(t *testing.T) {
+	var output string
+
+	if err := phone.Phone().Apply(context.TODO(), "+14155552671", &output); err != nil {
+		t.Fatalf("Expected errors to be empty, got: %s", err)
+	}
+	if output != "+14155552671" {
+		t.Errorf("Expected output to be +14155552671, got: %s", output)
+	}
+
+	if ok := testhelpers.CheckRuleSetInterface[string](phone.Phone()); !ok {
+		t.Error("Expected rule set to be implemented")
+	}
+}
+
+// Requirements:
+// - Spaces, dashes, dots and parentheses are removed from the output.
+// - Numbers without a plus sign, with a leading zero country code or with more than 15 digits are pattern errors.
+// - Non-strings are type errors.
+func TestPhoneE164(t *testing.T) {
+	ruleSet := phone.Phone()
+
+	valid := map[string]string{
+		"+1 (415) 555-2671": "+14155552671",
+		"+44 20 7946 0958":  "+442079460958",
+		"+49.30.1234567":    "+49301234567",
+	}
+	for input, expected := range valid {
+		var output string
+		if err := ruleSet.Apply(context.Background(), input, &output); err != nil {
+			t.Errorf("Expected %s to be valid, got: %s", input, err)
+		} else if output != expected {
+			t.Errorf("Expected %s to be normalized to %s, got: %s", input, expected, output)
+		}
+	}
+
+	for _, input := range []string{"4155552671", "+04155552671", "+1234567890123456", "+1 415 CALL NOW", "+", ""} {
+		testhelpers.MustNotApply(t, ruleSet.Any(), input, errors.CodePattern)
+	}
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), 14155552671, errors.CodeType)
+}
+
+// Requirements:
+// - National numbers are converted using the default calling code.
+// - A leading trunk zero is removed.
+// - International numbers are unchanged.
+// - Serializes to WithDefaultRegion("44").
+// - Panics on an invalid calling code.
+func TestPhoneWithDefaultRegion(t *testing.T) {
+	ruleSet := phone.Phone().WithDefaultRegion("+44")
+
+	cases := map[string]string{
+		"020 7946 0958":   "+442079460958",
+		"20 7946 0958":    "+442079460958",
+		"+1 415 555 2671": "+14155552671",
+	}
+	for input, expected := range cases {
+		var output string
+		if err := ruleSet.Apply(context.Background(), input, &output); err != nil {
+			t.Errorf("Expected %s to be valid, got: %s", input, err)
+		} else if output != expected {
+			t.Errorf("Expected %s to be normalized to %s, got: %s", input, expected, output)
+		}
+	}
+
+	expected := `PhoneRuleSet.WithDefaultRegion("44")`
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic")
+		}
+	}()
+
+	phone.Phone().WithDefaultRegion("GB")
+}
+
+// Requirements:
+// - Custom rules are passed the normalized number.
+// - Evaluate normalizes the same way as Apply.
+// - Required is kept by child rule sets.
+func TestPhoneCustom(t *testing.T) {
+	ruleSet := phone.Phone().WithRequired().WithRuleFunc(func(ctx context.Context, value string) errors.ValidationErrorCollection {
+		if !strings.HasPrefix(value, "+1") {
+			return errors.Collection(errors.Errorf(errors.CodeNotAllowed, ctx, "only NANP numbers are allowed"))
+		}
+		return nil
+	})
+
+	if errs := ruleSet.Evaluate(context.Background(), "+1 415-555-2671"); errs != nil {
+		t.Errorf("Expected errors to be nil, got: %s", errs)
+	}
+	testhelpers.MustNotApply(t, ruleSet.Any(), "+44 20 7946 0958", errors.CodeNotAllowed)
+
+	if !ruleSet.Required() {
+		t.Error("Expected rule set to be required")
+	}
+
+	expected := "PhoneRuleSet.WithRequired().WithRuleFunc(...)"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
@@ -1,12 +1,27 @@
 package rules
 
 import (
+	"context"
 	"errors"
 )
 
 // refTracker[T] represents a structure to track references and their dependencies.
 type refTracker[T comparable] struct {
-	edges map[T][]T // edges represent the directed graph of dependencies.
+	edges       map[T][]T              // edges represent the directed graph of dependencies.
+	dynamic     []dynamicRef[T]        // dynamic holds dependencies for dynamic keys.
+	dynamicDeps []dynamicDependency[T] // dynamicDeps holds dependencies on dynamic keys.
+}
+
+// dynamicRef represents a dependency from any key matching a dynamic key rule.
+type dynamicRef[T comparable] struct {
+	keyRule   Rule[T]
+	dependsOn T
+}
+
+// dynamicDependency represents a dependency from a key to every key matching a dynamic key rule.
+type dynamicDependency[T comparable] struct {
+	key           T
+	dependsOnRule Rule[T]
 }
 
 // newRefTracker initializes and returns a new refTracker[T].
@@ -18,36 +33,151 @@ func newRefTracker[T comparable]() *refTracker[T] {
 
 // Add adds a new dependency between key and dependsOnKey.
 // It returns an error if adding this dependency results in a circular reference.
+//
+// Either the key or the dependency may be dynamic, in which case the dependency applies to every key that matches
+// the key rule. A dynamic key may not depend on another dynamic key since there is no way to know if a single key
+// matches both rules.
 func (rt *refTracker[T]) Add(keyRule, dependsOnKeyRule Rule[T]) error {
+	constKeyRule, keyIsConstant := exactConstant(keyRule)
+	constDependsOnKeyRule, dependsOnKeyIsConstant := exactConstant(dependsOnKeyRule)
+
+	switch {
+	case !keyIsConstant && !dependsOnKeyIsConstant:
+		return errors.New("dynamic keys can not depend on other dynamic keys")
+
+	case !dependsOnKeyIsConstant:
+		key := constKeyRule.Value()
+
+		// A key may not depend on a dynamic key that matches it.
+		if dependsOnKeyRule.Evaluate(context.Background(), key) == nil {
+			return errors.New("circular reference detected")
+		}
+
+		rt.dynamicDeps = append(rt.dynamicDeps, dynamicDependency[T]{key, dependsOnKeyRule})
+		rt.addNode(key)
+
+		// Apply the dependency to all known keys that match the dynamic key.
+		for _, node := range rt.nodes() {
+			if dependsOnKeyRule.Evaluate(context.Background(), node) == nil {
+				rt.addEdge(key, node)
+			}
+		}
+
+	case !keyIsConstant:
+		dependsOnKey := constDependsOnKeyRule.Value()
+
+		// A dynamic key may not depend on a key it matches.
+		if keyRule.Evaluate(context.Background(), dependsOnKey) == nil {
+			return errors.New("circular reference detected")
+		}
+
+		rt.dynamic = append(rt.dynamic, dynamicRef[T]{keyRule, dependsOnKey})
+		rt.addNode(dependsOnKey)
 
-	// For now both key and depends on must be constants
-	constKeyRule, keyIsConstant := keyRule.(*ConstantRuleSet[T])
-	constDependsOnKeyRule, dependsOnKeyIsConstant := dependsOnKeyRule.(*ConstantRuleSet[T])
+		// Apply the dependency to all known keys that match the dynamic key.
+		for _, node := range rt.nodes() {
+			if keyRule.Evaluate(context.Background(), node) == nil {
+				rt.addEdge(node, dependsOnKey)
+			}
+		}
 
-	if !keyIsConstant || !dependsOnKeyIsConstant {
-		return errors.New("conditional rules do not support dynamic keys at this time")
+	default:
+		rt.addEdge(constKeyRule.Value(), constDependsOnKeyRule.Value())
 	}
 
-	key := constKeyRule.Value()
-	dependsOnKey := constDependsOnKeyRule.Value()
+	return rt.checkCycles()
+}
 
-	// Initialize the key in the map if it doesn't exist.
-	if _, exists := rt.edges[key]; !exists {
-		rt.edges[key] = []T{}
+// addNode adds a key to the graph if it does not already exist and applies any dynamic dependencies that match it.
+func (rt *refTracker[T]) addNode(node T) {
+	if _, exists := rt.edges[node]; exists {
+		return
+	}
+	rt.edges[node] = []T{}
+
+	for _, ref := range rt.dynamic {
+		if ref.keyRule.Evaluate(context.Background(), node) == nil {
+			rt.addEdge(node, ref.dependsOn)
+		}
 	}
+
+	for _, ref := range rt.dynamicDeps {
+		if node != ref.key && ref.dependsOnRule.Evaluate(context.Background(), node) == nil {
+			rt.addEdge(ref.key, node)
+		}
+	}
+}
+
+// addEdge adds a dependency between key and dependsOnKey if it does not already exist.
+func (rt *refTracker[T]) addEdge(key, dependsOnKey T) {
+	rt.addNode(key)
+	rt.addNode(dependsOnKey)
+
+	for _, existing := range rt.edges[key] {
+		if existing == dependsOnKey {
+			return
+		}
+	}
+
 	// Add the dependency.
 	rt.edges[key] = append(rt.edges[key], dependsOnKey)
+}
 
-	// Check for circular references.
+// nodes returns all keys that appear in the graph.
+func (rt *refTracker[T]) nodes() []T {
+	seen := make(map[T]bool)
+	nodes := make([]T, 0, len(rt.edges))
+
+	for key, dependencies := range rt.edges {
+		for _, node := range append([]T{key}, dependencies...) {
+			if !seen[node] {
+				seen[node] = true
+				nodes = append(nodes, node)
+			}
+		}
+	}
+	return nodes
+}
+
+// checkCycles returns an error if there are any circular references in the graph.
+func (rt *refTracker[T]) checkCycles() error {
 	visited := make(map[T]bool)
 	stack := make(map[T]bool)
 
-	if rt.hasCycle(key, visited, stack) {
-		return errors.New("circular reference detected")
+	for key := range rt.edges {
+		if rt.hasCycle(key, visited, stack) {
+			return errors.New("circular reference detected")
+		}
+	}
+
+	// Keys that only appear in the input are not in the graph. If a key depends on a dynamic key and any key matching
+	// another dynamic key depends on it, an input key that matches both would wait on itself. Since there is no way to
+	// know if the rules overlap this is always treated as a cycle.
+	for _, ref := range rt.dynamic {
+		for _, dep := range rt.dynamicDeps {
+			if ref.dependsOn == dep.key || rt.reaches(ref.dependsOn, dep.key, make(map[T]bool)) {
+				return errors.New("circular reference detected")
+			}
+		}
 	}
 	return nil
 }
 
+// reaches returns true if the target can be reached by following the dependencies of the node.
+func (rt *refTracker[T]) reaches(node, target T, visited map[T]bool) bool {
+	if visited[node] {
+		return false
+	}
+	visited[node] = true
+
+	for _, child := range rt.edges[node] {
+		if child == target || rt.reaches(child, target, visited) {
+			return true
+		}
+	}
+	return false
+}
+
 // hasCycle recursively checks for cycles in the graph using depth-first search.
 // It returns true if a cycle is detected.
 func (rt *refTracker[T]) hasCycle(node T, visited, stack map[T]bool) bool {
@@ -88,5 +218,8 @@ func (rt *refTracker[T]) Clone() *refTracker[T] {
 		clone.edges[key] = clonedValues
 	}
 
+	clone.dynamic = append(clone.dynamic, rt.dynamic...)
+	clone.dynamicDeps = append(clone.dynamicDeps, rt.dynamicDeps...)
+
 	return clone
 }
@@ -0,0 +1,41 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// registry holds the rule sets added with Register by name.
+var registry sync.Map
+
+// Register adds a named rule set that can be referenced from the "rules" annotation of struct fields.
+// Rule sets are usually registered from an init function so they exist before any Struct rule sets are created:
+//
+//	func init() {
+//		rules.Register("email", net.Email().Any())
+//	}
+//
+// See Struct for the annotation format.
+//
+// This function panics if the name is empty, contains a comma or surrounding spaces, is already registered or if the rule set is nil.
+func Register(name string, ruleSet RuleSet[any]) {
+	if name == "" || name != strings.TrimSpace(name) || strings.Contains(name, ",") {
+		panic(fmt.Errorf("invalid rule set name: %q", name))
+	}
+	if ruleSet == nil {
+		panic(fmt.Errorf("expected rule set to not be nil"))
+	}
+	if _, loaded := registry.LoadOrStore(name, ruleSet); loaded {
+		panic(fmt.Errorf("rule set already registered for name: %q", name))
+	}
+}
+
+// Registered returns the rule set registered for the name and a boolean indicating if one was found.
+func Registered(name string) (RuleSet[any], bool) {
+	ruleSet, ok := registry.Load(name)
+	if !ok {
+		return nil, false
+	}
+	return ruleSet.(RuleSet[any]), true
+}
@@ -0,0 +1,104 @@
+package rules_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+func init() {
+	rules.Register("test-code", rules.String().WithMinLen(3).Any())
+	rules.Register("test-lower", rules.String().WithRegexpString("^[a-z]*$", "must be lowercase").Any())
+}
+
+type registryStruct struct {
+	Code  string `validate:"code" rules:"test-code, test-lower"`
+	Label string `rules:"test-code"`
+}
+
+// Requirements:
+// - Rule sets named in the rules annotation are applied to the key.
+// - The field name is used when the annotation has no key.
+// - Rule sets added with WithKey are evaluated in addition to registered rule sets.
+// - Registered returns the rule set.
+func TestRegistry(t *testing.T) {
+	ruleSet := rules.Struct[registryStruct]()
+
+	testhelpers.MustApplyMutation(t, ruleSet.Any(), map[string]any{"code": "abc", "Label": "xyz"}, registryStruct{Code: "abc", Label: "xyz"})
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"code": "ab"}, errors.CodeMin)
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"code": "ABC"}, errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"Label": "x"}, errors.CodeMin)
+
+	withKey := ruleSet.WithKey("code", rules.String().WithMaxLen(4).Any())
+	testhelpers.MustNotApply(t, withKey.Any(), map[string]any{"code": "abcde"}, errors.CodeMax)
+	testhelpers.MustNotApply(t, withKey.Any(), map[string]any{"code": "ab"}, errors.CodeMin)
+
+	if _, ok := rules.Registered("test-code"); !ok {
+		t.Error("Expected rule set to be registered")
+	}
+	if _, ok := rules.Registered("test-missing"); ok {
+		t.Error("Expected rule set to not be registered")
+	}
+}
+
+// Requirements:
+// - Struct panics if the "rules" annotation names a rule set that is not registered.
+// - The panic names the rule set and the field.
+func TestRegistryMissing(t *testing.T) {
+	type missingStruct struct {
+		Code string `validate:"code" rules:"test-missing,test-code"`
+	}
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Error("Expected panic")
+		} else if msg := fmt.Sprint(r); !strings.Contains(msg, "test-missing") || !strings.Contains(msg, "Code") {
+			t.Errorf("Expected panic to name the rule set and field, got: %s", msg)
+		}
+	}()
+
+	rules.Struct[missingStruct]()
+}
+
+// Requirements:
+// - Comma separated "validate" annotations from other libraries are key mappings and do not panic.
+func TestRegistryThirdPartyTags(t *testing.T) {
+	type thirdPartyStruct struct {
+		Name  string `validate:"name,omitempty"`
+		Email string `validate:"required,email"`
+	}
+
+	ruleSet := rules.Struct[thirdPartyStruct]().
+		WithKey("name,omitempty", rules.String().Any())
+
+	testhelpers.MustApplyMutation(t, ruleSet.Any(), map[string]any{"name,omitempty": "abc"}, thirdPartyStruct{Name: "abc"})
+	testhelpers.MustNotApply(t, ruleSet.Any(), map[string]any{"name": "abc"}, errors.CodeUnexpected)
+}
+
+// Requirements:
+// - Register panics for invalid names, duplicate names and nil rule sets.
+func TestRegisterPanics(t *testing.T) {
+	tests := map[string]func(){
+		"empty":     func() { rules.Register("", rules.Any()) },
+		"comma":     func() { rules.Register("a,b", rules.Any()) },
+		"spaces":    func() { rules.Register(" a", rules.Any()) },
+		"duplicate": func() { rules.Register("test-code", rules.Any()) },
+		"nil":       func() { rules.Register("test-nil", nil) },
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Expected panic")
+				}
+			}()
+			fn()
+		})
+	}
+}
@@ -40,12 +40,15 @@ func (r Rounding) String() string {
 // If the number is not within tolerance (1e-9) of a whole number, an error will be returned.
 func (v *IntRuleSet[T]) WithRounding(rounding Rounding) *IntRuleSet[T] {
 	return &IntRuleSet[T]{
-		strict:   v.strict,
-		parent:   v,
-		base:     v.base,
-		required: v.required,
-		rounding: rounding,
-		label:    fmt.Sprintf("WithRounding(%s)", rounding.String()),
+		strict:      v.strict,
+		strictType:  v.strictType,
+		parent:      v,
+		base:        v.base,
+		required:    v.required,
+		withNil:     v.withNil,
+		errorConfig: v.errorConfig,
+		rounding:    rounding,
+		label:       fmt.Sprintf("WithRounding(%s)", rounding.String()),
 	}
 }
 
@@ -57,11 +60,13 @@ func (v *IntRuleSet[T]) WithRounding(rounding Rounding) *IntRuleSet[T] {
 // - For best results, consider using int for your math and data storage/transfer.
 func (v *FloatRuleSet[T]) WithRounding(rounding Rounding, precision int) *FloatRuleSet[T] {
 	return &FloatRuleSet[T]{
-		strict:    v.strict,
-		parent:    v,
-		required:  v.required,
-		rounding:  rounding,
-		precision: precision,
-		label:     fmt.Sprintf("WithRounding(%s, %d)", rounding.String(), precision),
+		strict:      v.strict,
+		parent:      v,
+		required:    v.required,
+		withNil:     v.withNil,
+		errorConfig: v.errorConfig,
+		rounding:    rounding,
+		precision:   precision,
+		label:       fmt.Sprintf("WithRounding(%s, %d)", rounding.String(), precision),
 	}
 }
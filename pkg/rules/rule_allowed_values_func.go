@@ -0,0 +1,69 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// allowedValuesFuncRule implements Rule for a list of allowed values that is resolved when the rule is evaluated.
+type allowedValuesFuncRule[T comparable] struct {
+	fn func(ctx context.Context) []T
+}
+
+// newAllowedValuesFuncRule returns a new rule for the function. This function panics if fn is nil.
+func newAllowedValuesFuncRule[T comparable](fn func(ctx context.Context) []T) *allowedValuesFuncRule[T] {
+	if fn == nil {
+		panic(fmt.Errorf("expected allowed values function to not be nil"))
+	}
+	return &allowedValuesFuncRule[T]{fn: fn}
+}
+
+// Evaluate calls the function to get the allowed values and returns an error if the value is not one of them.
+// The number of allowed values is included in the error metadata as "allowed". The values themselves are not.
+func (rule *allowedValuesFuncRule[T]) Evaluate(ctx context.Context, value T) errors.ValidationErrorCollection {
+	values := rule.fn(ctx)
+	if slices.Contains(values, value) {
+		return nil
+	}
+
+	err := errors.Errorf(errors.CodeNotAllowed, ctx, "field value is not allowed")
+	return errors.Collection(errors.WithMeta(err, map[string]any{"allowed": len(values)}))
+}
+
+// Conflict returns true for other allowed values function rules.
+func (rule *allowedValuesFuncRule[T]) Conflict(x Rule[T]) bool {
+	_, ok := x.(*allowedValuesFuncRule[T])
+	return ok
+}
+
+// String returns the string representation of the rule.
+// Example: WithAllowedValuesFunc(<func>)
+func (rule *allowedValuesFuncRule[T]) String() string {
+	return "WithAllowedValuesFunc(<func>)"
+}
+
+// WithAllowedValuesFunc returns a new child RuleSet that only allows the values returned by fn. The function is
+// called each time the rule is evaluated with the validation context so the list can depend on the request, such
+// as a per tenant allowlist stored on the context.
+//
+// Values that are not in the list return an errors.CodeNotAllowed error. The metadata of the error, available
+// through errors.MetaOf, has the number of allowed values as "allowed". The values themselves are not included.
+//
+// If this method is called more than once only the most recent function is used. Values must also be allowed by
+// WithAllowedValues if it is set. A function that returns an empty list allows nothing.
+//
+// This method panics if fn is nil.
+func (ruleSet *StringRuleSet) WithAllowedValuesFunc(fn func(ctx context.Context) []string) *StringRuleSet {
+	return ruleSet.WithRule(newAllowedValuesFuncRule(fn))
+}
+
+// WithAllowedValuesFunc returns a new child RuleSet that only allows the values returned by fn. The function is
+// called each time the rule is evaluated with the validation context so the list can depend on the request.
+//
+// It behaves the same as StringRuleSet.WithAllowedValuesFunc.
+func (ruleSet *IntRuleSet[T]) WithAllowedValuesFunc(fn func(ctx context.Context) []T) *IntRuleSet[T] {
+	return ruleSet.WithRule(newAllowedValuesFuncRule(fn))
+}
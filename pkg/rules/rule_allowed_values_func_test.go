@@ -0,0 +1,78 @@
+package rules_test
+
+import (
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// tenantContextKey is the context key for the tenant allowlist in tests.
+type tenantContextKey struct{}
+
+// Requirements:
+// - The allowed values are read from the context each time.
+// - Values that are not allowed return a not allowed error with the number of allowed values.
+// - Only the most recent function is used.
+// - Serializes to WithAllowedValuesFunc(<func>).
+func TestStringWithAllowedValuesFunc(t *testing.T) {
+	fromContext := func(ctx context.Context) []string {
+		values, _ := ctx.Value(tenantContextKey{}).([]string)
+		return values
+	}
+	ruleSet := rules.String().WithAllowedValuesFunc(func(context.Context) []string { return nil }).WithAllowedValuesFunc(fromContext)
+
+	tenantA := context.WithValue(context.Background(), tenantContextKey{}, []string{"red", "green"})
+	tenantB := context.WithValue(context.Background(), tenantContextKey{}, []string{"blue"})
+
+	var out string
+	if errs := ruleSet.Apply(tenantA, "red", &out); errs != nil {
+		t.Errorf("Expected errors to be nil, got: %s", errs)
+	}
+
+	errs := ruleSet.Apply(tenantB, "red", &out)
+	if len(errs) != 1 || errs.First().Code() != errors.CodeNotAllowed {
+		t.Fatalf("Expected a not allowed error, got: %s", errs)
+	}
+	if allowed := errors.MetaOf(errs.First())["allowed"]; allowed != 1 {
+		t.Errorf("Expected 1 allowed value in the metadata, got: %v", allowed)
+	}
+
+	if errs := ruleSet.Apply(context.Background(), "red", &out); errs == nil {
+		t.Error("Expected an error when there are no allowed values")
+	}
+
+	expected := "StringRuleSet.WithAllowedValuesFunc(<func>)"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Works for integer rule sets.
+// - Static allowed values must also pass.
+// - Panics on a nil function.
+func TestIntWithAllowedValuesFunc(t *testing.T) {
+	ruleSet := rules.Int().WithAllowedValues(1, 2, 3).WithAllowedValuesFunc(func(context.Context) []int {
+		return []int{2, 3, 4}
+	})
+
+	var out int
+	if errs := ruleSet.Apply(context.Background(), 2, &out); errs != nil {
+		t.Errorf("Expected errors to be nil, got: %s", errs)
+	}
+	for _, value := range []int{1, 4} {
+		if errs := ruleSet.Apply(context.Background(), value, &out); len(errs) != 1 || errs.First().Code() != errors.CodeNotAllowed {
+			t.Errorf("Expected a not allowed error for %d, got: %s", value, errs)
+		}
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic")
+		}
+	}()
+
+	rules.Int().WithAllowedValuesFunc(nil)
+}
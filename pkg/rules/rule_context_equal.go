@@ -0,0 +1,57 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// contextEqualRule implements Rule and requires the value to equal a value stored in the context.
+type contextEqualRule[T comparable] struct {
+	key any
+}
+
+// WithContextEqual returns a rule that requires the value to equal the value stored in the context for ctxKey.
+// This lets validation double as a lightweight authorization check, such as requiring a tenant ID in the input
+// to match the tenant of the current request:
+//
+//	var TenantKey = rulecontext.Key[string]("tenant")
+//
+//	ruleSet := rules.String().WithRule(rules.WithContextEqual[string](TenantKey))
+//	errs := ruleSet.Apply(rulecontext.WithValue(ctx, TenantKey, "acme"), input, &output)
+//
+// Any key accepted by context.WithValue may be used, including keys returned by rulecontext.Key.
+//
+// If the context has no value of type T for the key, or the value does not match, a CodeForbidden error is
+// returned. The error is flagged as a permission error so it can be told apart from invalid values with
+// errors.IsPermission or ValidationErrorCollection.PermissionErrors.
+//
+// This function panics if ctxKey is nil.
+func WithContextEqual[T comparable](ctxKey any) Rule[T] {
+	if ctxKey == nil {
+		panic(fmt.Errorf("expected context key to not be nil"))
+	}
+	return &contextEqualRule[T]{key: ctxKey}
+}
+
+// Evaluate returns a permission error if the value does not equal the context value.
+func (rule *contextEqualRule[T]) Evaluate(ctx context.Context, value T) errors.ValidationErrorCollection {
+	if expected, ok := ctx.Value(rule.key).(T); ok && expected == value {
+		return nil
+	}
+
+	return errors.Collection(errors.NewPermissionError(ctx, "field value is not permitted"))
+}
+
+// Conflict returns true for other context equal rules with the same key.
+func (rule *contextEqualRule[T]) Conflict(x Rule[T]) bool {
+	other, ok := x.(*contextEqualRule[T])
+	return ok && other.key == rule.key
+}
+
+// String returns the string representation of the context equal rule.
+// Example: WithContextEqual(tenant)
+func (rule *contextEqualRule[T]) String() string {
+	return fmt.Sprintf("WithContextEqual(%v)", rule.key)
+}
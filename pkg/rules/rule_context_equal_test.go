@@ -0,0 +1,87 @@
+package rules_test
+
+import (
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Passes when the value equals the context value.
+// - Fails with a permission error when the value does not match or the context has no value.
+// - Serializes to WithContextEqual(...).
+func TestWithContextEqual(t *testing.T) {
+	tenantKey := rulecontext.Key[string]("tenant")
+	ruleSet := rules.String().WithRule(rules.WithContextEqual[string](tenantKey))
+	ctx := rulecontext.WithValue(context.Background(), tenantKey, "acme")
+
+	var output string
+	if errs := ruleSet.Apply(ctx, "acme", &output); errs != nil {
+		t.Errorf("Expected errors to be nil, got: %s", errs)
+	}
+
+	errs := ruleSet.Apply(ctx, "globex", &output)
+	if errs == nil {
+		t.Fatal("Expected errors to not be nil")
+	} else if err := errs.First(); err.Code() != errors.CodeForbidden {
+		t.Errorf("Expected error code to be %s, got: %s", errors.CodeForbidden, err.Code())
+	} else if p, ok := err.(interface{ Permission() bool }); !ok || !p.Permission() {
+		t.Error("Expected Permission() to be true")
+	}
+
+	if errs.PermissionErrors() == nil {
+		t.Error("Expected permission errors to not be nil")
+	}
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), "acme", errors.CodeForbidden)
+
+	expected := "StringRuleSet.WithContextEqual(tenant)"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Panics if the key is nil.
+func TestWithContextEqualNilKey(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic")
+		}
+	}()
+
+	rules.WithContextEqual[string](nil)
+}
+
+// Requirements:
+// - Permission errors fail object validation like any other error.
+// - Callers can split permission errors from validation errors and the paths are kept.
+func TestObjectPermissionErrors(t *testing.T) {
+	tenantKey := rulecontext.Key[string]("tenant")
+	ruleSet := rules.StringMap[any]().
+		WithKey("tenant", rules.String().WithRule(rules.WithContextEqual[string](tenantKey)).Any()).
+		WithKey("name", rules.String().WithMinLen(3).Any())
+	ctx := rulecontext.WithValue(context.Background(), tenantKey, "acme")
+
+	var output map[string]any
+	errs := ruleSet.Apply(ctx, map[string]any{"tenant": "globex", "name": "x"}, &output)
+	if !errs.HasErrors() {
+		t.Fatal("Expected errors")
+	}
+
+	if permissions := errs.PermissionErrors(); permissions.Size() != 1 || permissions.First().Path() != "/tenant" {
+		t.Errorf("Expected one permission error at /tenant, got: %v", permissions)
+	}
+	if validations := errs.ValidationErrors(); validations.Size() != 1 || validations.First().Path() != "/name" {
+		t.Errorf("Expected one validation error at /name, got: %v", validations)
+	}
+
+	errs = ruleSet.Apply(ctx, map[string]any{"tenant": "globex", "name": "widget"}, &output)
+	if errs == nil || errs.ValidationErrors() != nil {
+		t.Errorf("Expected only a permission error, got: %v", errs)
+	}
+}
@@ -15,7 +15,7 @@ type maxLenRule[TV any, T lengthy[TV]] struct {
 
 // Evaluate takes a context and array/slice value and returns an error if it is not equal or lower in length than the specified value.
 func (rule *maxLenRule[TV, T]) Evaluate(ctx context.Context, value T) errors.ValidationErrorCollection {
-	if len(value) > rule.max {
+	if lengthOf[TV](ctx, value) > rule.max {
 		return errors.Collection(
 			errors.Errorf(errors.CodeMax, ctx, rule.msg, rule.max),
 		)
@@ -44,9 +44,32 @@ func (v *SliceRuleSet[T]) WithMaxLen(max int) *SliceRuleSet[T] {
 }
 
 // WithMaxLen returns a new child RuleSet that is constrained to the provided maximum string length.
+// Length is measured in bytes by default. Use WithRuneLength to measure in Unicode code points.
 func (v *StringRuleSet) WithMaxLen(max int) *StringRuleSet {
 	return v.WithRule(&maxLenRule[any, string]{
 		max,
 		"value must be at most %d characters long",
 	})
 }
+
+// MaxLen returns the maximum string length and true if one is set. This is useful for generating documentation
+// or schemas.
+func (v *StringRuleSet) MaxLen() (int, bool) {
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if rule, ok := currentRuleSet.rule.(*maxLenRule[any, string]); ok {
+			return rule.max, true
+		}
+	}
+	return 0, false
+}
+
+// MaxLen returns the maximum list length and true if one is set. This is useful for generating documentation
+// or schemas.
+func (v *SliceRuleSet[T]) MaxLen() (int, bool) {
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if rule, ok := currentRuleSet.rule.(*maxLenRule[T, []T]); ok {
+			return rule.max, true
+		}
+	}
+	return 0, false
+}
@@ -15,7 +15,7 @@ type minLenRule[TV any, T lengthy[TV]] struct {
 
 // Evaluate takes a context and array/slice value and returns an error if it is not equal or lower in length than the specified value.
 func (rule *minLenRule[TV, T]) Evaluate(ctx context.Context, value T) errors.ValidationErrorCollection {
-	if len(value) < rule.min {
+	if lengthOf[TV](ctx, value) < rule.min {
 		return errors.Collection(
 			errors.Errorf(errors.CodeMin, ctx, rule.msg, rule.min),
 		)
@@ -44,9 +44,32 @@ func (v *SliceRuleSet[T]) WithMinLen(min int) *SliceRuleSet[T] {
 }
 
 // WithMinLen returns a new child RuleSet that is constrained to the provided minimum string length.
+// Length is measured in bytes by default. Use WithRuneLength to measure in Unicode code points.
 func (v *StringRuleSet) WithMinLen(min int) *StringRuleSet {
 	return v.WithRule(&minLenRule[any, string]{
 		min,
 		"value must be at least %d characters long",
 	})
 }
+
+// MinLen returns the minimum string length and true if one is set. This is useful for generating documentation
+// or schemas.
+func (v *StringRuleSet) MinLen() (int, bool) {
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if rule, ok := currentRuleSet.rule.(*minLenRule[any, string]); ok {
+			return rule.min, true
+		}
+	}
+	return 0, false
+}
+
+// MinLen returns the minimum list length and true if one is set. This is useful for generating documentation
+// or schemas.
+func (v *SliceRuleSet[T]) MinLen() (int, bool) {
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if rule, ok := currentRuleSet.rule.(*minLenRule[T, []T]); ok {
+			return rule.min, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// retryRule implements Rule and retries the wrapped rule when it reports a transient failure.
+type retryRule[T any] struct {
+	inner    Rule[T]
+	attempts int
+	backoff  time.Duration
+}
+
+// WithRetry wraps a rule so that it is evaluated up to attempts times when it returns an errors.CodeUnavailable
+// error. This is intended for rules that call an external service where a failed lookup says nothing about the
+// value. Rules should return CodeUnavailable for transient failures and their usual codes for invalid values.
+//
+// Results without a CodeUnavailable error, including other validation errors, are returned right away. If every
+// attempt is unavailable the result of the last attempt is returned.
+//
+// The wait before the second attempt is the backoff and it doubles after each attempt. If the context is done
+// while waiting, a CodeTimeout or CodeCancelled error is returned instead.
+//
+// This function panics if attempts is less than 1 or the backoff is negative.
+func WithRetry[T any](inner Rule[T], attempts int, backoff time.Duration) Rule[T] {
+	if attempts < 1 {
+		panic(fmt.Errorf("attempts must be at least 1, got: %d", attempts))
+	}
+	if backoff < 0 {
+		panic(fmt.Errorf("backoff must not be negative, got: %s", backoff))
+	}
+
+	return &retryRule[T]{
+		inner:    inner,
+		attempts: attempts,
+		backoff:  backoff,
+	}
+}
+
+// Evaluate evaluates the wrapped rule until it does not return a CodeUnavailable error or the attempts run out.
+func (rule *retryRule[T]) Evaluate(ctx context.Context, value T) errors.ValidationErrorCollection {
+	wait := rule.backoff
+
+	for attempt := 1; ; attempt++ {
+		errs := rule.inner.Evaluate(ctx, value)
+		if attempt >= rule.attempts || !unavailable(errs) {
+			return errs
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Collection(contextErrorToValidation(ctx))
+		case <-timer.C:
+		}
+
+		wait *= 2
+	}
+}
+
+// unavailable returns true if any of the errors are transient.
+func unavailable(errs errors.ValidationErrorCollection) bool {
+	for _, err := range errs {
+		if err.Code() == errors.CodeUnavailable {
+			return true
+		}
+	}
+	return false
+}
+
+// Conflict returns true if the wrapped rule conflicts with the other rule or the rule it wraps.
+func (rule *retryRule[T]) Conflict(x Rule[T]) bool {
+	if other, ok := x.(*retryRule[T]); ok {
+		x = other.inner
+	}
+	return rule.inner.Conflict(x)
+}
+
+// String returns the string representation of the retry rule.
+// Example: WithRetry(WithRuleFunc(...), 3, 100ms)
+func (rule *retryRule[T]) String() string {
+	return fmt.Sprintf("WithRetry(%s, %d, %s)", rule.inner, rule.attempts, rule.backoff)
+}
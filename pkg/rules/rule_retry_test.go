@@ -0,0 +1,92 @@
+package rules_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// flakyRule returns a rule that is unavailable for the first failures calls and then returns result.
+func flakyRule(calls *int, failures int, result errors.ErrorCode) rules.RuleFunc[string] {
+	return func(ctx context.Context, value string) errors.ValidationErrorCollection {
+		*calls++
+		if *calls <= failures {
+			return errors.Collection(errors.Errorf(errors.CodeUnavailable, ctx, "lookup failed"))
+		}
+		if result != "" {
+			return errors.Collection(errors.Errorf(result, ctx, "not found"))
+		}
+		return nil
+	}
+}
+
+// Requirements:
+// - Unavailable errors are retried until the rule passes.
+// - Other errors are not retried.
+// - The last result is returned when the attempts run out.
+// - Serializes to WithRetry(...).
+func TestWithRetry(t *testing.T) {
+	calls := 0
+	ruleSet := rules.String().WithRule(rules.WithRetry[string](flakyRule(&calls, 2, ""), 3, time.Millisecond))
+	testhelpers.MustApply(t, ruleSet.Any(), "sku")
+	if calls != 3 {
+		t.Errorf("Expected 3 calls, got: %d", calls)
+	}
+
+	calls = 0
+	ruleSet = rules.String().WithRule(rules.WithRetry[string](flakyRule(&calls, 0, errors.CodeNotAllowed), 3, time.Millisecond))
+	testhelpers.MustNotApply(t, ruleSet.Any(), "sku", errors.CodeNotAllowed)
+	if calls != 1 {
+		t.Errorf("Expected 1 call, got: %d", calls)
+	}
+
+	calls = 0
+	ruleSet = rules.String().WithRule(rules.WithRetry[string](flakyRule(&calls, 5, ""), 2, time.Millisecond))
+	testhelpers.MustNotApply(t, ruleSet.Any(), "sku", errors.CodeUnavailable)
+	if calls != 2 {
+		t.Errorf("Expected 2 calls, got: %d", calls)
+	}
+
+	expected := "StringRuleSet.WithRetry(WithRuleFunc(...), 2, 1ms)"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - The context is respected between attempts.
+func TestWithRetry_Cancelled(t *testing.T) {
+	calls := 0
+	rule := rules.WithRetry[string](flakyRule(&calls, 5, ""), 5, time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	errs := rule.Evaluate(ctx, "sku")
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected the wait to end with the context, took %s", elapsed)
+	}
+	if len(errs) != 1 || errs.First().Code() != errors.CodeTimeout {
+		t.Errorf("Expected a timeout error, got: %s", errs)
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 call, got: %d", calls)
+	}
+}
+
+// Requirements:
+// - Panics if attempts is less than 1.
+func TestWithRetry_InvalidAttempts(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic")
+		}
+	}()
+
+	rules.WithRetry[string](rules.RuleFunc[string](nil), 0, time.Millisecond)
+}
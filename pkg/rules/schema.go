@@ -0,0 +1,397 @@
+package rules
+
+import (
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// JSONSchema is a JSON Schema fragment generated from a rule set.
+// It can be passed directly to json.Marshal.
+type JSONSchema map[string]any
+
+// JSONSchemaProvider is implemented by rule sets that can describe themselves as a JSON Schema.
+type JSONSchemaProvider interface {
+	JSONSchema() JSONSchema
+}
+
+// JSONSchemaFor returns the JSON Schema for a rule set. Rule sets that do not implement JSONSchemaProvider are
+// described using their string representation.
+func JSONSchemaFor(ruleSet any) JSONSchema {
+	if provider, ok := ruleSet.(JSONSchemaProvider); ok {
+		return provider.JSONSchema()
+	}
+	if stringer, ok := ruleSet.(fmt.Stringer); ok {
+		return JSONSchema{"description": stringer.String()}
+	}
+	return JSONSchema{}
+}
+
+// schemaBuilder is used to build a schema while walking a rule set from the newest rule to the oldest.
+type schemaBuilder struct {
+	schema       JSONSchema
+	descriptions []string
+}
+
+// newSchemaBuilder returns a new builder for the JSON type.
+// An empty type creates a schema without a type.
+func newSchemaBuilder(jsonType string) *schemaBuilder {
+	b := &schemaBuilder{schema: JSONSchema{}}
+	if jsonType != "" {
+		b.schema["type"] = jsonType
+	}
+	return b
+}
+
+// set sets the keyword unless it has already been set by a newer rule.
+func (b *schemaBuilder) set(keyword string, value any) {
+	if _, ok := b.schema[keyword]; !ok {
+		b.schema[keyword] = value
+	}
+}
+
+// allOf adds a sub schema that must also match.
+func (b *schemaBuilder) allOf(schema JSONSchema) {
+	existing, _ := b.schema["allOf"].([]JSONSchema)
+	b.schema["allOf"] = append(existing, schema)
+}
+
+// describe records a rule that cannot be represented so it can be included in the description.
+func (b *schemaBuilder) describe(rule fmt.Stringer) {
+	b.descriptions = append(b.descriptions, rule.String())
+}
+
+// build returns the schema with descriptions in the order the rules were added.
+func (b *schemaBuilder) build() JSONSchema {
+	if len(b.descriptions) > 0 {
+		for i, j := 0, len(b.descriptions)-1; i < j; i, j = i+1, j-1 {
+			b.descriptions[i], b.descriptions[j] = b.descriptions[j], b.descriptions[i]
+		}
+		b.schema["description"] = strings.Join(b.descriptions, ", ")
+	}
+	return b.schema
+}
+
+// JSONSchema returns a JSON Schema fragment for the rule set.
+// Rules that cannot be represented are listed in the description.
+func (v *StringRuleSet) JSONSchema() JSONSchema {
+	b := newSchemaBuilder("string")
+
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		switch rule := currentRuleSet.rule.(type) {
+		case nil:
+		case *minLenRule[any, string]:
+			b.set("minLength", rule.min)
+		case *maxLenRule[any, string]:
+			b.set("maxLength", rule.max)
+		case *regexpRule:
+			if _, ok := b.schema["pattern"]; ok {
+				b.allOf(JSONSchema{"pattern": rule.exp.String()})
+			} else {
+				b.set("pattern", rule.exp.String())
+			}
+		case *anyPatternRule:
+			anyOf := make([]JSONSchema, len(rule.exps))
+			for i, exp := range rule.exps {
+				anyOf[i] = JSONSchema{"pattern": exp.String()}
+			}
+			b.set("anyOf", anyOf)
+		case *stringValuesRule:
+			if rule.allow {
+				b.set("enum", append([]string(nil), rule.values...))
+			} else {
+				b.allOf(JSONSchema{"not": JSONSchema{"enum": append([]string(nil), rule.values...)}})
+			}
+		case *base64Rule:
+			if rule.encoding == base64.StdEncoding {
+				b.set("contentEncoding", "base64")
+			} else {
+				b.describe(rule)
+			}
+		default:
+			b.describe(rule)
+		}
+	}
+
+	return b.build()
+}
+
+// numberJSONSchema builds the schema for integer and floating point rule sets.
+func numberJSONSchema[T integer | floating](b *schemaBuilder, rule Rule[T]) {
+	switch rule := rule.(type) {
+	case nil:
+	case *minRule[T]:
+		b.set("minimum", rule.min)
+	case *maxRule[T]:
+		b.set("maximum", rule.max)
+	case *valuesRule[T]:
+		if rule.allow {
+			b.set("enum", append([]T(nil), rule.values...))
+		} else {
+			b.allOf(JSONSchema{"not": JSONSchema{"enum": append([]T(nil), rule.values...)}})
+		}
+	default:
+		b.describe(rule)
+	}
+}
+
+// JSONSchema returns a JSON Schema fragment for the rule set.
+// Rules that cannot be represented are listed in the description.
+func (v *IntRuleSet[T]) JSONSchema() JSONSchema {
+	b := newSchemaBuilder("integer")
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		// Steps can only be represented if the start is also a multiple of the step.
+		if rule, ok := currentRuleSet.rule.(*stepRule[T]); ok && rule.remainder(rule.start) == 0 {
+			b.set("multipleOf", rule.step)
+			continue
+		}
+		if _, ok := currentRuleSet.rule.(*rangeRule[T]); ok {
+			continue
+		}
+		numberJSONSchema(b, currentRuleSet.rule)
+	}
+
+	// The type limits are only used if there is no explicit minimum or maximum.
+	if v.hasRange() {
+		min, max := integerLimits[T]()
+		b.set("minimum", min)
+		b.set("maximum", max)
+	}
+	return b.build()
+}
+
+// JSONSchema returns a JSON Schema fragment for the rule set.
+// Rules that cannot be represented are listed in the description.
+func (v *FloatRuleSet[T]) JSONSchema() JSONSchema {
+	b := newSchemaBuilder("number")
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		numberJSONSchema(b, currentRuleSet.rule)
+	}
+	return b.build()
+}
+
+// JSONSchema returns a JSON Schema fragment for the rule set.
+// Rules that cannot be represented are listed in the description.
+func (v *SliceRuleSet[T]) JSONSchema() JSONSchema {
+	b := newSchemaBuilder("array")
+
+	itemRuleSet := v.itemRuleSet()
+	if itemRuleSet != nil {
+		b.set("items", JSONSchemaFor(itemRuleSet))
+	}
+
+	// Positional rule sets are listed in order. Gaps use the item schema or allow anything.
+	if indexRuleSets := v.indexRuleSets(); indexRuleSets != nil {
+		last := 0
+		for i := range indexRuleSets {
+			last = max(last, i)
+		}
+
+		prefixItems := make([]JSONSchema, last+1)
+		for i := range prefixItems {
+			if ruleSet, ok := indexRuleSets[i]; ok {
+				prefixItems[i] = JSONSchemaFor(ruleSet)
+			} else if itemRuleSet != nil {
+				prefixItems[i] = JSONSchemaFor(itemRuleSet)
+			} else {
+				prefixItems[i] = JSONSchema{}
+			}
+		}
+		b.set("prefixItems", prefixItems)
+	}
+
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		switch rule := currentRuleSet.rule.(type) {
+		case nil:
+		case *minLenRule[T, []T]:
+			b.set("minItems", rule.min)
+		case *maxLenRule[T, []T]:
+			b.set("maxItems", rule.max)
+		default:
+			b.describe(rule)
+		}
+	}
+
+	return b.build()
+}
+
+// JSONSchema returns a JSON Schema fragment for the rule set.
+//
+// Each constant key is included as a property. If a key has more than one rule set they are combined with allOf.
+// Conditional keys are included as properties but are never required. Read only keys are marked readOnly. Object
+// rules are listed in the description.
+// Additional properties are only allowed if unknown keys are allowed or the rule set has dynamic keys. If the rule set
+// has a value rule set, additional properties must match it.
+func (v *ObjectRuleSet[T, TK, TV]) JSONSchema() JSONSchema {
+	b := newSchemaBuilder("object")
+
+	properties := JSONSchema{}
+	required := make([]string, 0)
+	dynamic := false
+
+	for _, key := range v.Keys() {
+		schemas := make([]JSONSchema, 0)
+		for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+			if c, ok := exactConstant(currentRuleSet.key); ok && currentRuleSet.rule != nil && c.Value() == key {
+				schemas = append(schemas, JSONSchemaFor(currentRuleSet.rule))
+			}
+		}
+
+		name := toPath(key)
+		if len(schemas) == 1 {
+			properties[name] = schemas[0]
+		} else {
+			properties[name] = JSONSchema{"allOf": schemas}
+		}
+
+		if v.KeyRequired(key) && !v.partial {
+			required = append(required, name)
+		}
+	}
+
+	// Read only keys are included even if they have no rule sets so clients know not to send them.
+	for key := range v.readOnlyKeys() {
+		name := toPath(key)
+		schema := JSONSchema{"readOnly": true}
+		if existing, ok := properties[name].(JSONSchema); ok {
+			// Copy so a schema returned by a custom rule set is not modified
+			for k, value := range existing {
+				schema[k] = value
+			}
+			schema["readOnly"] = true
+		}
+		properties[name] = schema
+	}
+
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if currentRuleSet.keyFunc != nil || currentRuleSet.bucket != *new(TK) {
+			dynamic = true
+		} else if _, ok := exactConstant(currentRuleSet.key); !ok && currentRuleSet.key != nil {
+			dynamic = true
+		}
+
+		if currentRuleSet.objRule != nil {
+			b.describe(currentRuleSet.objRule)
+		}
+
+		if len(currentRuleSet.atLeastOne) > 0 {
+			anyOf := make([]JSONSchema, len(currentRuleSet.atLeastOne))
+			for i, key := range currentRuleSet.atLeastOne {
+				anyOf[i] = JSONSchema{"required": []string{toPath(key)}}
+			}
+			b.allOf(JSONSchema{"anyOf": anyOf})
+		}
+	}
+
+	b.set("properties", properties)
+	if v.minLen != nil {
+		b.set("minProperties", *v.minLen)
+	}
+	if v.maxLen != nil {
+		b.set("maxProperties", *v.maxLen)
+	}
+	if len(required) > 0 {
+		b.set("required", required)
+	}
+	if valueRuleSet := v.valueRuleSet(); valueRuleSet != nil {
+		b.set("additionalProperties", JSONSchemaFor(valueRuleSet.rule))
+	} else if !v.allowUnknown && !dynamic {
+		b.set("additionalProperties", false)
+	}
+
+	return b.build()
+}
+
+// JSONSchema returns a JSON Schema fragment that only allows the enum values.
+func (v *EnumRuleSet[T]) JSONSchema() JSONSchema {
+	b := newSchemaBuilder("")
+	b.set("enum", append([]any(nil), v.display...))
+
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if currentRuleSet.rule != nil {
+			b.describe(currentRuleSet.rule)
+		}
+	}
+
+	return b.build()
+}
+
+// JSONSchema returns a JSON Schema fragment that only allows the constant value.
+// Constants with an equality function can not be represented and are described using their string representation.
+func (ruleSet *ConstantRuleSet[T]) JSONSchema() JSONSchema {
+	if ruleSet.equal != nil {
+		return JSONSchema{"description": ruleSet.String()}
+	}
+
+	value := any(ruleSet.value)
+
+	// Named string types are converted so they are encoded the same way as plain strings
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.String {
+		value = rv.String()
+	}
+
+	return JSONSchema{"const": value}
+}
+
+// JSONSchema returns a JSON Schema fragment for the rule set. Any value is allowed.
+// Rules that cannot be represented are listed in the description.
+func (v *AnyRuleSet) JSONSchema() JSONSchema {
+	b := newSchemaBuilder("")
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if currentRuleSet.rule != nil {
+			b.describe(currentRuleSet.rule)
+		}
+	}
+	return b.build()
+}
+
+// JSONSchema returns the JSON Schema of the wrapped rule set.
+// Rules added directly to the wrapper are listed in the description.
+func (v *WrapAnyRuleSet[T]) JSONSchema() JSONSchema {
+	schema := JSONSchemaFor(v.inner)
+
+	b := &schemaBuilder{schema: schema}
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if currentRuleSet.rule != nil {
+			b.describe(currentRuleSet.rule)
+		}
+	}
+
+	if len(b.descriptions) > 0 {
+		if existing, ok := schema["description"].(string); ok {
+			b.descriptions = append(b.descriptions, existing)
+		}
+		delete(schema, "description")
+	}
+
+	return b.build()
+}
+
+// JSONSchema returns the JSON Schema of the wrapped rule set.
+func (v *TransformRuleSet[T]) JSONSchema() JSONSchema {
+	return JSONSchemaFor(v.inner)
+}
+
+// JSONSchema returns a schema that matches any of the wrapped rule sets.
+func (v *OneOfRuleSet[T]) JSONSchema() JSONSchema {
+	anyOf := make([]JSONSchema, len(v.ruleSets))
+	for i, ruleSet := range v.ruleSets {
+		anyOf[i] = JSONSchemaFor(ruleSet)
+	}
+	return JSONSchema{"anyOf": anyOf}
+}
+
+// JSONSchema returns a schema that matches all of the wrapped rule sets.
+func (v *AllOfRuleSet[T]) JSONSchema() JSONSchema {
+	allOf := make([]JSONSchema, len(v.ruleSets))
+	for i, ruleSet := range v.ruleSets {
+		allOf[i] = JSONSchemaFor(ruleSet)
+	}
+	return JSONSchema{"allOf": allOf}
+}
+
+// JSONSchema returns a schema that matches anything the wrapped rule set does not.
+func (v *NotRuleSet[T]) JSONSchema() JSONSchema {
+	return JSONSchema{"not": JSONSchemaFor(v.inner)}
+}
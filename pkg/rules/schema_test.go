@@ -0,0 +1,129 @@
+package rules_test
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// mustJSONSchema returns the JSON encoded schema for the rule set.
+func mustJSONSchema(t testing.TB, ruleSet any) string {
+	t.Helper()
+
+	data, err := json.Marshal(rules.JSONSchemaFor(ruleSet))
+	if err != nil {
+		t.Fatalf("Expected schema to be encoded, got: %s", err)
+	}
+	return string(data)
+}
+
+// Requirements:
+// - String rules that can be represented are included.
+// - Custom rules are listed in the description.
+func TestStringJSONSchema(t *testing.T) {
+	ruleSet := rules.String().
+		WithMinLen(1).
+		WithMaxLen(10).
+		WithRegexp(regexp.MustCompile("^[a-z]+$"), "").
+		WithAllowedValues("abc", "xyz").
+		WithRuleFunc(func(ctx context.Context, value string) errors.ValidationErrorCollection { return nil })
+
+	expected := `{"description":"WithRuleFunc(...)","enum":["abc","xyz"],"maxLength":10,"minLength":1,"pattern":"^[a-z]+$","type":"string"}`
+	if s := mustJSONSchema(t, ruleSet); s != expected {
+		t.Errorf("Expected schema to be %s, got %s", expected, s)
+	}
+
+	expected = `{"contentEncoding":"base64","type":"string"}`
+	if s := mustJSONSchema(t, rules.String().WithBase64().Any()); s != expected {
+		t.Errorf("Expected schema to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Number rules that can be represented are included.
+// - Integers and floats have different types.
+func TestNumberJSONSchema(t *testing.T) {
+	expected := `{"maximum":10,"minimum":1,"type":"integer"}`
+	if s := mustJSONSchema(t, rules.Int().WithMin(1).WithMax(10)); s != expected {
+		t.Errorf("Expected schema to be %s, got %s", expected, s)
+	}
+
+	expected = `{"allOf":[{"not":{"enum":[0]}}],"type":"integer"}`
+	if s := mustJSONSchema(t, rules.Int().WithRejectedValues(0)); s != expected {
+		t.Errorf("Expected schema to be %s, got %s", expected, s)
+	}
+
+	expected = `{"multipleOf":5,"type":"integer"}`
+	if s := mustJSONSchema(t, rules.Int().WithStep(10, 5)); s != expected {
+		t.Errorf("Expected schema to be %s, got %s", expected, s)
+	}
+
+	expected = `{"description":"WithStep(1, 10)","type":"integer"}`
+	if s := mustJSONSchema(t, rules.Int().WithStep(1, 10)); s != expected {
+		t.Errorf("Expected schema to be %s, got %s", expected, s)
+	}
+
+	expected = `{"minimum":0.5,"type":"number"}`
+	if s := mustJSONSchema(t, rules.Float64().WithMin(0.5)); s != expected {
+		t.Errorf("Expected schema to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Objects include properties, required keys and nested rule sets.
+// - Additional properties are not allowed by default.
+// - Additional properties must match the value rule set.
+// - Read only keys are marked readOnly and are never required.
+// - Rule sets without a schema fall back to their string representation.
+func TestObjectJSONSchema(t *testing.T) {
+	ruleSet := rules.StringMap[any]().
+		WithKey("name", rules.String().WithRequired().Any()).
+		WithKey("tags", rules.Slice[string]().WithItemRuleSet(rules.String().WithMaxLen(5)).WithMaxLen(3).Any()).
+		WithKey("kind", rules.Enum("a", "b").Any()).
+		WithKey("other", rules.Interface[error]().Any()).
+		WithRequireAtLeastOne("name", "tags")
+
+	expected := `{"additionalProperties":false,` +
+		`"allOf":[{"anyOf":[{"required":["name"]},{"required":["tags"]}]}],` +
+		`"properties":{` +
+		`"kind":{"enum":["a","b"]},` +
+		`"name":{"type":"string"},` +
+		`"other":{"description":"InterfaceRuleSet[error]"},` +
+		`"tags":{"items":{"maxLength":5,"type":"string"},"maxItems":3,"type":"array"}},` +
+		`"required":["name"],"type":"object"}`
+	if s := mustJSONSchema(t, ruleSet.Any()); s != expected {
+		t.Errorf("Expected schema to be %s, got %s", expected, s)
+	}
+
+	expected = `{"properties":{},"type":"object"}`
+	if s := mustJSONSchema(t, rules.StringMap[any]().WithUnknown()); s != expected {
+		t.Errorf("Expected schema to be %s, got %s", expected, s)
+	}
+
+	expected = `{"additionalProperties":{"maximum":10,"type":"integer"},"properties":{},"type":"object"}`
+	if s := mustJSONSchema(t, rules.StringMap[int]().WithValueRuleSet(rules.Int().WithMax(10))); s != expected {
+		t.Errorf("Expected schema to be %s, got %s", expected, s)
+	}
+
+	expected = `{"additionalProperties":false,"properties":{"created":{"readOnly":true},"id":{"readOnly":true,"type":"string"}},"type":"object"}`
+	ruleSet = rules.StringMap[any]().
+		WithKey("id", rules.String().WithRequired().Any()).
+		WithReadOnlyKey("id", rules.ReadOnlyDrop).
+		WithReadOnlyKey("created", rules.ReadOnlyError)
+	if s := mustJSONSchema(t, ruleSet.Any()); s != expected {
+		t.Errorf("Expected schema to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - OneOf is represented with anyOf.
+func TestOneOfJSONSchema(t *testing.T) {
+	expected := `{"anyOf":[{"type":"integer"},{"type":"string"}]}`
+	if s := mustJSONSchema(t, rules.OneOf[any](rules.Int().Any(), rules.String().Any())); s != expected {
+		t.Errorf("Expected schema to be %s, got %s", expected, s)
+	}
+}
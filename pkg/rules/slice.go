@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 	"reflect"
-	"strconv"
 
+	"proto.zip/studio/validate/internal/util"
 	"proto.zip/studio/validate/pkg/errors"
 	"proto.zip/studio/validate/pkg/rulecontext"
 )
@@ -16,7 +16,13 @@ type SliceRuleSet[T any] struct {
 	itemRules RuleSet[T]
 	rule      Rule[[]T]
 	required  bool
+	withNil   bool
 	parent    *SliceRuleSet[T]
+	maxDepth  int
+	summary   bool
+	position  int
+	posRules  RuleSet[T]
+	json      *JsonOptions
 	label     string
 }
 
@@ -41,10 +47,29 @@ func (v *SliceRuleSet[T]) WithRequired() *SliceRuleSet[T] {
 	return &SliceRuleSet[T]{
 		parent:   v,
 		required: true,
+		withNil:  v.withNil,
 		label:    "WithRequired()",
 	}
 }
 
+// WithNil returns a new child rule set that accepts a nil input, such as a JSON null or a nil pointer to a slice.
+// The item rules are not run and the output is left unchanged, so a nil output slice stays nil. Without WithNil a
+// nil input returns an errors.CodeType error.
+//
+// A nil slice is not a nil input and is always validated as an empty slice.
+func (v *SliceRuleSet[T]) WithNil() *SliceRuleSet[T] {
+	if v.withNil {
+		return v
+	}
+
+	return &SliceRuleSet[T]{
+		parent:   v,
+		required: v.required,
+		withNil:  true,
+		label:    "WithNil()",
+	}
+}
+
 // WithItemRuleSet takes a new rule set to use to validate array items and returns a new child rule set.
 //
 // If this function is called more than once, only the most recent one will be used to validate the items.
@@ -55,12 +80,78 @@ func (v *SliceRuleSet[T]) WithItemRuleSet(itemRules RuleSet[T]) *SliceRuleSet[T]
 		itemRules: itemRules,
 		parent:    v,
 		required:  v.required,
+		withNil:   v.withNil,
+	}
+}
+
+// WithMaxDepth returns a new child rule set that limits how deeply nested the input may be.
+//
+// The depth is tracked on the context and increases by one for each nested object or slice, including this one.
+// When the limit is exceeded a CodeMax error is returned at the path of the value and validation does not
+// descend any further. Nested rule sets may set a lower limit but can not raise it.
+//
+// This method will panic if n is less than 1.
+func (v *SliceRuleSet[T]) WithMaxDepth(n int) *SliceRuleSet[T] {
+	if n < 1 {
+		panic(fmt.Errorf("max depth must be at least 1, got: %d", n))
+	}
+
+	return &SliceRuleSet[T]{
+		parent:   v,
+		required: v.required,
+		withNil:  v.withNil,
+		maxDepth: n,
+		label:    fmt.Sprintf("WithMaxDepth(%d)", n),
+	}
+}
+
+// depthLimit returns the most recent max depth or 0 if there is none.
+func (v *SliceRuleSet[T]) depthLimit() int {
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if currentRuleSet.maxDepth > 0 {
+			return currentRuleSet.maxDepth
+		}
 	}
+	return 0
+}
+
+// itemRuleSet returns the most recent item rule set or nil if there is none.
+func (v *SliceRuleSet[T]) itemRuleSet() RuleSet[T] {
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if currentRuleSet.itemRules != nil {
+			return currentRuleSet.itemRules
+		}
+	}
+	return nil
+}
+
+// itemCoercionError returns the error for an item that could not be cast to the item type.
+func itemCoercionError[T any](ctx context.Context, value reflect.Value) errors.ValidationError {
+	expected := reflect.TypeOf(new(T)).Elem().Name()
+	return errors.NewCoercionError(ctx, expected, value.Kind().String())
 }
 
 // Apply performs a validation of a RuleSet against a value and assigns the result to the output parameter.
 // It returns a ValidationErrorCollection if any validation errors occur.
+//
+// The output always has the same length as the input. If the output is a *[]T with a non-nil slice that has enough
+// capacity, its backing array is reused instead of allocating a new one. This avoids an allocation when the same
+// output is used for many requests but means:
+//   - Other slices that share the backing array see the new items.
+//   - Items past the new length are not cleared and are kept alive until they are overwritten.
+//   - If validation fails the existing items may be partially overwritten.
+//
+// Applying a slice to itself is safe since each item is read before it is written. Passing a slice that overlaps the
+// output at a different offset is not.
 func (v *SliceRuleSet[T]) Apply(ctx context.Context, input any, output any) errors.ValidationErrorCollection {
+	// Nil is only allowed with WithNil
+	if util.IsNil(input) {
+		if v.withNil {
+			return nil
+		}
+		return errors.Collection(errors.NewCoercionError(ctx, "array", "nil"))
+	}
+
 	// Ensure output is a non-nil pointer
 	outputVal := reflect.ValueOf(output)
 	if outputVal.Kind() != reflect.Ptr || outputVal.IsNil() {
@@ -69,6 +160,26 @@ func (v *SliceRuleSet[T]) Apply(ctx context.Context, input any, output any) erro
 		))
 	}
 
+	// Stop descending if the input is nested too deeply.
+	ctx, depthErr := enterDepth(ctx, v.depthLimit())
+	if depthErr != nil {
+		return errors.Collection(depthErr)
+	}
+
+	// Decode Json strings if enabled
+	if options := v.jsonOptions(); options != nil {
+		var result []any
+
+		inValue := reflect.Indirect(reflect.ValueOf(input))
+		attempted, err := decodeJsonInput(inValue, &result, *options)
+		if err != nil {
+			return errors.Collection(errors.NewCoercionError(ctx, "array or JSON string", inValue.Kind().String()))
+		}
+		if attempted {
+			input = result
+		}
+	}
+
 	valueOf := reflect.ValueOf(input)
 	typeOf := valueOf.Type()
 	kind := typeOf.Kind()
@@ -79,35 +190,33 @@ func (v *SliceRuleSet[T]) Apply(ctx context.Context, input any, output any) erro
 
 	l := valueOf.Len()
 
-	outputSlice := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf((*T)(nil)).Elem()), l, l)
+	var outputSlice []T
+	if existing, ok := output.(*[]T); ok && *existing != nil && cap(*existing) >= l {
+		outputSlice = (*existing)[:l]
+	} else {
+		outputSlice = make([]T, l)
+	}
 
 	var allErrors = errors.Collection()
+	var failures itemFailures
+	failFast := rulecontext.FailFast(ctx)
 
 	// Check for an item RuleSet
-	var itemRuleSet RuleSet[T]
-
-	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
-		if currentRuleSet.itemRules != nil {
-			itemRuleSet = currentRuleSet.itemRules
-			break
-		}
-	}
+	itemRuleSet := v.itemRuleSet()
+	indexRuleSets := v.indexRuleSets()
 
 	// Default to a plain type cast if the rule set is nil
-	if itemRuleSet == nil {
-		expected := ""
-
+	if typed, ok := input.([]T); ok && itemRuleSet == nil && indexRuleSets == nil {
+		// Nothing to cast or validate
+		copy(outputSlice, typed)
+	} else if itemRuleSet == nil && indexRuleSets == nil {
 		for i := 0; i < l; i++ {
 			item := valueOf.Index(i).Interface()
 			castItem, castOk := item.(T)
-			outputSlice.Index(i).Set(reflect.ValueOf(castItem))
+			outputSlice[i] = castItem
 			if !castOk {
-				subContext := rulecontext.WithPathString(ctx, strconv.Itoa(i))
-				if expected == "" {
-					expected = reflect.TypeOf(new(T)).Elem().Name()
-				}
-				actual := valueOf.Index(i).Kind().String()
-				allErrors = append(allErrors, errors.NewCoercionError(subContext, expected, actual))
+				allErrors = append(allErrors, itemCoercionError[T](rulecontext.WithPathIndex(ctx, i), valueOf.Index(i)))
+				failures.add(i)
 			}
 		}
 	} else {
@@ -115,35 +224,65 @@ func (v *SliceRuleSet[T]) Apply(ctx context.Context, input any, output any) erro
 			subContext := rulecontext.WithPathIndex(ctx, i)
 			item := valueOf.Index(i).Interface()
 
+			ruleSet := itemRuleSet
+			if indexRuleSet, ok := indexRuleSets[i]; ok {
+				ruleSet = indexRuleSet
+			}
+
+			// Positions without a rule set are only cast
+			if ruleSet == nil {
+				castItem, castOk := item.(T)
+				outputSlice[i] = castItem
+				if !castOk {
+					allErrors = append(allErrors, itemCoercionError[T](subContext, valueOf.Index(i)))
+					failures.add(i)
+				}
+				continue
+			}
+
 			// Prepare the output location for the item
 			var itemOutput T
-			itemErr := itemRuleSet.Apply(subContext, item, &itemOutput)
-			outputSlice.Index(i).Set(reflect.ValueOf(itemOutput))
+			itemErr := ruleSet.Apply(subContext, item, &itemOutput)
+			outputSlice[i] = itemOutput
 
 			if itemErr != nil {
-				allErrors = append(allErrors, itemErr...)
+				allErrors = appendErrors(allErrors, itemErr)
+				if itemErr.HasErrors() {
+					if failFast {
+						return allErrors.Errors()[:1]
+					}
+					failures.add(i)
+				}
 			}
 		}
 	}
 
+	if failures.count > 0 && v.errorSummary() {
+		allErrors = summarizeItemErrors(ctx, allErrors, failures, l)
+	}
+
 	// Apply array-level rules after all items are validated and cast
 	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
 		if currentRuleSet.rule != nil {
-			if err := currentRuleSet.rule.Evaluate(ctx, outputSlice.Interface().([]T)); err != nil {
-				allErrors = append(allErrors, err...)
+			if err := currentRuleSet.rule.Evaluate(ctx, outputSlice); err != nil {
+				allErrors = appendErrors(allErrors, err)
+				if failFast && err.HasErrors() {
+					break
+				}
 			}
 		}
 	}
 
 	// Assign the result to the output
-	outputElem := outputVal.Elem()
-	if outputElem.Kind() == reflect.Interface && outputElem.IsNil() {
-		outputElem.Set(outputSlice)
-	} else if outputSlice.Type().AssignableTo(outputElem.Type()) {
-		outputElem.Set(outputSlice)
+	if typedOutput, ok := output.(*[]T); ok {
+		*typedOutput = outputSlice
+	} else if outputElem := outputVal.Elem(); outputElem.Kind() == reflect.Interface && outputElem.IsNil() {
+		outputElem.Set(reflect.ValueOf(outputSlice))
+	} else if reflect.TypeOf(outputSlice).AssignableTo(outputElem.Type()) {
+		outputElem.Set(reflect.ValueOf(outputSlice))
 	} else {
 		return errors.Collection(errors.Errorf(
-			errors.CodeInternal, ctx, "Cannot assign %T to %T", outputSlice.Interface(), outputElem.Interface(),
+			errors.CodeInternal, ctx, "Cannot assign %T to %T", outputSlice, outputElem.Interface(),
 		))
 	}
 
@@ -189,7 +328,13 @@ func (ruleSet *SliceRuleSet[T]) noConflict(rule Rule[[]T]) *SliceRuleSet[T] {
 		rule:      ruleSet.rule,
 		parent:    newParent,
 		required:  ruleSet.required,
+		withNil:   ruleSet.withNil,
 		itemRules: ruleSet.itemRules,
+		maxDepth:  ruleSet.maxDepth,
+		summary:   ruleSet.summary,
+		position:  ruleSet.position,
+		posRules:  ruleSet.posRules,
+		json:      ruleSet.json,
 		label:     ruleSet.label,
 	}
 }
@@ -204,6 +349,7 @@ func (v *SliceRuleSet[T]) WithRule(rule Rule[[]T]) *SliceRuleSet[T] {
 		rule:     rule,
 		parent:   v.noConflict(rule),
 		required: v.required,
+		withNil:  v.withNil,
 	}
 }
 
@@ -231,6 +377,8 @@ func (ruleSet *SliceRuleSet[T]) String() string {
 			label = ruleSet.rule.String()
 		} else if ruleSet.itemRules != nil {
 			label = fmt.Sprintf("WithItemRuleSet(%s)", ruleSet.itemRules)
+		} else if ruleSet.posRules != nil {
+			label = fmt.Sprintf("WithIndexRuleSet(%d, %s)", ruleSet.position, ruleSet.posRules)
 		}
 	}
 
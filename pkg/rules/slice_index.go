@@ -0,0 +1,47 @@
+package rules
+
+import (
+	"fmt"
+)
+
+// WithIndexRuleSet returns a new child rule set that validates the item at index i with the provided rule set
+// instead of the item rule set. Use this to validate tuples where each position has a different meaning, such as
+// a JSON array of a string, an int and a float. For mixed types use Slice[any] and the Any version of each rule set.
+//
+// If this function is called more than once for the same index, only the most recent one is used. Items at other
+// indices are validated with the rule set from WithItemRuleSet, or only cast if there is none. Use WithMinLen and
+// WithMaxLen to require a specific number of items.
+//
+// This method will panic if i is negative.
+func (v *SliceRuleSet[T]) WithIndexRuleSet(i int, ruleSet RuleSet[T]) *SliceRuleSet[T] {
+	if i < 0 {
+		panic(fmt.Errorf("index must not be negative, got: %d", i))
+	}
+
+	return &SliceRuleSet[T]{
+		parent:   v,
+		required: v.required,
+		withNil:  v.withNil,
+		position: i,
+		posRules: ruleSet,
+	}
+}
+
+// indexRuleSets returns the most recent rule set for each index or nil if there are none.
+func (v *SliceRuleSet[T]) indexRuleSets() map[int]RuleSet[T] {
+	var ruleSets map[int]RuleSet[T]
+
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if currentRuleSet.posRules == nil {
+			continue
+		}
+		if ruleSets == nil {
+			ruleSets = make(map[int]RuleSet[T])
+		}
+		if _, ok := ruleSets[currentRuleSet.position]; !ok {
+			ruleSets[currentRuleSet.position] = currentRuleSet.posRules
+		}
+	}
+
+	return ruleSets
+}
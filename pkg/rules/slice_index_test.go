@@ -0,0 +1,103 @@
+package rules_test
+
+import (
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Each position is validated with its own rule set.
+// - Errors have the index in the path.
+// - Positions without an index rule set use the item rule set.
+// - The most recent rule set for an index is used.
+// - Serializes to WithIndexRuleSet(i, ...).
+func TestSlice_WithIndexRuleSet(t *testing.T) {
+	tuple := rules.Slice[any]().
+		WithIndexRuleSet(0, rules.String().WithMinLen(2).Any()).
+		WithIndexRuleSet(1, rules.Int().WithMin(1).Any()).
+		WithIndexRuleSet(2, rules.Float64().WithMax(1).Any()).
+		WithMinLen(3).
+		WithMaxLen(3)
+
+	var out []any
+	if errs := tuple.Apply(context.Background(), []any{"ab", 5.0, 0.5}, &out); errs != nil {
+		t.Fatalf("Expected errors to be nil, got: %s", errs)
+	}
+	if out[0] != "ab" || out[1] != 5 || out[2] != 0.5 {
+		t.Errorf("Expected items to be coerced, got: %v", out)
+	}
+
+	ctx := rulecontext.WithPathString(context.Background(), "tuple")
+	errs := tuple.Apply(ctx, []any{"ab", 0, "yes", 0.5}, &out)
+	if len(errs) != 3 {
+		t.Fatalf("Expected 3 errors, got: %s", errs)
+	}
+	if err := errs.For("/tuple/1"); len(err) != 1 || err.First().Code() != errors.CodeMin {
+		t.Errorf("Expected a min error at /tuple/1, got: %s", errs)
+	}
+	if err := errs.For("/tuple/2"); len(err) != 1 || err.First().Code() != errors.CodeType {
+		t.Errorf("Expected a type error at /tuple/2, got: %s", errs)
+	}
+	if !errs.ContainsCode(errors.CodeMax) {
+		t.Errorf("Expected a max length error, got: %s", errs)
+	}
+
+	// Fallback and replacement
+	ruleSet := rules.Slice[int]().
+		WithItemRuleSet(rules.Int().WithMax(10)).
+		WithIndexRuleSet(0, rules.Int().WithMax(1)).
+		WithIndexRuleSet(0, rules.Int().WithMax(100))
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), []int{50, 50}, errors.CodeMax)
+	var ints []int
+	if errs := ruleSet.Apply(context.Background(), []int{50, 5}, &ints); errs != nil {
+		t.Errorf("Expected errors to be nil, got: %s", errs)
+	}
+
+	expected := "SliceRuleSet[int].WithItemRuleSet(IntRuleSet[int].WithMax(10))" +
+		".WithIndexRuleSet(0, IntRuleSet[int].WithMax(1))" +
+		".WithIndexRuleSet(0, IntRuleSet[int].WithMax(100))"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Positions without any rule set are only cast.
+// - Panics on a negative index.
+func TestSlice_WithIndexRuleSet_CastOnly(t *testing.T) {
+	ruleSet := rules.Slice[int]().WithIndexRuleSet(1, rules.Int().WithMax(10))
+
+	var out []int
+	if errs := ruleSet.Apply(context.Background(), []any{50, 5}, &out); errs != nil {
+		t.Errorf("Expected errors to be nil, got: %s", errs)
+	}
+	testhelpers.MustNotApply(t, ruleSet.Any(), []any{"a", 5}, errors.CodeType)
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic")
+		}
+	}()
+
+	rules.Slice[int]().WithIndexRuleSet(-1, rules.Int())
+}
+
+// Requirements:
+// - Index rule sets are listed as prefixItems.
+// - Gaps use the item schema.
+func TestSlice_WithIndexRuleSetJSONSchema(t *testing.T) {
+	ruleSet := rules.Slice[int]().
+		WithItemRuleSet(rules.Int().WithMax(10)).
+		WithIndexRuleSet(1, rules.Int().WithMin(1))
+
+	expected := `{"items":{"maximum":10,"type":"integer"},"prefixItems":[{"maximum":10,"type":"integer"},{"minimum":1,"type":"integer"}],"type":"array"}`
+	if s := mustJSONSchema(t, ruleSet); s != expected {
+		t.Errorf("Expected schema to be %s, got %s", expected, s)
+	}
+}
@@ -0,0 +1,48 @@
+package rules
+
+import "fmt"
+
+// WithJson returns a new child rule set that allows the input to be a Json encoded array.
+// Strings, byte slices such as json.RawMessage and pointers to them are decoded before the items are validated.
+// Input that is not a valid Json array returns a coercion error.
+//
+// Since byte slices are decoded, WithJson should not be used with rule sets for []byte.
+func (v *SliceRuleSet[T]) WithJson() *SliceRuleSet[T] {
+	if v.jsonOptions() != nil {
+		return v
+	}
+
+	return &SliceRuleSet[T]{
+		parent:   v,
+		required: v.required,
+		withNil:  v.withNil,
+		json:     &JsonOptions{},
+		label:    "WithJson()",
+	}
+}
+
+// WithJsonOptions returns a new child rule set that allows the input to be a Json encoded array, like WithJson,
+// and sets the options used to decode it.
+func (v *SliceRuleSet[T]) WithJsonOptions(options JsonOptions) *SliceRuleSet[T] {
+	if current := v.jsonOptions(); current != nil && *current == options {
+		return v
+	}
+
+	return &SliceRuleSet[T]{
+		parent:   v,
+		required: v.required,
+		withNil:  v.withNil,
+		json:     &options,
+		label:    fmt.Sprintf("WithJsonOptions(%+v)", options),
+	}
+}
+
+// jsonOptions returns the most recent Json options or nil if Json input is not allowed.
+func (v *SliceRuleSet[T]) jsonOptions() *JsonOptions {
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if currentRuleSet.json != nil {
+			return currentRuleSet.json
+		}
+	}
+	return nil
+}
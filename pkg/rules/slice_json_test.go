@@ -0,0 +1,69 @@
+package rules_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// checkDeepEqual is a check function that compares slices.
+func checkDeepEqual(expected, actual any) error {
+	if !reflect.DeepEqual(expected, actual) {
+		return fmt.Errorf("Expected output to be %v, got: %v", expected, actual)
+	}
+	return nil
+}
+
+// Requirements:
+// - Does not parse Json by default.
+// - Decodes a top level Json array from strings, pointers to strings and json.RawMessage.
+// - Items are validated by the item rule set.
+// - Invalid Json and non array Json return a type error.
+// - Serializes to WithJson().
+func TestSliceWithJson(t *testing.T) {
+	base := rules.Slice[int]().WithItemRuleSet(rules.Int().WithMax(10))
+	ruleSet := base.WithJson()
+
+	j := "[1,2,3]"
+	expected := []int{1, 2, 3}
+
+	testhelpers.MustNotApply(t, base.Any(), j, errors.CodeType)
+
+	testhelpers.MustApplyFunc(t, ruleSet.Any(), j, expected, checkDeepEqual)
+	testhelpers.MustApplyFunc(t, ruleSet.Any(), &j, expected, checkDeepEqual)
+	testhelpers.MustApplyFunc(t, ruleSet.Any(), json.RawMessage(j), expected, checkDeepEqual)
+	testhelpers.MustApplyFunc(t, ruleSet.Any(), []any{1, 2, 3}, expected, checkDeepEqual)
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), "[1,2,30]", errors.CodeMax)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "[1,2", errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet.Any(), `{"a": 1}`, errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet.Any(), `"abc"`, errors.CodeType)
+
+	expectedString := "SliceRuleSet[int].WithItemRuleSet(IntRuleSet[int].WithMax(10)).WithJson()"
+	if s := ruleSet.String(); s != expectedString {
+		t.Errorf("Expected rule set to be %s, got %s", expectedString, s)
+	}
+
+	if ruleSet.WithJson() != ruleSet {
+		t.Error("Expected WithJson to return the same rule set when already set")
+	}
+}
+
+// Requirements:
+// - Json options are used to decode the array.
+// - Nested objects keep the options.
+func TestSliceWithJsonOptions(t *testing.T) {
+	ruleSet := rules.Slice[int64]().
+		WithItemRuleSet(rules.Int64()).
+		WithJsonOptions(rules.JsonOptions{UseNumber: true, DisallowDuplicateKeys: true})
+
+	testhelpers.MustApplyFunc(t, ruleSet.Any(), "[9007199254740993]", []int64{9007199254740993}, checkDeepEqual)
+
+	objects := rules.Slice[any]().WithJsonOptions(rules.JsonOptions{DisallowDuplicateKeys: true})
+	testhelpers.MustNotApply(t, objects.Any(), `[{"a": 1, "a": 2}]`, errors.CodeType)
+}
@@ -0,0 +1,84 @@
+package rules
+
+import (
+	"context"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+)
+
+// EvaluateSeq validates the items produced by seq one at a time without collecting them into a slice.
+// This is useful for large or unbounded inputs such as records read from a file.
+//
+// The returned function yields each item after it has been through the item or index rule set along with any
+// errors for that item. Error paths contain the index of the item. The signatures match iter.Seq and iter.Seq2 so
+// the result can be used in a range statement by code built with Go 1.23 or later.
+//
+// Rules that apply to the whole slice, such as WithMinLen and WithMaxLen, need every item at once and are not
+// evaluated.
+//
+// If the context is cancelled or times out the error is yielded once and no more items are read from seq. The error
+// is also yielded if seq returns while the context is done.
+func (v *SliceRuleSet[T]) EvaluateSeq(ctx context.Context, seq func(yield func(T) bool)) func(yield func(T, errors.ValidationErrorCollection) bool) {
+	itemRuleSet := v.itemRuleSet()
+	indexRuleSets := v.indexRuleSets()
+
+	return func(yield func(T, errors.ValidationErrorCollection) bool) {
+		i := 0
+		stopped := false
+
+		seq(func(item T) bool {
+			index := i
+			subContext := rulecontext.WithPathIndex(ctx, index)
+			i++
+
+			if done(ctx) {
+				var empty T
+				yield(empty, errors.Collection(contextErrorToValidation(subContext)))
+				stopped = true
+				return false
+			}
+
+			ruleSet := itemRuleSet
+			if indexRuleSet, ok := indexRuleSets[index]; ok {
+				ruleSet = indexRuleSet
+			}
+
+			var itemOutput T
+			var itemErr errors.ValidationErrorCollection
+			if ruleSet == nil {
+				itemOutput = item
+			} else {
+				itemErr = ruleSet.Apply(subContext, item, &itemOutput)
+			}
+
+			stopped = !yield(itemOutput, itemErr)
+			return !stopped
+		})
+
+		// The sequence may have stopped early because the context is done
+		if !stopped && done(ctx) {
+			var empty T
+			yield(empty, errors.Collection(contextErrorToValidation(rulecontext.WithPathIndex(ctx, i))))
+		}
+	}
+}
+
+// EvaluateChan is the same as EvaluateSeq except that the items are read from a channel until it is closed.
+//
+// The channel is not drained if the caller stops early or the context is done.
+func (v *SliceRuleSet[T]) EvaluateChan(ctx context.Context, ch <-chan T) func(yield func(T, errors.ValidationErrorCollection) bool) {
+	return v.EvaluateSeq(ctx, func(yield func(T) bool) {
+		for {
+			select {
+			case <-ctx.Done():
+				// EvaluateSeq reports the context error once the sequence returns
+				return
+			case item, ok := <-ch:
+				if !ok || !yield(item) {
+					return
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,46 @@
+//go:build go1.23
+
+package rules_test
+
+import (
+	"context"
+	"iter"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// Requirements:
+// - The result of EvaluateSeq is an iter.Seq2 and can be used in a range statement.
+// - Breaking out of the loop stops reading from the input.
+func TestSlice_EvaluateSeq_Range(t *testing.T) {
+	ruleSet := rules.Slice[int]().WithItemRuleSet(rules.Int().WithMin(0))
+
+	read := 0
+	var input iter.Seq[int] = seqOf(&read, 1, -1, 2, 3)
+	var seq iter.Seq2[int, errors.ValidationErrorCollection] = ruleSet.EvaluateSeq(context.Background(), input)
+
+	var values []int
+	errCount := 0
+
+	for value, err := range seq {
+		values = append(values, value)
+		errCount += len(err)
+		if value == 2 {
+			break
+		}
+	}
+
+	if read != 3 {
+		t.Errorf("Expected 3 items to be read, got: %d", read)
+	}
+
+	if len(values) != 3 {
+		t.Errorf("Expected 3 values, got: %v", values)
+	}
+
+	if errCount != 1 {
+		t.Errorf("Expected 1 error, got: %d", errCount)
+	}
+}
@@ -0,0 +1,80 @@
+package rules
+
+import (
+	"context"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// summaryIndices is the number of failing item indices included in the metadata of a summary error.
+const summaryIndices = 5
+
+// itemFailures tracks the number of items that failed validation and the indices of the first few.
+type itemFailures struct {
+	count   int
+	indices []int
+}
+
+// add records a failing item.
+func (f *itemFailures) add(i int) {
+	f.count++
+	if len(f.indices) < summaryIndices {
+		f.indices = append(f.indices, i)
+	}
+}
+
+// WithErrorSummary returns a new child rule set that replaces the errors for individual items with a single
+// error for the slice. This keeps responses small when validating large inputs such as bulk imports.
+//
+// The summary error has the code of the first item error and the path of the slice. Its metadata, available
+// through errors.MetaOf, contains:
+//   - "count": the number of items that failed.
+//   - "total": the number of items in the slice.
+//   - "indices": the indices of the first 5 items that failed.
+//   - "codes": the number of item errors for each error code.
+//
+// Item warnings are not summarized and errors from slice level rules are returned as normal. EvaluateSeq and
+// EvaluateChan return errors per item and are not affected.
+//
+// By default each failing item returns its own errors with the index in the path.
+func (v *SliceRuleSet[T]) WithErrorSummary() *SliceRuleSet[T] {
+	if v.errorSummary() {
+		return v
+	}
+
+	return &SliceRuleSet[T]{
+		parent:   v,
+		required: v.required,
+		withNil:  v.withNil,
+		summary:  true,
+		label:    "WithErrorSummary()",
+	}
+}
+
+// errorSummary returns true if WithErrorSummary was called on the rule set or any of its parents.
+func (v *SliceRuleSet[T]) errorSummary() bool {
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if currentRuleSet.summary {
+			return true
+		}
+	}
+	return false
+}
+
+// summarizeItemErrors replaces the item errors with a single summary error. Warnings are kept.
+func summarizeItemErrors(ctx context.Context, errs errors.ValidationErrorCollection, failures itemFailures, total int) errors.ValidationErrorCollection {
+	itemErrors := errs.Errors()
+	if len(itemErrors) == 0 {
+		return errs
+	}
+
+	summary := errors.Errorf(itemErrors.First().Code(), ctx, "%d of %d items are invalid", failures.count, total)
+	summary = errors.WithMeta(summary, map[string]any{
+		"count":   failures.count,
+		"total":   total,
+		"indices": failures.indices,
+		"codes":   itemErrors.CountByCode(),
+	})
+
+	return append(errs.Warnings(), summary)
+}
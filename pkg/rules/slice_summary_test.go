@@ -0,0 +1,87 @@
+package rules_test
+
+import (
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// Requirements:
+// - Item errors are replaced with one error at the slice path.
+// - The count matches the number of failing items.
+// - The first 5 failing indices and the counts per code are in the metadata.
+// - Serializes to WithErrorSummary().
+func TestSlice_WithErrorSummary(t *testing.T) {
+	ruleSet := rules.Slice[int]().WithItemRuleSet(rules.Int().WithMax(10)).WithErrorSummary()
+
+	input := make([]int, 100)
+	failing := 0
+	for i := range input {
+		if i%3 == 0 {
+			input[i] = 100
+			failing++
+		}
+	}
+
+	var out []int
+	errs := ruleSet.Apply(context.Background(), input, &out)
+	if len(errs) != 1 {
+		t.Fatalf("Expected 1 error, got: %d", len(errs))
+	}
+
+	err := errs.First()
+	if err.Code() != errors.CodeMax {
+		t.Errorf("Expected code to be %s, got: %s", errors.CodeMax, err.Code())
+	}
+	if err.Path() != "" {
+		t.Errorf("Expected path to be empty, got: %s", err.Path())
+	}
+
+	meta := errors.MetaOf(err)
+	if count := meta["count"]; count != failing {
+		t.Errorf("Expected count to be %d, got: %v", failing, count)
+	}
+	if total := meta["total"]; total != 100 {
+		t.Errorf("Expected total to be 100, got: %v", total)
+	}
+	if indices, ok := meta["indices"].([]int); !ok || len(indices) != 5 || indices[0] != 0 || indices[4] != 12 {
+		t.Errorf("Expected the first 5 indices, got: %v", meta["indices"])
+	}
+	if codes, ok := meta["codes"].(map[errors.ErrorCode]int); !ok || codes[errors.CodeMax] != failing {
+		t.Errorf("Expected %d max errors, got: %v", failing, meta["codes"])
+	}
+
+	if errs := ruleSet.Apply(context.Background(), []int{1, 2, 3}, &out); errs != nil {
+		t.Errorf("Expected errors to be nil, got: %s", errs)
+	}
+
+	expected := "SliceRuleSet[int].WithItemRuleSet(IntRuleSet[int].WithMax(10)).WithErrorSummary()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Coercion errors are summarized.
+// - Slice level rules still return their own errors.
+func TestSlice_WithErrorSummary_CastAndRules(t *testing.T) {
+	ruleSet := rules.Slice[int]().WithErrorSummary().WithRuleFunc(func(ctx context.Context, _ []int) errors.ValidationErrorCollection {
+		return errors.Collection(errors.Errorf(errors.CodePattern, ctx, "invalid"))
+	})
+
+	var out []int
+	errs := ruleSet.Apply(context.Background(), []any{1, "a", "b"}, &out)
+	if len(errs) != 2 {
+		t.Fatalf("Expected 2 errors, got: %s", errs)
+	}
+
+	summary := errs.FirstWithCode(errors.CodeType)
+	if summary == nil || errors.MetaOf(summary)["count"] != 2 {
+		t.Errorf("Expected a summary of 2 type errors, got: %s", errs)
+	}
+	if !errs.ContainsCode(errors.CodePattern) {
+		t.Errorf("Expected the slice rule error, got: %s", errs)
+	}
+}
@@ -3,7 +3,9 @@ package rules_test
 import (
 	"context"
 	"testing"
+	"time"
 
+	"proto.zip/studio/validate/pkg/errors"
 	"proto.zip/studio/validate/pkg/rulecontext"
 	"proto.zip/studio/validate/pkg/rules"
 	"proto.zip/studio/validate/pkg/testhelpers"
@@ -161,6 +163,21 @@ func TestReturnsCorrectPaths(t *testing.T) {
 	}
 }
 
+// Requirements:
+// - Item errors for a slice without a parent path start with a slash.
+func TestReturnsCorrectPathsRoot(t *testing.T) {
+	var output []int
+
+	err := rules.Slice[int]().Apply(context.Background(), []any{1, "x"}, &output)
+	if err == nil {
+		t.Fatalf("Expected errors to not be nil")
+	}
+
+	if path := err.First().Path(); path != "/1" {
+		t.Errorf("Expected error path to be `%s` got `%s`", "/1", path)
+	}
+}
+
 func TestAny(t *testing.T) {
 	ruleSet := rules.Slice[int]().Any()
 
@@ -213,3 +230,244 @@ func TestEvaluate(t *testing.T) {
 		t.Errorf("Expected errors to both be nil, got %s and %s", err1, err2)
 	}
 }
+
+// seqOf returns a function that yields each of the values and counts how many were read.
+func seqOf[T any](read *int, values ...T) func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for _, value := range values {
+			*read++
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Requirements:
+// - Items are validated one at a time using the item rule set.
+// - Errors contain the index of the item.
+// - Items are read lazily and reading stops when the caller stops.
+func TestSlice_EvaluateSeq(t *testing.T) {
+	ruleSet := rules.Slice[int]().WithItemRuleSet(rules.Int().WithMin(0)).WithMinLen(100)
+
+	read := 0
+	seq := ruleSet.EvaluateSeq(context.Background(), seqOf(&read, 1, -1, 2, 3))
+
+	var values []int
+	var errs []errors.ValidationErrorCollection
+
+	seq(func(value int, err errors.ValidationErrorCollection) bool {
+		values = append(values, value)
+		errs = append(errs, err)
+		return value != 2
+	})
+
+	if read != 3 {
+		t.Errorf("Expected 3 items to be read, got: %d", read)
+	}
+
+	if len(values) != 3 || values[0] != 1 || values[2] != 2 {
+		t.Errorf("Expected values to be [1 -1 2], got: %v", values)
+	}
+
+	if errs[0] != nil || errs[2] != nil {
+		t.Errorf("Expected only the second item to have errors, got: %v", errs)
+	}
+
+	if errs[1] == nil {
+		t.Errorf("Expected the second item to have errors")
+	} else if path := errs[1].First().Path(); path != "/1" {
+		t.Errorf("Expected error path to be /1, got: %s", path)
+	}
+}
+
+// Requirements:
+// - Cancelled contexts yield a single error and stop reading.
+func TestSlice_EvaluateSeq_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	read := 0
+	calls := 0
+	var lastErr errors.ValidationErrorCollection
+
+	rules.Slice[int]().EvaluateSeq(ctx, seqOf(&read, 1, 2, 3, 4))(func(value int, err errors.ValidationErrorCollection) bool {
+		calls++
+		lastErr = err
+		cancel()
+		return true
+	})
+
+	if calls != 2 {
+		t.Errorf("Expected 2 calls, got: %d", calls)
+	}
+
+	if read != 2 {
+		t.Errorf("Expected 2 items to be read, got: %d", read)
+	}
+
+	if lastErr == nil {
+		t.Errorf("Expected an error")
+	} else if code := lastErr.First().Code(); code != errors.CodeCancelled {
+		t.Errorf("Expected error code to be %s, got: %s", errors.CodeCancelled, code)
+	} else if path := lastErr.First().Path(); path != "/1" {
+		t.Errorf("Expected error path to be /1, got: %s", path)
+	}
+}
+
+// Requirements:
+// - The context error is yielded if the sequence returns early because the context is done.
+func TestSlice_EvaluateSeq_CancelledSeq(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	seq := func(yield func(int) bool) {
+		for i := 0; ctx.Err() == nil; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	calls := 0
+	var lastErr errors.ValidationErrorCollection
+
+	rules.Slice[int]().EvaluateSeq(ctx, seq)(func(value int, err errors.ValidationErrorCollection) bool {
+		calls++
+		lastErr = err
+		cancel()
+		return true
+	})
+
+	if calls != 2 {
+		t.Errorf("Expected 2 calls, got: %d", calls)
+	}
+
+	if lastErr == nil {
+		t.Errorf("Expected an error")
+	} else if code := lastErr.First().Code(); code != errors.CodeCancelled {
+		t.Errorf("Expected error code to be %s, got: %s", errors.CodeCancelled, code)
+	} else if path := lastErr.First().Path(); path != "/1" {
+		t.Errorf("Expected error path to be /1, got: %s", path)
+	}
+}
+
+// Requirements:
+// - Items are read from the channel until it is closed.
+func TestSlice_EvaluateChan(t *testing.T) {
+	ch := make(chan string, 3)
+	ch <- "a"
+	ch <- "bb"
+	ch <- "c"
+	close(ch)
+
+	ruleSet := rules.Slice[string]().WithItemRuleSet(rules.String().WithMaxLen(1))
+
+	var values []string
+	errCount := 0
+
+	ruleSet.EvaluateChan(context.Background(), ch)(func(value string, err errors.ValidationErrorCollection) bool {
+		values = append(values, value)
+		errCount += len(err)
+		return true
+	})
+
+	if len(values) != 3 {
+		t.Errorf("Expected 3 values, got: %v", values)
+	}
+
+	if errCount != 1 {
+		t.Errorf("Expected 1 error, got: %d", errCount)
+	}
+}
+
+// Requirements:
+// - Cancelled contexts yield a single error without waiting for the channel to be closed.
+// - Timeouts yield a single error even if nothing is sent on the channel.
+func TestSlice_EvaluateChan_Cancelled(t *testing.T) {
+	ch := make(chan string, 1)
+	ch <- "a"
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	calls := 0
+	var lastErr errors.ValidationErrorCollection
+
+	rules.Slice[string]().EvaluateChan(ctx, ch)(func(value string, err errors.ValidationErrorCollection) bool {
+		calls++
+		lastErr = err
+		cancel()
+		return true
+	})
+
+	if calls != 2 {
+		t.Errorf("Expected 2 calls, got: %d", calls)
+	}
+
+	if lastErr == nil {
+		t.Errorf("Expected an error")
+	} else if code := lastErr.First().Code(); code != errors.CodeCancelled {
+		t.Errorf("Expected error code to be %s, got: %s", errors.CodeCancelled, code)
+	} else if path := lastErr.First().Path(); path != "/1" {
+		t.Errorf("Expected error path to be /1, got: %s", path)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	calls = 0
+	lastErr = nil
+
+	rules.Slice[string]().EvaluateChan(ctx, make(chan string))(func(value string, err errors.ValidationErrorCollection) bool {
+		calls++
+		lastErr = err
+		return true
+	})
+
+	if calls != 1 {
+		t.Errorf("Expected 1 call, got: %d", calls)
+	}
+
+	if lastErr == nil {
+		t.Errorf("Expected an error")
+	} else if code := lastErr.First().Code(); code != errors.CodeTimeout {
+		t.Errorf("Expected error code to be %s, got: %s", errors.CodeTimeout, code)
+	}
+}
+
+// Requirements:
+// - The backing array of a non-nil output is reused if it has enough capacity.
+// - The output length matches the input.
+// - A new array is allocated if the capacity is too small.
+func TestSlice_ReuseOutput(t *testing.T) {
+	ruleSet := rules.Slice[int]().WithItemRuleSet(rules.Int())
+
+	out := make([]int, 5, 10)
+	backing := &out[:1][0]
+
+	if err := ruleSet.Apply(context.Background(), []any{1, 2, 3}, &out); err != nil {
+		t.Fatalf("Expected errors to be nil, got: %s", err)
+	}
+	if len(out) != 3 || out[0] != 1 || out[2] != 3 {
+		t.Errorf("Expected output to be [1 2 3], got: %v", out)
+	}
+	if &out[0] != backing {
+		t.Error("Expected backing array to be reused")
+	}
+
+	if err := ruleSet.Apply(context.Background(), make([]any, 11), &out); err == nil && &out[0] == backing {
+		t.Error("Expected a new backing array when the capacity is too small")
+	}
+}
+
+// BenchmarkSliceReuse measures applying a slice to an output that is reused between iterations.
+func BenchmarkSliceReuse(b *testing.B) {
+	ruleSet := rules.Slice[int]()
+	input := make([]int, 100)
+
+	b.ReportAllocs()
+	var out []int
+	for i := 0; i < b.N; i++ {
+		if err := ruleSet.Apply(context.Background(), input, &out); err != nil {
+			b.Fatalf("Expected errors to be nil, got: %s", err)
+		}
+	}
+}
@@ -3,7 +3,10 @@ package rules
 import (
 	"context"
 	"reflect"
+	"strings"
 
+	"golang.org/x/text/unicode/norm"
+	"proto.zip/studio/validate/internal/util"
 	"proto.zip/studio/validate/pkg/errors"
 	"proto.zip/studio/validate/pkg/rulecontext"
 )
@@ -11,11 +14,21 @@ import (
 // Implementation of RuleSet for strings.
 type StringRuleSet struct {
 	NoConflict[string]
-	strict   bool
-	rule     Rule[string]
-	required bool
-	parent   *StringRuleSet
-	label    string
+	strict      bool
+	text        bool
+	sensitive   bool
+	lengthMode  lengthMode
+	normalize   bool
+	lowercase   bool
+	emptyAsNil  bool
+	form        norm.Form
+	transform   TransformFunc
+	rule        Rule[string]
+	required    bool
+	withNil     bool
+	errorConfig *errors.ErrorConfig
+	parent      *StringRuleSet
+	label       string
 }
 
 // baseStringRuleSet is the main RuleSet.
@@ -30,14 +43,73 @@ func String() *StringRuleSet {
 }
 
 // WithStrict returns a new child RuleSet with the strict flag applied.
-// A strict rule will only validate if the value is already a string.
+// A strict rule will only validate if the value is already a string or a non-nil *string. Any other input returns an
+// errors.CodeType error.
+//
+// Without the strict flag ints, floats, []byte, json.RawMessage and fmt.Stringer values are converted to strings.
+// A json.RawMessage must contain a JSON string, which is decoded.
 func (v *StringRuleSet) WithStrict() *StringRuleSet {
-	return &StringRuleSet{
-		strict:   true,
-		parent:   v,
-		required: v.required,
-		label:    "WithStrict()",
+	newRuleSet := v.clone()
+	newRuleSet.strict = true
+	newRuleSet.label = "WithStrict()"
+	return newRuleSet
+}
+
+// withLengthMode returns a new child RuleSet with the length mode set.
+func (v *StringRuleSet) withLengthMode(mode lengthMode, label string) *StringRuleSet {
+	if v.lengthMode == mode {
+		return v
 	}
+
+	newRuleSet := v.clone()
+	newRuleSet.lengthMode = mode
+	newRuleSet.label = label
+	return newRuleSet
+}
+
+// clone returns a new child RuleSet with the flags copied from the current RuleSet.
+// The rule, transform and label only apply to a single RuleSet in the chain and are not copied.
+func (v *StringRuleSet) clone() *StringRuleSet {
+	newRuleSet := *v
+	newRuleSet.rule = nil
+	newRuleSet.transform = nil
+	newRuleSet.label = ""
+	newRuleSet.parent = v
+	return &newRuleSet
+}
+
+// WithRuneLength returns a new child RuleSet that measures length in Unicode code points rather than bytes.
+// This applies to WithMinLen and WithMaxLen regardless of the order they were added in.
+//
+// Code points are not the same as visible characters. Combining characters and emoji sequences are made up
+// of multiple code points. Use WithGraphemeLength to count visible characters.
+func (v *StringRuleSet) WithRuneLength() *StringRuleSet {
+	return v.withLengthMode(lengthModeRunes, "WithRuneLength()")
+}
+
+// WithGraphemeLength returns a new child RuleSet that measures length in extended grapheme clusters.
+// This applies to WithMinLen and WithMaxLen regardless of the order they were added in.
+//
+// Grapheme clusters are closest to what a user sees as a single character, such as an emoji with a skin tone
+// modifier or a flag. Segmenting the string is slower than counting bytes or code points.
+func (v *StringRuleSet) WithGraphemeLength() *StringRuleSet {
+	return v.withLengthMode(lengthModeGraphemes, "WithGraphemeLength()")
+}
+
+// WithByteLength returns a new child RuleSet that measures length in bytes.
+// This is the default and is only needed to undo WithRuneLength or WithGraphemeLength.
+func (v *StringRuleSet) WithByteLength() *StringRuleSet {
+	return v.withLengthMode(lengthModeBytes, "WithByteLength()")
+}
+
+// WithTransform returns a new child RuleSet that calls the function with the raw input of Apply before it is
+// coerced to a string. If WithTransform is called more than once, the functions are called in the order they
+// were added.
+func (v *StringRuleSet) WithTransform(fn TransformFunc) *StringRuleSet {
+	newRuleSet := v.clone()
+	newRuleSet.transform = fn
+	newRuleSet.label = "WithTransform(<func>)"
+	return newRuleSet
 }
 
 // Required returns a boolean indicating if the value is allowed to be omitted when included in a nested object.
@@ -48,17 +120,47 @@ func (v *StringRuleSet) Required() bool {
 // WithRequired returns a new child rule set with the required flag set.
 // Use WithRequired when nesting a RuleSet and the a value is not allowed to be omitted.
 func (v *StringRuleSet) WithRequired() *StringRuleSet {
-	return &StringRuleSet{
-		strict:   v.strict,
-		parent:   v,
-		required: true,
-		label:    "WithRequired()",
+	newRuleSet := v.clone()
+	newRuleSet.required = true
+	newRuleSet.label = "WithRequired()"
+	return newRuleSet
+}
+
+// WithNil returns a new child rule set that accepts a nil input, such as a JSON null or a nil *string, and leaves
+// the output unchanged so a *string output stays nil. Without WithNil a nil input returns an errors.CodeType error.
+//
+// Unlike WithEmptyAsNil, which treats an empty string the same as a nil or missing value, only nil is affected.
+func (v *StringRuleSet) WithNil() *StringRuleSet {
+	if v.withNil {
+		return v
 	}
+
+	newRuleSet := v.clone()
+	newRuleSet.withNil = true
+	newRuleSet.label = "WithNil()"
+	return newRuleSet
 }
 
 // Apply performs a validation of a RuleSet against a value and assigns the resulting string to the output pointer
 // a ValidationErrorCollection.
 func (v *StringRuleSet) Apply(ctx context.Context, value, output any) errors.ValidationErrorCollection {
+	// Nil is only allowed with WithNil
+	if v.withNil && util.IsNil(value) {
+		return nil
+	}
+
+	errs := v.apply(ctx, value, output)
+	if v.sensitive && errs != nil {
+		// Transform and coercion errors are not masked by Evaluate
+		if str, err := v.coerce(value, ctx); err == nil {
+			return maskErrors(errs, str)
+		}
+	}
+	return errs
+}
+
+// apply implements Apply without masking sensitive values.
+func (v *StringRuleSet) apply(ctx context.Context, value, output any) errors.ValidationErrorCollection {
 	// Ensure output is a pointer that can be set
 	rv := reflect.ValueOf(output)
 	if rv.Kind() != reflect.Ptr || rv.IsNil() {
@@ -67,6 +169,31 @@ func (v *StringRuleSet) Apply(ctx context.Context, value, output any) errors.Val
 		)
 	}
 
+	// Empty strings are treated the same as a missing value
+	if v.isAbsent(value) {
+		if v.required {
+			return errors.Collection(
+				errors.Errorf(errors.CodeRequired, ctx, "field is required"),
+			)
+		}
+		return nil
+	}
+
+	// Transforms run on the raw input from the oldest to the newest
+	transforms := make([]TransformFunc, 0)
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if currentRuleSet.transform != nil {
+			transforms = append(transforms, currentRuleSet.transform)
+		}
+	}
+	for i := len(transforms) - 1; i >= 0; i-- {
+		var transformErr errors.ValidationError
+		value, transformErr = applyTransform(ctx, transforms[i], value)
+		if transformErr != nil {
+			return errors.Collection(transformErr)
+		}
+	}
+
 	// Attempt to coerce the input to a string
 	str, validationErr := v.coerce(value, ctx)
 
@@ -74,8 +201,17 @@ func (v *StringRuleSet) Apply(ctx context.Context, value, output any) errors.Val
 		return errors.Collection(validationErr)
 	}
 
+	if v.normalize {
+		str = v.form.String(str)
+	}
+
+	if v.lowercase {
+		str = strings.ToLower(str)
+	}
+
+	// Warnings are returned along with the value
 	verrs := v.Evaluate(ctx, str)
-	if verrs != nil {
+	if verrs.HasErrors() {
 		return verrs
 	}
 
@@ -86,13 +222,23 @@ func (v *StringRuleSet) Apply(ctx context.Context, value, output any) errors.Val
 	if elem.Kind() == reflect.Interface {
 		// Create a new string value and set the interface to point to it
 		elem.Set(reflect.ValueOf(str))
-		return nil
+		return verrs
+	}
+
+	// If the element is a byte slice and the value is base64, assign the decoded bytes
+	if elem.Kind() == reflect.Slice && elem.Type().Elem().Kind() == reflect.Uint8 {
+		if rule := v.base64Rule(); rule != nil {
+			// The value has already been validated by the rule so this will not fail
+			decoded, _ := rule.encoding.DecodeString(str)
+			elem.SetBytes(decoded)
+			return verrs
+		}
 	}
 
 	// If the element is a string, replace it with the new string value
 	if elem.Kind() == reflect.String {
 		elem.SetString(str)
-		return nil
+		return verrs
 	}
 
 	return errors.Collection(
@@ -107,11 +253,15 @@ func (v *StringRuleSet) Evaluate(ctx context.Context, value string) errors.Valid
 
 	currentRuleSet := v
 	ctx = rulecontext.WithRuleSet(ctx, v)
+	failFast := rulecontext.FailFast(ctx)
 
 	for currentRuleSet != nil {
 		if currentRuleSet.rule != nil {
 			if errs := currentRuleSet.rule.Evaluate(ctx, value); errs != nil {
-				allErrors = append(allErrors, errs...)
+				allErrors = appendErrors(allErrors, errs)
+				if failFast && errs.HasErrors() {
+					break
+				}
 			}
 		}
 
@@ -119,6 +269,10 @@ func (v *StringRuleSet) Evaluate(ctx context.Context, value string) errors.Valid
 	}
 
 	if len(allErrors) > 0 {
+		allErrors = errors.WithErrorConfig(ctx, allErrors, v.errorConfig)
+		if v.sensitive {
+			return maskErrors(allErrors, value)
+		}
 		return allErrors
 	} else {
 		return nil
@@ -147,13 +301,9 @@ func (ruleSet *StringRuleSet) noConflict(rule Rule[string]) *StringRuleSet {
 		return ruleSet
 	}
 
-	return &StringRuleSet{
-		rule:     ruleSet.rule,
-		parent:   newParent,
-		required: ruleSet.required,
-		strict:   ruleSet.strict,
-		label:    ruleSet.label,
-	}
+	newRuleSet := *ruleSet
+	newRuleSet.parent = newParent
+	return &newRuleSet
 }
 
 // WithRule returns a new child rule set with a rule added to the list of
@@ -162,12 +312,10 @@ func (ruleSet *StringRuleSet) noConflict(rule Rule[string]) *StringRuleSet {
 //
 // Use this when implementing custom rules.
 func (ruleSet *StringRuleSet) WithRule(rule Rule[string]) *StringRuleSet {
-	return &StringRuleSet{
-		strict:   ruleSet.strict,
-		rule:     rule,
-		parent:   ruleSet.noConflict(rule),
-		required: ruleSet.required,
-	}
+	newRuleSet := ruleSet.clone()
+	newRuleSet.rule = rule
+	newRuleSet.parent = ruleSet.noConflict(rule)
+	return newRuleSet
 }
 
 // WithRuleFunc returns a new child rule set with a rule added to the list of
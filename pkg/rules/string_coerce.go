@@ -2,19 +2,60 @@ package rules
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strconv"
 
+	"proto.zip/studio/validate/internal/util"
 	"proto.zip/studio/validate/pkg/errors"
 )
 
+// WithTextCoercion returns a new child RuleSet that accepts values implementing encoding.TextMarshaler or
+// fmt.Stringer and validates their text representation. This is useful for validating custom ID types directly.
+//
+// TextMarshaler is preferred if the value implements both. Text coercion applies even if the rule set is strict. If
+// MarshalText returns an error, Apply returns an errors.CodeType error.
+func (v *StringRuleSet) WithTextCoercion() *StringRuleSet {
+	if v.text {
+		return v
+	}
+
+	newRuleSet := v.clone()
+	newRuleSet.text = true
+	newRuleSet.label = "WithTextCoercion()"
+	return newRuleSet
+}
+
+// coerce attempts to convert the value to a string.
+//
+// A string or non-nil *string is always accepted. With text coercion, values implementing encoding.TextMarshaler
+// or fmt.Stringer are converted next. In strict mode anything else is a type error. Otherwise ints, floats and their
+// pointers are formatted, []byte is converted as is, json.RawMessage must hold a JSON string which is decoded, and
+// any other fmt.Stringer is converted by calling String.
 func (v *StringRuleSet) coerce(value any, ctx context.Context) (string, errors.ValidationError) {
-	str, ok := value.(string)
+	switch x := value.(type) {
+	case string:
+		return x, nil
+	case *string:
+		if x != nil {
+			return *x, nil
+		}
+		return "", errors.NewCoercionError(ctx, "string", "nil")
+	case nil:
+		return "", errors.NewCoercionError(ctx, "string", "nil")
+	}
 
-	if ok {
-		return str, nil
+	if v.text {
+		str, ok, err := util.Text(value)
+		if err != nil {
+			return "", errors.Errorf(errors.CodeType, ctx, "value could not be converted to text: %s", err)
+		}
+		if ok {
+			return str, nil
+		}
 	}
+
 	if v.strict {
 		return "", errors.NewCoercionError(ctx, "string", reflect.TypeOf(value).String())
 	}
@@ -32,8 +73,16 @@ func (v *StringRuleSet) coerce(value any, ctx context.Context) (string, errors.V
 		return fmt.Sprintf("%v", x), nil
 	case *float64:
 		return fmt.Sprintf("%v", *x), nil
-	case *string:
-		return *x, nil
+	case json.RawMessage:
+		var str string
+		if err := json.Unmarshal(x, &str); err != nil {
+			return "", errors.NewCoercionError(ctx, "string", "json")
+		}
+		return str, nil
+	case []byte:
+		return string(x), nil
+	case fmt.Stringer:
+		return x.String(), nil
 	}
 
 	return "", errors.NewCoercionError(ctx, "string", reflect.TypeOf(value).String())
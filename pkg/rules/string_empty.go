@@ -0,0 +1,54 @@
+package rules
+
+// absentChecker is implemented by rule sets that treat some input values the same as a missing value.
+// Object rule sets use it to apply the same required and optional logic as they do for missing keys.
+type absentChecker interface {
+	isAbsent(value any) bool
+}
+
+// isAbsent returns true if the rule set treats the value as missing.
+func isAbsent[T any](ruleSet RuleSet[T], value any) bool {
+	checker, ok := ruleSet.(absentChecker)
+	return ok && checker.isAbsent(value)
+}
+
+// WithEmptyAsNil returns a new child RuleSet that treats an empty string the same as a nil or missing value.
+//
+// If the rule set is required, an empty string returns a CodeRequired error. Otherwise the value is skipped: it
+// is not coerced, no rules are evaluated and the output is left unchanged.
+//
+// When nested in an object or map, an empty string is handled exactly like a missing key. The key is not set on
+// the output and required keys are not checked when WithPartial is used.
+//
+// The check is made against the raw input before any transforms are run, so a transform that trims whitespace
+// will not cause a blank string to be treated as nil.
+func (v *StringRuleSet) WithEmptyAsNil() *StringRuleSet {
+	if v.emptyAsNil {
+		return v
+	}
+
+	newRuleSet := v.clone()
+	newRuleSet.emptyAsNil = true
+	newRuleSet.label = "WithEmptyAsNil()"
+	return newRuleSet
+}
+
+// isAbsent returns true if empty strings are treated as nil and the value is an empty string.
+func (v *StringRuleSet) isAbsent(value any) bool {
+	if !v.emptyAsNil {
+		return false
+	}
+
+	switch x := value.(type) {
+	case string:
+		return x == ""
+	case *string:
+		return x != nil && *x == ""
+	}
+	return false
+}
+
+// isAbsent returns true if the wrapped rule set treats the value as missing.
+func (v *WrapAnyRuleSet[T]) isAbsent(value any) bool {
+	return isAbsent(v.inner, value)
+}
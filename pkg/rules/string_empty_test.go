@@ -0,0 +1,97 @@
+package rules_test
+
+import (
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Empty strings are skipped and the output is left unchanged when optional.
+// - Non-empty strings are still validated.
+// - Serializes to WithEmptyAsNil().
+// - Calling WithEmptyAsNil again returns the same rule set.
+func TestString_WithEmptyAsNil(t *testing.T) {
+	ruleSet := rules.String().WithMinLen(3).WithEmptyAsNil()
+
+	out := "unchanged"
+	if err := ruleSet.Apply(context.TODO(), "", &out); err != nil {
+		t.Fatalf("Expected error to be nil, got: %s", err)
+	}
+	if out != "unchanged" {
+		t.Errorf("Expected output to be unchanged, got: %s", out)
+	}
+
+	empty := ""
+	if err := ruleSet.Apply(context.TODO(), &empty, &out); err != nil {
+		t.Errorf("Expected error to be nil, got: %s", err)
+	}
+
+	testhelpers.MustApply(t, ruleSet.Any(), "abc")
+	testhelpers.MustNotApply(t, ruleSet.Any(), "ab", errors.CodeMin)
+
+	// Without the flag empty strings are validated as usual
+	testhelpers.MustNotApply(t, rules.String().WithMinLen(3).Any(), "", errors.CodeMin)
+
+	expected := "StringRuleSet.WithMinLen(3).WithEmptyAsNil()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+
+	if ruleSet.WithEmptyAsNil() != ruleSet {
+		t.Error("Expected WithEmptyAsNil to return the same rule set")
+	}
+}
+
+// Requirements:
+// - Empty strings return a required error when the rule set is required.
+// - The flag is kept when rules are added.
+func TestString_WithEmptyAsNil_Required(t *testing.T) {
+	ruleSet := rules.String().WithEmptyAsNil().WithRequired().WithMinLen(3)
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), "", errors.CodeRequired)
+	testhelpers.MustApply(t, ruleSet.Any(), "abc")
+}
+
+// Requirements:
+// - Empty strings are treated as missing keys in objects.
+// - Optional keys with empty strings are not set on the output.
+// - Required keys with empty strings return a required error at the key path.
+// - WithPartial skips required keys with empty strings.
+func TestString_WithEmptyAsNil_Object(t *testing.T) {
+	ruleSet := rules.StringMap[any]().
+		WithKey("name", rules.String().WithMinLen(3).WithEmptyAsNil().WithRequired().Any()).
+		WithKey("nickname", rules.String().WithMinLen(3).WithEmptyAsNil().Any())
+
+	var out map[string]any
+	err := ruleSet.Apply(context.TODO(), map[string]any{"name": "abc", "nickname": ""}, &out)
+	if err != nil {
+		t.Fatalf("Expected error to be nil, got: %s", err)
+	}
+	if _, ok := out["nickname"]; ok {
+		t.Errorf("Expected nickname to not be set, got: %v", out["nickname"])
+	}
+
+	err = ruleSet.Apply(context.TODO(), map[string]any{"name": ""}, &out)
+	if len(err) != 1 {
+		t.Fatalf("Expected 1 error, got: %d (%s)", len(err), err)
+	}
+	if err[0].Code() != errors.CodeRequired {
+		t.Errorf("Expected error code to be %s, got: %s", errors.CodeRequired, err[0].Code())
+	}
+	if err[0].Path() != "/name" {
+		t.Errorf("Expected error path to be /name, got: %s", err[0].Path())
+	}
+
+	out = nil
+	err = ruleSet.WithPartial().Apply(context.TODO(), map[string]any{"name": ""}, &out)
+	if err != nil {
+		t.Errorf("Expected error to be nil, got: %s", err)
+	}
+	if _, ok := out["name"]; ok {
+		t.Errorf("Expected name to not be set, got: %v", out["name"])
+	}
+}
@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"fmt"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// normalizationFormName returns the name of the normalization form. Useful for debugging.
+func normalizationFormName(form norm.Form) string {
+	switch form {
+	case norm.NFC:
+		return "NFC"
+	case norm.NFD:
+		return "NFD"
+	case norm.NFKC:
+		return "NFKC"
+	case norm.NFKD:
+		return "NFKD"
+	}
+	return "Unknown"
+}
+
+// WithNormalize returns a new child RuleSet that converts the value to the provided Unicode normalization form.
+//
+// Normalization happens in Apply before any rules are evaluated, so allowed values and patterns are compared
+// against the normalized value. The normalized value is written to the output.
+//
+// Only one normalization form is used. Calling WithNormalize again replaces the previous form.
+func (v *StringRuleSet) WithNormalize(form norm.Form) *StringRuleSet {
+	if v.normalize && v.form == form {
+		return v
+	}
+
+	newRuleSet := v.clone()
+	newRuleSet.normalize = true
+	newRuleSet.form = form
+	newRuleSet.label = fmt.Sprintf("WithNormalize(%s)", normalizationFormName(form))
+	return newRuleSet
+}
+
+// WithNFC is a convenience function that returns a new child RuleSet that normalizes the value using
+// canonical composition (NFC).
+//
+// This is the recommended form for comparing text from different sources.
+func (v *StringRuleSet) WithNFC() *StringRuleSet {
+	return v.WithNormalize(norm.NFC)
+}
+
+// WithNFKC is a convenience function that returns a new child RuleSet that normalizes the value using
+// compatibility composition (NFKC).
+//
+// NFKC also folds compatibility characters such as ligatures and full width letters into their plain forms.
+func (v *StringRuleSet) WithNFKC() *StringRuleSet {
+	return v.WithNormalize(norm.NFKC)
+}
+
+// WithLowercase returns a new child RuleSet that converts the value to lowercase.
+//
+// Like WithNormalize, the conversion happens in Apply before any rules are evaluated, so allowed and forbidden
+// values are compared against the lowercase value. The lowercase value is written to the output. Lowercasing
+// happens after normalization.
+func (v *StringRuleSet) WithLowercase() *StringRuleSet {
+	if v.lowercase {
+		return v
+	}
+
+	newRuleSet := v.clone()
+	newRuleSet.lowercase = true
+	newRuleSet.label = "WithLowercase()"
+	return newRuleSet
+}
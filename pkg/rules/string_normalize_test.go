@@ -0,0 +1,56 @@
+package rules_test
+
+import (
+	"testing"
+
+	"golang.org/x/text/unicode/norm"
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - NFD input is converted to NFC.
+// - The normalized value is written to the output.
+// - Allowed values are compared against the normalized value.
+func TestString_WithNFC(t *testing.T) {
+	decomposed := "cafe\u0301"
+	composed := "caf\u00e9"
+
+	ruleSet := rules.String().WithAllowedValues(composed).WithNFC()
+
+	testhelpers.MustApplyMutation(t, ruleSet.Any(), decomposed, composed)
+	testhelpers.MustApply(t, ruleSet.Any(), composed)
+
+	testhelpers.MustNotApply(t, rules.String().WithAllowedValues(composed).Any(), decomposed, errors.CodeNotAllowed)
+}
+
+// Requirements:
+// - NFKC folds compatibility characters.
+// - Serializes to WithNormalize(NFKC)
+func TestString_WithNFKC(t *testing.T) {
+	ruleSet := rules.String().WithNFKC()
+
+	testhelpers.MustApplyMutation(t, ruleSet.Any(), "\ufb01le", "file")
+	testhelpers.MustApplyMutation(t, ruleSet.Any(), "\uff21\uff22\uff23", "ABC")
+
+	expected := "StringRuleSet.WithNormalize(NFKC)"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - The most recent normalization form is used.
+// - Setting the same form twice does not add a label.
+func TestString_WithNormalize_Replace(t *testing.T) {
+	ruleSet := rules.String().WithNFKC().WithNormalize(norm.NFD).WithNormalize(norm.NFD)
+
+	testhelpers.MustApplyMutation(t, ruleSet.Any(), "caf\u00e9", "cafe\u0301")
+	testhelpers.MustApplyMutation(t, ruleSet.Any(), "\ufb01", "\ufb01")
+
+	expected := "StringRuleSet.WithNormalize(NFKC).WithNormalize(NFD)"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
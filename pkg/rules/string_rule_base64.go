@@ -0,0 +1,81 @@
+package rules
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// Implements the Rule interface for base64 encoded strings.
+type base64Rule struct {
+	encoding *base64.Encoding
+	name     string
+}
+
+// Evaluate takes a context and string value and returns an error if it is not correctly encoded.
+//
+// The base64 package ignores new lines when decoding so they are rejected here since whitespace is
+// not part of the alphabet.
+func (rule *base64Rule) Evaluate(ctx context.Context, value string) errors.ValidationErrorCollection {
+	if strings.ContainsAny(value, "\r\n") {
+		return errors.Collection(
+			errors.Errorf(errors.CodeEncoding, ctx, "value must be base64 encoded"),
+		)
+	}
+
+	if _, err := rule.encoding.DecodeString(value); err != nil {
+		return errors.Collection(
+			errors.Errorf(errors.CodeEncoding, ctx, "value must be base64 encoded"),
+		)
+	}
+
+	return nil
+}
+
+// Conflict returns true for any base64 rule.
+func (rule *base64Rule) Conflict(x Rule[string]) bool {
+	_, ok := x.(*base64Rule)
+	return ok
+}
+
+// String returns the string representation of the base64 rule.
+// Example: WithBase64()
+func (rule *base64Rule) String() string {
+	return rule.name + "()"
+}
+
+// base64Rule returns the most recent base64 rule or nil if there is none.
+func (v *StringRuleSet) base64Rule() *base64Rule {
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		if rule, ok := currentRuleSet.rule.(*base64Rule); ok {
+			return rule
+		}
+	}
+	return nil
+}
+
+// WithBase64 returns a new child RuleSet that requires the value to be padded base64 using the standard alphabet.
+//
+// When the output of Apply is a byte slice the decoded bytes are assigned instead of the string.
+func (v *StringRuleSet) WithBase64() *StringRuleSet {
+	return v.WithRule(&base64Rule{base64.StdEncoding, "WithBase64"})
+}
+
+// WithBase64URL returns a new child RuleSet that requires the value to be padded base64 using the URL safe alphabet.
+//
+// When the output of Apply is a byte slice the decoded bytes are assigned instead of the string.
+func (v *StringRuleSet) WithBase64URL() *StringRuleSet {
+	return v.WithRule(&base64Rule{base64.URLEncoding, "WithBase64URL"})
+}
+
+// WithRawBase64 is the same as WithBase64 except that padding characters are not allowed.
+func (v *StringRuleSet) WithRawBase64() *StringRuleSet {
+	return v.WithRule(&base64Rule{base64.RawStdEncoding, "WithRawBase64"})
+}
+
+// WithRawBase64URL is the same as WithBase64URL except that padding characters are not allowed.
+func (v *StringRuleSet) WithRawBase64URL() *StringRuleSet {
+	return v.WithRule(&base64Rule{base64.RawURLEncoding, "WithRawBase64URL"})
+}
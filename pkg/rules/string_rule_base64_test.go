@@ -0,0 +1,96 @@
+package rules_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Padded standard base64 is accepted.
+// - Whitespace, wrong alphabet and incorrect padding are rejected with CodeEncoding.
+// - Serializes to WithBase64()
+func TestString_WithBase64(t *testing.T) {
+	ruleSet := rules.String().WithBase64()
+
+	testhelpers.MustApply(t, ruleSet.Any(), "aGVsbG8+Pz8=")
+	testhelpers.MustApply(t, ruleSet.Any(), "")
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), "aGVs bG8=", errors.CodeEncoding)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "aGVs\nbG8=", errors.CodeEncoding)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "aGVsbG8-Pz8=", errors.CodeEncoding)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "aGVsbG8", errors.CodeEncoding)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "aGVsbG8==", errors.CodeEncoding)
+
+	expected := "StringRuleSet.WithBase64()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - The URL alphabet is used.
+// - The raw variants reject padding.
+func TestString_WithBase64URL(t *testing.T) {
+	ruleSet := rules.String().WithBase64URL()
+
+	testhelpers.MustApply(t, ruleSet.Any(), "aGVsbG8-Pz8=")
+	testhelpers.MustNotApply(t, ruleSet.Any(), "aGVsbG8+Pz8=", errors.CodeEncoding)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "aGVsbG8-Pz8", errors.CodeEncoding)
+
+	rawRuleSet := rules.String().WithRawBase64URL()
+
+	testhelpers.MustApply(t, rawRuleSet.Any(), "aGVsbG8-Pz8")
+	testhelpers.MustNotApply(t, rawRuleSet.Any(), "aGVsbG8-Pz8=", errors.CodeEncoding)
+
+	rawStdRuleSet := rules.String().WithRawBase64()
+
+	testhelpers.MustApply(t, rawStdRuleSet.Any(), "aGVsbG8+Pz8")
+	testhelpers.MustNotApply(t, rawStdRuleSet.Any(), "aGVsbG8+Pz8=", errors.CodeEncoding)
+}
+
+// Requirements:
+// - Only one base64 rule can exist on a rule set.
+// - Most recent encoding is used.
+func TestString_WithBase64_Conflict(t *testing.T) {
+	ruleSet := rules.String().WithBase64().WithMaxLen(20).WithRawBase64URL()
+
+	testhelpers.MustApply(t, ruleSet.Any(), "aGVsbG8-Pz8")
+
+	expected := "StringRuleSet.WithMaxLen(20).WithRawBase64URL()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Byte slice outputs receive the decoded value.
+// - String outputs receive the encoded value.
+// - Byte slice outputs are not allowed without a base64 rule.
+func TestString_WithBase64_DecodedOutput(t *testing.T) {
+	ruleSet := rules.String().WithBase64()
+
+	var decoded []byte
+	if err := ruleSet.Apply(context.Background(), "aGVsbG8=", &decoded); err != nil {
+		t.Fatalf("Expected errors to be nil, got: %s", err)
+	} else if !bytes.Equal(decoded, []byte("hello")) {
+		t.Errorf("Expected output to be hello, got: %s", decoded)
+	}
+
+	var encoded string
+	if err := ruleSet.Apply(context.Background(), "aGVsbG8=", &encoded); err != nil {
+		t.Fatalf("Expected errors to be nil, got: %s", err)
+	} else if encoded != "aGVsbG8=" {
+		t.Errorf("Expected output to be aGVsbG8=, got: %s", encoded)
+	}
+
+	if err := rules.String().Apply(context.Background(), "aGVsbG8=", &decoded); err == nil {
+		t.Errorf("Expected errors to not be nil")
+	} else if err.First().Code() != errors.CodeInternal {
+		t.Errorf("Expected error code to be %s, got: %s", errors.CodeInternal, err.First().Code())
+	}
+}
@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// Implements the Rule interface for regular expressions that write their named groups to an output.
+type regexpCaptureRule struct {
+	NoConflict[string]
+	exp *regexp.Regexp
+	out any
+}
+
+// Evaluate takes a context and string value and returns an error if it does not match the expected pattern.
+// On a match the named groups are written to the output.
+func (rule *regexpCaptureRule) Evaluate(ctx context.Context, value string) errors.ValidationErrorCollection {
+	match := rule.exp.FindStringSubmatch(value)
+	if match == nil {
+		return errors.Collection(
+			errors.Errorf(errors.CodePattern, ctx, "value does not match the expected pattern"),
+		)
+	}
+
+	if rule.out == nil {
+		return nil
+	}
+
+	for i, name := range rule.exp.SubexpNames() {
+		if name == "" {
+			continue
+		}
+
+		switch out := rule.out.(type) {
+		case map[string]string:
+			out[name] = match[i]
+		default:
+			structValue := reflect.ValueOf(out).Elem()
+			field := structValue.FieldByNameFunc(func(fieldName string) bool {
+				return strings.EqualFold(fieldName, name)
+			})
+			if field.IsValid() && field.CanSet() && field.Kind() == reflect.String {
+				field.SetString(match[i])
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns the string representation of the capture rule.
+// Example: WithRegexpCapture(^(?P<year>\d{4})-W(?P<week>\d{2})$)
+func (rule *regexpCaptureRule) String() string {
+	return fmt.Sprintf("WithRegexpCapture(%s)", rule.exp)
+}
+
+// WithRegexpCapture returns a new child RuleSet that is constrained to the provided regular expression and writes
+// the named groups of the match to out. Values that do not match return an errors.CodePattern error.
+//
+// The output may be:
+//   - A map[string]string which gets an entry for each named group.
+//   - A pointer to a struct. Each named group is written to the string field with the same name, ignoring case.
+//     Groups without a matching field are skipped.
+//   - Nil, in which case the expression is only used for validation.
+//
+// Groups that do not take part in the match are written as empty strings. The expression is matched against the
+// value after any transforms and normalization, the same as other rules.
+//
+// The output is written each time a value matches, even if other rules fail, and is shared by every evaluation of
+// the rule set. It is not safe to evaluate the rule set concurrently with a non-nil output so create a new rule set
+// for each value when validating in parallel.
+//
+// This method panics if out is not one of the supported types.
+func (v *StringRuleSet) WithRegexpCapture(exp *regexp.Regexp, out any) *StringRuleSet {
+	switch x := out.(type) {
+	case nil:
+	case map[string]string:
+		if x == nil {
+			panic(fmt.Errorf("expected map to not be nil"))
+		}
+	default:
+		rv := reflect.ValueOf(out)
+		if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+			panic(fmt.Errorf("expected out to be a map[string]string or a pointer to a struct, got: %T", out))
+		}
+	}
+
+	return v.WithRule(&regexpCaptureRule{
+		exp: exp,
+		out: out,
+	})
+}
@@ -0,0 +1,85 @@
+package rules_test
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+var isoWeekPattern = regexp.MustCompile(`^(?P<year>\d{4})-W(?P<week>\d{2})(?:-(?P<day>\d))?$`)
+
+// Requirements:
+// - Named groups are written to a map.
+// - Groups that do not take part in the match are empty.
+// - Values that do not match return a pattern error.
+// - Serializes to WithRegexpCapture(...).
+func TestWithRegexpCapture_Map(t *testing.T) {
+	captured := make(map[string]string)
+	ruleSet := rules.String().WithRegexpCapture(isoWeekPattern, captured)
+
+	var out string
+	if errs := ruleSet.Apply(context.Background(), "2024-W05", &out); errs != nil {
+		t.Fatalf("Expected errors to be nil, got: %s", errs)
+	}
+	if captured["year"] != "2024" || captured["week"] != "05" || captured["day"] != "" {
+		t.Errorf("Expected the groups to be captured, got: %v", captured)
+	}
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), "2024-05", errors.CodePattern)
+
+	expected := `StringRuleSet.WithRegexpCapture(^(?P<year>\d{4})-W(?P<week>\d{2})(?:-(?P<day>\d))?$)`
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Named groups are written to struct fields with the same name ignoring case.
+// - Groups without a field are skipped.
+// - The expression runs after transforms.
+// - A nil output only validates.
+func TestWithRegexpCapture_Struct(t *testing.T) {
+	var week struct {
+		Year string
+		Week string
+	}
+	trim := func(_ context.Context, value any) (any, error) {
+		if s, ok := value.(string); ok {
+			return strings.TrimSpace(s), nil
+		}
+		return value, nil
+	}
+	ruleSet := rules.String().WithTransform(trim).WithRegexpCapture(isoWeekPattern, &week)
+
+	var out string
+	if errs := ruleSet.Apply(context.Background(), " 2024-W05-3 ", &out); errs != nil {
+		t.Fatalf("Expected errors to be nil, got: %s", errs)
+	}
+	if week.Year != "2024" || week.Week != "05" {
+		t.Errorf("Expected the groups to be captured, got: %+v", week)
+	}
+
+	validateOnly := rules.String().WithRegexpCapture(isoWeekPattern, nil)
+	testhelpers.MustApply(t, validateOnly.Any(), "2024-W05")
+	testhelpers.MustNotApply(t, validateOnly.Any(), "W05", errors.CodePattern)
+}
+
+// Requirements:
+// - Panics on unsupported outputs.
+func TestWithRegexpCapture_InvalidOutput(t *testing.T) {
+	for _, out := range []any{"string", struct{}{}, map[string]string(nil), map[string]int{}} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Expected panic for %T", out)
+				}
+			}()
+			rules.String().WithRegexpCapture(isoWeekPattern, out)
+		}()
+	}
+}
@@ -0,0 +1,199 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"proto.zip/studio/validate/internal/util"
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// CardNetwork identifies a payment card network for WithCardNetwork.
+type CardNetwork string
+
+const (
+	CardNetworkVisa       CardNetwork = "Visa"
+	CardNetworkMastercard CardNetwork = "Mastercard"
+	CardNetworkAmex       CardNetwork = "Amex"
+)
+
+// cardRange is an inclusive range of card number prefixes that all have the same number of digits.
+type cardRange struct {
+	low, high string
+}
+
+// cardNetworkSpec holds the prefixes and lengths for a card network.
+type cardNetworkSpec struct {
+	prefixes []cardRange
+	lengths  []int
+}
+
+// cardNetworks holds the prefix and length rules for each supported network.
+var cardNetworks = map[CardNetwork]cardNetworkSpec{
+	CardNetworkVisa: {
+		prefixes: []cardRange{{"4", "4"}},
+		lengths:  []int{13, 16, 19},
+	},
+	CardNetworkMastercard: {
+		prefixes: []cardRange{{"51", "55"}, {"2221", "2720"}},
+		lengths:  []int{16},
+	},
+	CardNetworkAmex: {
+		prefixes: []cardRange{{"34", "34"}, {"37", "37"}},
+		lengths:  []int{15},
+	},
+}
+
+// matches returns true if the digits have a valid prefix and length for the network.
+// Prefix strings in a range have the same length so they can be compared as strings.
+func (spec cardNetworkSpec) matches(digits string) bool {
+	lengthOk := false
+	for _, length := range spec.lengths {
+		if len(digits) == length {
+			lengthOk = true
+			break
+		}
+	}
+	if !lengthOk {
+		return false
+	}
+
+	for _, r := range spec.prefixes {
+		prefix := digits[:len(r.low)]
+		if prefix >= r.low && prefix <= r.high {
+			return true
+		}
+	}
+	return false
+}
+
+// cardDigits returns the value with spaces and dashes removed and true if only digits remain.
+func cardDigits(value string) (string, bool) {
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(value)
+	if digits == "" {
+		return "", false
+	}
+
+	for i := 0; i < len(digits); i++ {
+		if digits[i] < '0' || digits[i] > '9' {
+			return "", false
+		}
+	}
+	return digits, true
+}
+
+// luhnValid returns true if the digits pass the Luhn checksum.
+func luhnValid(digits string) bool {
+	sum := 0
+	double := false
+
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+
+	return sum%10 == 0
+}
+
+// Implements the Rule interface for the Luhn checksum.
+//
+// The value is never included in error messages since it may be a card number.
+type luhnRule struct{}
+
+// Evaluate takes a context and string value and returns an error if the digits do not pass the Luhn checksum.
+// Spaces and dashes are ignored.
+func (rule *luhnRule) Evaluate(ctx context.Context, value string) errors.ValidationErrorCollection {
+	digits, ok := cardDigits(value)
+	if !ok || len(digits) < 2 {
+		return errors.Collection(
+			errors.Errorf(errors.CodePattern, ctx, "value must only contain digits, spaces and dashes"),
+		)
+	}
+
+	if !luhnValid(digits) {
+		return errors.Collection(
+			errors.Errorf(errors.CodeChecksum, ctx, "value has an invalid checksum"),
+		)
+	}
+
+	return nil
+}
+
+// Conflict returns true for any Luhn rule.
+func (rule *luhnRule) Conflict(x Rule[string]) bool {
+	_, ok := x.(*luhnRule)
+	return ok
+}
+
+// String returns the string representation of the Luhn rule.
+func (rule *luhnRule) String() string {
+	return "WithLuhn()"
+}
+
+// WithLuhn returns a new child RuleSet that requires the value to pass the Luhn checksum used by payment card
+// numbers and other identifiers. Spaces and dashes are ignored but are not removed from the output. Use
+// WithTransform to remove them.
+//
+// Values with other characters return CodePattern and values with a bad check digit return CodeChecksum.
+func (v *StringRuleSet) WithLuhn() *StringRuleSet {
+	return v.WithRule(&luhnRule{})
+}
+
+// Implements the Rule interface for card network prefixes and lengths.
+type cardNetworkRule struct {
+	networks []CardNetwork
+}
+
+// Evaluate takes a context and string value and returns an error if it does not have the prefix and length of
+// one of the networks. Spaces and dashes are ignored.
+func (rule *cardNetworkRule) Evaluate(ctx context.Context, value string) errors.ValidationErrorCollection {
+	if digits, ok := cardDigits(value); ok {
+		for _, network := range rule.networks {
+			if cardNetworks[network].matches(digits) {
+				return nil
+			}
+		}
+	}
+
+	return errors.Collection(
+		errors.Errorf(errors.CodePattern, ctx, "value must be a card number for a supported network"),
+	)
+}
+
+// Conflict returns true for any card network rule.
+func (rule *cardNetworkRule) Conflict(x Rule[string]) bool {
+	_, ok := x.(*cardNetworkRule)
+	return ok
+}
+
+// String returns the string representation of the card network rule.
+// Example: WithCardNetwork("Visa", "Mastercard")
+func (rule *cardNetworkRule) String() string {
+	return util.StringsToRuleOutput("WithCardNetwork", rule.networks)
+}
+
+// WithCardNetwork returns a new child RuleSet that requires the value to have the prefix and length of one of the
+// provided card networks. It does not check the checksum so it is usually combined with WithLuhn.
+//
+// This method panics if a network is not supported.
+func (v *StringRuleSet) WithCardNetwork(first CardNetwork, rest ...CardNetwork) *StringRuleSet {
+	networks := make([]CardNetwork, 0, 1+len(rest))
+	networks = append(networks, first)
+	networks = append(networks, rest...)
+
+	for _, network := range networks {
+		if _, ok := cardNetworks[network]; !ok {
+			panic(fmt.Errorf("unsupported card network: %q", network))
+		}
+	}
+
+	return v.WithRule(&cardNetworkRule{networks})
+}
@@ -0,0 +1,102 @@
+package rules_test
+
+import (
+	"strings"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Known good numbers pass.
+// - Spaces and dashes are ignored and kept in the output.
+// - Bad check digits return CodeChecksum.
+// - Other characters return CodePattern.
+func TestStringRuleSet_WithLuhn(t *testing.T) {
+	ruleSet := rules.String().WithLuhn().Any()
+
+	testhelpers.MustApply(t, ruleSet, "4111111111111111")
+	testhelpers.MustApply(t, ruleSet, "5555555555554444")
+	testhelpers.MustApply(t, ruleSet, "378282246310005")
+	testhelpers.MustApply(t, ruleSet, "4111 1111 1111 1111")
+	testhelpers.MustApply(t, ruleSet, "3782-822463-10005")
+
+	testhelpers.MustNotApply(t, ruleSet, "4111111111111112", errors.CodeChecksum)
+	testhelpers.MustNotApply(t, ruleSet, "4111 1111 1111 1121", errors.CodeChecksum)
+	testhelpers.MustNotApply(t, ruleSet, "4111.1111.1111.1111", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet, "4111111111111111a", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet, " - ", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet, "0", errors.CodePattern)
+}
+
+// Requirements:
+// - Error messages do not contain the value.
+func TestStringRuleSet_WithLuhnMessage(t *testing.T) {
+	value := "4111111111111112"
+
+	err := testhelpers.MustNotApply(t, rules.String().WithLuhn().WithCardNetwork(rules.CardNetworkAmex).Any(), value, errors.CodePattern)
+	if err == nil {
+		return
+	}
+
+	for _, inner := range err.(errors.ValidationErrorCollection) {
+		if strings.Contains(inner.Error(), value) {
+			t.Errorf("Expected error to not contain the value, got: %s", inner.Error())
+		}
+	}
+}
+
+// Requirements:
+// - Prefix and length must match one of the networks.
+// - Separators are ignored.
+// - Most recent networks are used.
+// - Panics on unknown networks.
+func TestStringRuleSet_WithCardNetwork(t *testing.T) {
+	visa := rules.String().WithCardNetwork(rules.CardNetworkVisa).Any()
+	testhelpers.MustApply(t, visa, "4111111111111111")
+	testhelpers.MustApply(t, visa, "4222222222222")
+	testhelpers.MustNotApply(t, visa, "41111111111111", errors.CodePattern)
+	testhelpers.MustNotApply(t, visa, "5555555555554444", errors.CodePattern)
+
+	mastercard := rules.String().WithCardNetwork(rules.CardNetworkMastercard).Any()
+	testhelpers.MustApply(t, mastercard, "5555 5555 5555 4444")
+	testhelpers.MustApply(t, mastercard, "2223003122003222")
+	testhelpers.MustNotApply(t, mastercard, "2721000000000000", errors.CodePattern)
+	testhelpers.MustNotApply(t, mastercard, "5655555555554444", errors.CodePattern)
+
+	amex := rules.String().WithCardNetwork(rules.CardNetworkAmex).Any()
+	testhelpers.MustApply(t, amex, "3782-822463-10005")
+	testhelpers.MustApply(t, amex, "371449635398431")
+	testhelpers.MustNotApply(t, amex, "4111111111111111", errors.CodePattern)
+
+	ruleSet := rules.String().WithCardNetwork(rules.CardNetworkAmex).WithCardNetwork(rules.CardNetworkVisa, rules.CardNetworkMastercard)
+	testhelpers.MustApply(t, ruleSet.Any(), "4111111111111111")
+	testhelpers.MustApply(t, ruleSet.Any(), "5555555555554444")
+	testhelpers.MustNotApply(t, ruleSet.Any(), "378282246310005", errors.CodePattern)
+
+	expected := "StringRuleSet.WithCardNetwork(Visa, Mastercard)"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic")
+		}
+	}()
+
+	rules.String().WithCardNetwork("Discover")
+}
+
+// Requirements:
+// - Serializes to WithLuhn().
+func TestStringRuleSet_WithLuhnString(t *testing.T) {
+	ruleSet := rules.String().WithLuhn().WithLuhn()
+
+	expected := "StringRuleSet.WithLuhn()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
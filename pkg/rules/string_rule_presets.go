@@ -0,0 +1,63 @@
+package rules
+
+import (
+	"regexp"
+)
+
+// Expressions used by the string format presets.
+var (
+	hexColorPattern     = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3,4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+	slugPattern         = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+	alphanumericPattern = regexp.MustCompile(`^[a-zA-Z0-9]*$`)
+	asciiPattern        = regexp.MustCompile(`^[\x00-\x7F]*$`)
+	jsonPointerPattern  = regexp.MustCompile(`^(/([^~/]|~[01])*)*$`)
+	jsonPathPattern     = regexp.MustCompile(`^\$(\.[A-Za-z_][A-Za-z0-9_]*|\.\*|\[(\*|-?[0-9]+|'([^'\\]|\\.)*'|"([^"\\]|\\.)*")\])*$`)
+)
+
+// withPreset returns a new child RuleSet with a regular expression rule that serializes with a descriptive label.
+func (v *StringRuleSet) withPreset(exp *regexp.Regexp, errorMsg, label string) *StringRuleSet {
+	return v.WithRule(&regexpRule{
+		exp:   exp,
+		msg:   errorMsg,
+		label: label,
+	})
+}
+
+// WithHexColor returns a new child RuleSet that is constrained to CSS hex colors.
+// The formats #RGB, #RGBA, #RRGGBB and #RRGGBBAA are allowed in either case.
+func (v *StringRuleSet) WithHexColor() *StringRuleSet {
+	return v.withPreset(hexColorPattern, "value must be a hex color", "WithHexColor()")
+}
+
+// WithSlug returns a new child RuleSet that is constrained to URL slugs.
+// Slugs contain lowercase letters and digits separated by single hyphens such as "my-first-post".
+func (v *StringRuleSet) WithSlug() *StringRuleSet {
+	return v.withPreset(slugPattern, "value must be a slug", "WithSlug()")
+}
+
+// WithAlphanumeric returns a new child RuleSet that is constrained to ASCII letters and digits.
+func (v *StringRuleSet) WithAlphanumeric() *StringRuleSet {
+	return v.withPreset(alphanumericPattern, "value must only contain letters and numbers", "WithAlphanumeric()")
+}
+
+// WithASCII returns a new child RuleSet that is constrained to ASCII characters.
+func (v *StringRuleSet) WithASCII() *StringRuleSet {
+	return v.withPreset(asciiPattern, "value must only contain ASCII characters", "WithASCII()")
+}
+
+// WithJSONPointer returns a new child RuleSet that is constrained to RFC 6901 JSON Pointers such as "/foo/0/bar".
+// A "~" must be escaped as "~0" and a "/" within a token must be escaped as "~1".
+//
+// The empty string is allowed since it refers to the whole document.
+func (v *StringRuleSet) WithJSONPointer() *StringRuleSet {
+	return v.withPreset(jsonPointerPattern, "value must be a JSON pointer", "WithJSONPointer()")
+}
+
+// WithJSONPath returns a new child RuleSet that is constrained to the common subset of JSONPath.
+//
+// Paths start with "$" followed by any number of dot segments (".name" or ".*") or bracket segments ("[0]", "[-1]",
+// "[*]", "['name']" or ["name"]). Quoted names may contain backslash escapes. Filters, slices, unions and
+// recursive descent are not allowed.
+func (v *StringRuleSet) WithJSONPath() *StringRuleSet {
+	return v.withPreset(jsonPathPattern, "value must be a JSON path", "WithJSONPath()")
+}
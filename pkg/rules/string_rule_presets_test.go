@@ -0,0 +1,152 @@
+package rules_test
+
+import (
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - All four hex color lengths are allowed in either case.
+// - Other lengths and characters fail with CodePattern.
+// - Serializes to WithHexColor()
+func TestString_WithHexColor(t *testing.T) {
+	ruleSet := rules.String().WithHexColor()
+
+	testhelpers.MustApply(t, ruleSet.Any(), "#fff")
+	testhelpers.MustApply(t, ruleSet.Any(), "#FFF8")
+	testhelpers.MustApply(t, ruleSet.Any(), "#a1B2c3")
+	testhelpers.MustApply(t, ruleSet.Any(), "#A1B2C3D4")
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), "fff", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "#ff", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "#fffff", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "#fffffff", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "#ggg", errors.CodePattern)
+
+	expected := "StringRuleSet.WithHexColor()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Lowercase words separated by single hyphens are allowed.
+// - Serializes to WithSlug()
+func TestString_WithSlug(t *testing.T) {
+	ruleSet := rules.String().WithSlug()
+
+	testhelpers.MustApply(t, ruleSet.Any(), "my-first-post")
+	testhelpers.MustApply(t, ruleSet.Any(), "post2")
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), "My-Post", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "my--post", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "-post", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "my_post", errors.CodePattern)
+
+	expected := "StringRuleSet.WithSlug()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Only ASCII letters and digits are allowed.
+// - Serializes to WithAlphanumeric()
+func TestString_WithAlphanumeric(t *testing.T) {
+	ruleSet := rules.String().WithAlphanumeric()
+
+	testhelpers.MustApply(t, ruleSet.Any(), "abcXYZ123")
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), "abc 123", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "café", errors.CodePattern)
+
+	expected := "StringRuleSet.WithAlphanumeric()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Only ASCII characters are allowed.
+// - Presets can be combined.
+// - Serializes to WithASCII()
+func TestString_WithASCII(t *testing.T) {
+	ruleSet := rules.String().WithASCII()
+
+	testhelpers.MustApply(t, ruleSet.Any(), "Hello, World!\n")
+	testhelpers.MustNotApply(t, ruleSet.Any(), "café", errors.CodePattern)
+
+	combined := ruleSet.WithSlug()
+	testhelpers.MustApply(t, combined.Any(), "hello-world")
+
+	expected := "StringRuleSet.WithASCII().WithSlug()"
+	if s := combined.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - RFC 6901 pointers are allowed.
+// - The empty pointer is allowed.
+// - "~" must be followed by "0" or "1".
+// - Pointers must start with "/".
+// - Serializes to WithJSONPointer()
+func TestString_WithJSONPointer(t *testing.T) {
+	ruleSet := rules.String().WithJSONPointer()
+
+	testhelpers.MustApply(t, ruleSet.Any(), "")
+	testhelpers.MustApply(t, ruleSet.Any(), "/")
+	testhelpers.MustApply(t, ruleSet.Any(), "/foo/0/bar")
+	testhelpers.MustApply(t, ruleSet.Any(), "/a~1b")
+	testhelpers.MustApply(t, ruleSet.Any(), "/m~0n")
+	testhelpers.MustApply(t, ruleSet.Any(), "/~01")
+	testhelpers.MustApply(t, ruleSet.Any(), "/foo//bar")
+	testhelpers.MustApply(t, ruleSet.Any(), "/c%d/ü")
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), "foo", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "/a~b", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "/a~2", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "/a~", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "#/foo", errors.CodePattern)
+
+	expected := "StringRuleSet.WithJSONPointer()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Dot and bracket segments are allowed.
+// - Quoted names may contain escapes.
+// - Paths must start with "$".
+// - Unsupported syntax returns an error.
+// - Serializes to WithJSONPath()
+func TestString_WithJSONPath(t *testing.T) {
+	ruleSet := rules.String().WithJSONPath()
+
+	testhelpers.MustApply(t, ruleSet.Any(), "$")
+	testhelpers.MustApply(t, ruleSet.Any(), "$.store.book[0].title")
+	testhelpers.MustApply(t, ruleSet.Any(), "$.items[*]")
+	testhelpers.MustApply(t, ruleSet.Any(), "$.*")
+	testhelpers.MustApply(t, ruleSet.Any(), "$[-1]")
+	testhelpers.MustApply(t, ruleSet.Any(), "$['first name']")
+	testhelpers.MustApply(t, ruleSet.Any(), `$["say \"hi\""]`)
+	testhelpers.MustApply(t, ruleSet.Any(), `$['it\'s']`)
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), "store.book", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "$.", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "$.0abc", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "$[0", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "$['unterminated]", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), `$['it's']`, errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "$..book", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "$[?(@.price < 10)]", errors.CodePattern)
+
+	expected := "StringRuleSet.WithJSONPath()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
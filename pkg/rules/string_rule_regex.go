@@ -4,15 +4,18 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
 )
 
 // Implements the Rule interface for regular expressions.
 type regexpRule struct {
 	NoConflict[string]
-	exp *regexp.Regexp
-	msg string
+	exp   *regexp.Regexp
+	msg   string
+	label string // label overrides the string representation for presets.
 }
 
 // Evaluate takes a context and string value and returns an error if it does not match the expected pattern.
@@ -29,9 +32,38 @@ func (rule *regexpRule) Evaluate(ctx context.Context, value string) errors.Valid
 // String returns the string representation of the regex rule.
 // Example: WithRegexp(2)
 func (rule *regexpRule) String() string {
+	if rule.label != "" {
+		return rule.label
+	}
 	return fmt.Sprintf("WithRegexp(%s)", rule.exp)
 }
 
+// matchKey returns true if Evaluate would return no errors for the value.
+// Regular expressions are checked directly so that keys that do not match don't need to build an error. This is used
+// when matching input keys against dynamic key rules.
+func (v *StringRuleSet) matchKey(ctx context.Context, value string) bool {
+	var ruleCtx context.Context
+
+	for currentRuleSet := v; currentRuleSet != nil; currentRuleSet = currentRuleSet.parent {
+		switch rule := currentRuleSet.rule.(type) {
+		case nil:
+		case *regexpRule:
+			if !rule.exp.MatchString(value) {
+				return false
+			}
+		default:
+			if ruleCtx == nil {
+				ruleCtx = rulecontext.WithRuleSet(ctx, v)
+			}
+			if rule.Evaluate(ruleCtx, value) != nil {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 // WithRegexpString returns a new child RuleSet that is constrained to the provided regular expression.
 // The second parameter is the error text, which will be localized if a translation is available.
 //
@@ -48,3 +80,66 @@ func (v *StringRuleSet) WithRegexp(exp *regexp.Regexp, errorMsg string) *StringR
 		msg: errorMsg,
 	})
 }
+
+// Implements the Rule interface for a list of regular expressions where any may match.
+type anyPatternRule struct {
+	exps []*regexp.Regexp
+}
+
+// Evaluate takes a context and string value and returns an error if it does not match any of the expected patterns.
+func (rule *anyPatternRule) Evaluate(ctx context.Context, value string) errors.ValidationErrorCollection {
+	for _, exp := range rule.exps {
+		if exp.MatchString(value) {
+			return nil
+		}
+	}
+
+	return errors.Collection(
+		errors.Errorf(errors.CodePattern, ctx, "value does not match any of the %d allowed patterns", len(rule.exps)),
+	)
+}
+
+// Conflict returns true for any other any pattern rule.
+func (rule *anyPatternRule) Conflict(x Rule[string]) bool {
+	_, ok := x.(*anyPatternRule)
+	return ok
+}
+
+// String returns the string representation of the any pattern rule.
+// Example: WithAnyPattern(^[a-z]+$, ^[0-9]+$)
+func (rule *anyPatternRule) String() string {
+	strs := make([]string, len(rule.exps))
+	for i, exp := range rule.exps {
+		strs[i] = exp.String()
+	}
+	return fmt.Sprintf("WithAnyPattern(%s)", strings.Join(strs, ", "))
+}
+
+// WithAnyPattern returns a new child RuleSet that passes if the value matches at least one of the
+// provided regular expressions.
+//
+// Unlike chaining WithRegexp, where every expression must match, only one of the expressions needs to match.
+// Calling WithAnyPattern again replaces the previous list of patterns.
+//
+// This method panics if no expressions are provided.
+func (v *StringRuleSet) WithAnyPattern(patterns ...*regexp.Regexp) *StringRuleSet {
+	if len(patterns) == 0 {
+		panic(fmt.Errorf("at least one pattern is required"))
+	}
+
+	return v.WithRule(&anyPatternRule{
+		exps: patterns,
+	})
+}
+
+// WithAnyPatternString returns a new child RuleSet that passes if the value matches at least one of the
+// provided regular expressions.
+//
+// This method panics if any of the expressions cannot be compiled or if no expressions are provided.
+func (v *StringRuleSet) WithAnyPatternString(patterns ...string) *StringRuleSet {
+	exps := make([]*regexp.Regexp, len(patterns))
+	for i, pattern := range patterns {
+		exps[i] = regexp.MustCompile(pattern)
+	}
+	return v.WithAnyPattern(exps...)
+}
@@ -62,3 +62,70 @@ func TestRegexStringSerialize(t *testing.T) {
 		t.Errorf("Expected rule set to be %s, got %s", expected, s)
 	}
 }
+
+// Requirements:
+// - Passes if any of the patterns match
+// - Returns a single pattern error if none match
+func TestAnyPattern(t *testing.T) {
+	ruleSet := rules.String().WithAnyPattern(
+		regexp.MustCompile(`^\d{3}-\d{4}$`),
+		regexp.MustCompile(`^\(\d{3}\) \d{3}-\d{4}$`),
+	).Any()
+
+	testhelpers.MustApply(t, ruleSet, "555-1234")
+	testhelpers.MustApply(t, ruleSet, "(555) 555-1234")
+
+	err := testhelpers.MustNotApply(t, ruleSet, "5551234", errors.CodePattern)
+	if err != nil {
+		if errs := err.(errors.ValidationErrorCollection); len(errs) != 1 {
+			t.Errorf("Expected 1 error, got: %d", len(errs))
+		}
+	}
+}
+
+// Requirements:
+// - Compiles the provided strings
+// - Panics on invalid regexp string
+// - Panics when no patterns are provided
+func TestAnyPatternString(t *testing.T) {
+	ruleSet := rules.String().WithAnyPatternString("^[a-z]+$", "^[0-9]+$").Any()
+
+	testhelpers.MustApply(t, ruleSet, "abc")
+	testhelpers.MustApply(t, ruleSet, "123")
+	testhelpers.MustNotApply(t, ruleSet, "abc123", errors.CodePattern)
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("Expected panic")
+			}
+		}()
+
+		rules.String().WithAnyPatternString("^[a-z]+$", "[[[")
+	}()
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("Expected panic")
+			}
+		}()
+
+		rules.String().WithAnyPattern()
+	}()
+}
+
+// Requirements:
+// - Serializes to WithAnyPattern(...)
+// - Replaces a previous any pattern rule
+func TestAnyPatternSerialize(t *testing.T) {
+	ruleSet := rules.String().
+		WithAnyPatternString("[a-z]", "[A-Z]").
+		WithRegexpString("[0-9]", "").
+		WithAnyPatternString("^x$", "^y$")
+
+	expected := "StringRuleSet.WithRegexp([0-9]).WithAnyPattern(^x$, ^y$)"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
@@ -12,6 +12,8 @@ import (
 type stringValuesRule struct {
 	values []string
 	allow  bool
+	code   errors.ErrorCode // code is the error code for rejected values.
+	label  string           // label is the method name for rejected values.
 }
 
 // exists returns true if the value exists in the rule
@@ -48,7 +50,7 @@ func (rule *stringValuesRule) Evaluate(ctx context.Context, value string) errors
 		}
 	} else if exists {
 		return errors.Collection(
-			errors.Errorf(errors.CodeForbidden, ctx, "field value is not allowed"),
+			errors.Errorf(rule.code, ctx, "field value is not allowed"),
 		)
 	}
 
@@ -71,7 +73,7 @@ func (rule *stringValuesRule) Conflict(x Rule[string]) bool {
 // Example: WithAllowedValues("b", "b", "c")
 func (rule *stringValuesRule) String() string {
 	if !rule.allow {
-		return util.StringsToRuleOutput("WithRejectedValues", rule.values)
+		return util.StringsToRuleOutput(rule.label, rule.values)
 
 	}
 	return util.StringsToRuleOutput("WithAllowedValues", rule.values)
@@ -116,16 +118,13 @@ func (ruleSet *StringRuleSet) WithAllowedValues(value string, rest ...string) *S
 	slices.Sort(values)
 
 	return ruleSet.WithRule(&stringValuesRule{
-		values,
-		true,
+		values: values,
+		allow:  true,
 	})
 }
 
-// WithRejectedValues returns a new child RuleSet that is checked against the provided list of values hat should be rejected.
-// This method can be called more than once.
-//
-// Rejected values will always be rejected even if they are in the allowed values list.
-func (ruleSet *StringRuleSet) WithRejectedValues(value string, rest ...string) *StringRuleSet {
+// withRejectedValues returns a new child RuleSet that returns an error with the code for any of the values.
+func (ruleSet *StringRuleSet) withRejectedValues(code errors.ErrorCode, label string, value string, rest []string) *StringRuleSet {
 	values := make([]string, 0, 1+len(rest))
 	values = append(values, value)
 	values = append(values, rest...)
@@ -133,7 +132,35 @@ func (ruleSet *StringRuleSet) WithRejectedValues(value string, rest ...string) *
 	slices.Sort(values)
 
 	return ruleSet.WithRule(&stringValuesRule{
-		values,
-		false,
+		values: values,
+		code:   code,
+		label:  label,
 	})
 }
+
+// WithRejectedValues returns a new child RuleSet that is checked against the provided list of values hat should be rejected.
+// This method can be called more than once.
+//
+// Rejected values will always be rejected even if they are in the allowed values list.
+func (ruleSet *StringRuleSet) WithRejectedValues(value string, rest ...string) *StringRuleSet {
+	return ruleSet.withRejectedValues(errors.CodeForbidden, "WithRejectedValues", value, rest)
+}
+
+// WithForbiddenValues behaves like WithRejectedValues except matching values return an errors.CodeNotAllowed error,
+// the same as a value missing from WithAllowedValues, rather than errors.CodeForbidden. Use it for values such as
+// reserved names that are simply not available.
+//
+// Values are compared after normalization and WithLowercase, so use lowercase values for case insensitive
+// comparison.
+func (ruleSet *StringRuleSet) WithForbiddenValues(value string, rest ...string) *StringRuleSet {
+	return ruleSet.withRejectedValues(errors.CodeNotAllowed, "WithForbiddenValues", value, rest)
+}
+
+// AllowedValues returns the values allowed by WithAllowedValues in sorted order or nil if there are no allowed
+// values. This is useful for generating documentation or schemas.
+func (ruleSet *StringRuleSet) AllowedValues() []string {
+	if rule := ruleSet.getValuesRule(true); rule != nil {
+		return append([]string(nil), rule.values...)
+	}
+	return nil
+}
@@ -89,3 +89,72 @@ func TestWithRejectedValues(t *testing.T) {
 		t.Errorf("Expected rule set to be %s, got %s", expected, s)
 	}
 }
+
+// Requirements:
+// - Forbidden values are cumulative.
+// - Forbidden values causes a validation error.
+// - Each call is shown in String().
+// - Forbidden values return CodeNotAllowed.
+func TestWithForbiddenValues(t *testing.T) {
+	ruleSet := rules.String().WithForbiddenValues("root", "admin")
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), "admin", errors.CodeNotAllowed)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "root", errors.CodeNotAllowed)
+	testhelpers.MustApply(t, ruleSet.Any(), "api")
+	testhelpers.MustApply(t, ruleSet.Any(), "Admin")
+
+	expected := fmt.Sprintf("StringRuleSet.WithForbiddenValues(\"admin\", \"root\")")
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+
+	ruleSet = ruleSet.WithMinLen(2).WithForbiddenValues("api", "root")
+	testhelpers.MustNotApply(t, ruleSet.Any(), "admin", errors.CodeNotAllowed)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "api", errors.CodeNotAllowed)
+
+	expected = fmt.Sprintf("StringRuleSet.WithForbiddenValues(\"admin\", \"root\").WithMinLen(2).WithForbiddenValues(\"api\", \"root\")")
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Allowed and forbidden values are both enforced.
+// - Forbidden values do not replace rejected values.
+func TestWithForbiddenAndAllowedValues(t *testing.T) {
+	ruleSet := rules.String().
+		WithAllowedValues("admin", "alice", "bob").
+		WithRejectedValues("bob").
+		WithForbiddenValues("admin")
+
+	testhelpers.MustApply(t, ruleSet.Any(), "alice")
+	testhelpers.MustNotApply(t, ruleSet.Any(), "admin", errors.CodeNotAllowed)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "bob", errors.CodeForbidden)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "carol", errors.CodeNotAllowed)
+
+	expected := fmt.Sprintf("StringRuleSet.WithAllowedValues(\"admin\", \"alice\", \"bob\").WithRejectedValues(\"bob\").WithForbiddenValues(\"admin\")")
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Forbidden values are compared against the lowercase value when WithLowercase is chained.
+// - The lowercase value is written to the output.
+// - Serializes to WithLowercase().
+func TestWithForbiddenValues_Lowercase(t *testing.T) {
+	ruleSet := rules.String().WithLowercase().WithForbiddenValues("admin", "root")
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), "Admin", errors.CodeNotAllowed)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "ROOT", errors.CodeNotAllowed)
+	testhelpers.MustApplyMutation(t, ruleSet.Any(), "Alice", "alice")
+
+	if ruleSet.WithLowercase() != ruleSet.WithLowercase().WithLowercase() {
+		t.Error("Expected WithLowercase to be idempotent")
+	}
+
+	expected := "StringRuleSet.WithLowercase().WithForbiddenValues(\"admin\", \"root\")"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
@@ -0,0 +1,55 @@
+package rules
+
+import (
+	"strings"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// sensitiveMask replaces sensitive values in error messages and metadata.
+const sensitiveMask = "***"
+
+// WithSensitive returns a new child RuleSet that keeps the value out of any errors it returns. Use this for
+// passwords, tokens and other secrets so they do not end up in logs.
+//
+// Any occurrence of the value in the message or in string metadata of an error is replaced with "***". This
+// includes errors from custom rules, transforms and coercion, so rules can safely include the value in their
+// messages. Empty values are not masked.
+func (v *StringRuleSet) WithSensitive() *StringRuleSet {
+	if v.sensitive {
+		return v
+	}
+
+	newRuleSet := v.clone()
+	newRuleSet.sensitive = true
+	newRuleSet.label = "WithSensitive()"
+	return newRuleSet
+}
+
+// maskErrors returns a copy of the errors with every occurrence of the value replaced with the mask.
+// The code, path, severity and permission flag of each error are kept.
+func maskErrors(errs errors.ValidationErrorCollection, value string) errors.ValidationErrorCollection {
+	if value == "" || len(errs) == 0 {
+		return errs
+	}
+
+	masked := make([]errors.ValidationError, len(errs))
+	for i, err := range errs {
+		var meta map[string]any
+		if original := errors.MetaOf(err); original != nil {
+			meta = make(map[string]any, len(original))
+			for k, v := range original {
+				if str, ok := v.(string); ok {
+					v = strings.ReplaceAll(str, value, sensitiveMask)
+				}
+				meta[k] = v
+			}
+		}
+
+		maskedErr := errors.New(err.Code(), err.Path(), strings.ReplaceAll(err.Error(), value, sensitiveMask))
+		maskedErr = errors.WithMeta(errors.WithSeverity(maskedErr, errors.SeverityOf(err)), meta)
+		masked[i] = errors.WithPermission(maskedErr, errors.IsPermission(err))
+	}
+
+	return errors.Collection(masked...)
+}
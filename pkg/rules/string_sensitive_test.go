@@ -0,0 +1,90 @@
+package rules_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// leakyRule returns a rule that includes the value in the error message and metadata.
+func leakyRule(ctx context.Context, value string) errors.ValidationErrorCollection {
+	err := errors.Errorf(errors.CodePattern, ctx, "password %q is too weak", value)
+	return errors.Collection(errors.WithMeta(err, map[string]any{"value": value, "score": 1}))
+}
+
+// mustNotLeak fails the test if any error contains the secret.
+func mustNotLeak(t *testing.T, errs errors.ValidationErrorCollection, secret string) {
+	t.Helper()
+
+	if errs == nil {
+		t.Fatal("Expected errors to not be nil")
+	}
+
+	for _, err := range errs {
+		if strings.Contains(err.Error(), secret) {
+			t.Errorf("Expected message to not contain the value, got: %s", err)
+		}
+		for k, v := range errors.MetaOf(err) {
+			if strings.Contains(fmt.Sprint(v), secret) {
+				t.Errorf("Expected meta %s to not contain the value, got: %v", k, v)
+			}
+		}
+	}
+}
+
+// Requirements:
+// - Rule errors do not contain the value.
+// - Transform errors do not contain the value.
+// - Code, path, severity and non-string metadata are kept.
+// - Without WithSensitive the value is returned as is.
+// - Serializes to WithSensitive().
+func TestStringWithSensitive(t *testing.T) {
+	secret := "hunter2-secret"
+	var output string
+
+	errs := rules.String().WithRuleFunc(leakyRule).Apply(context.Background(), secret, &output)
+	if errs == nil || !strings.Contains(errs.First().Error(), secret) {
+		t.Fatalf("Expected message to contain the value, got: %v", errs)
+	}
+
+	ruleSet := rules.String().WithSensitive().WithRuleFunc(leakyRule)
+
+	errs = ruleSet.Apply(context.Background(), secret, &output)
+	mustNotLeak(t, errs, secret)
+
+	err := errs.First()
+	if err.Code() != errors.CodePattern {
+		t.Errorf("Expected code to be %s, got: %s", errors.CodePattern, err.Code())
+	}
+	if msg := err.Error(); msg != `password "***" is too weak` {
+		t.Errorf("Expected value to be masked, got: %s", msg)
+	}
+	if score := errors.MetaOf(err)["score"]; score != 1 {
+		t.Errorf("Expected score to be kept, got: %v", score)
+	}
+
+	mustNotLeak(t, ruleSet.Evaluate(context.Background(), secret), secret)
+
+	warning := ruleSet.WithSeverity(errors.SeverityWarning).Evaluate(context.Background(), secret)
+	if s := errors.SeverityOf(warning.First()); s != errors.SeverityWarning {
+		t.Errorf("Expected severity to be %s, got: %s", errors.SeverityWarning, s)
+	}
+
+	transformed := rules.String().WithSensitive().WithTransform(func(_ context.Context, value any) (any, error) {
+		return nil, fmt.Errorf("could not decode %s", value)
+	})
+	mustNotLeak(t, transformed.Apply(context.Background(), secret, &output), secret)
+
+	expected := "StringRuleSet.WithSensitive().WithRuleFunc(...)"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+
+	if ruleSet := rules.String().WithSensitive(); ruleSet.WithSensitive() != ruleSet {
+		t.Error("Expected WithSensitive to return the same rule set when already sensitive")
+	}
+}
@@ -2,6 +2,7 @@ package rules_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"proto.zip/studio/validate/pkg/errors"
@@ -182,3 +183,130 @@ func TestString_WithStrict(t *testing.T) {
 		t.Errorf("Expected rule set to be %s, got %s", expected, s)
 	}
 }
+
+// Requirements:
+// - Length is measured in bytes by default.
+// - WithRuneLength measures length in code points.
+// - The mode applies to length rules added before it.
+// - Combining characters count as separate code points.
+func TestString_WithRuneLength(t *testing.T) {
+	emoji := "😀😀😀😀😀😀😀😀"           // 8 code points, 32 bytes
+	combining := "e\u0301e\u0301" // 4 code points, 6 bytes
+
+	byteRuleSet := rules.String().WithMaxLen(16).Any()
+	testhelpers.MustNotApply(t, byteRuleSet, emoji, errors.CodeMax)
+
+	runeRuleSet := rules.String().WithMaxLen(16).WithRuneLength().Any()
+	testhelpers.MustApply(t, runeRuleSet, emoji)
+
+	minRuleSet := rules.String().WithRuneLength().WithMinLen(5).Any()
+	testhelpers.MustNotApply(t, minRuleSet, combining, errors.CodeMin)
+	testhelpers.MustApply(t, minRuleSet, combining+"e")
+
+	exactRuleSet := rules.String().WithRuneLength().WithMinLen(4).WithMaxLen(4).Any()
+	testhelpers.MustApply(t, exactRuleSet, combining)
+	testhelpers.MustApply(t, exactRuleSet, "😀😀😀😀")
+	testhelpers.MustNotApply(t, exactRuleSet, "😀😀😀", errors.CodeMin)
+}
+
+// Requirements:
+// - WithByteLength switches back to measuring bytes.
+// - Setting the same mode twice does not add a label.
+// - Serializes to WithRuneLength() and WithByteLength()
+func TestString_WithByteLength(t *testing.T) {
+	ruleSet := rules.String().WithMaxLen(4).WithRuneLength().WithRuneLength()
+
+	testhelpers.MustApply(t, ruleSet.Any(), "😀😀")
+
+	byteRuleSet := ruleSet.WithByteLength().WithByteLength()
+	testhelpers.MustNotApply(t, byteRuleSet.Any(), "😀😀", errors.CodeMax)
+
+	expected := "StringRuleSet.WithMaxLen(4).WithRuneLength().WithByteLength()"
+	if s := byteRuleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+
+	if rs := rules.String(); rs.WithByteLength() != rs {
+		t.Errorf("Expected WithByteLength to return the same rule set by default")
+	}
+}
+
+// Requirements:
+// - WithGraphemeLength counts extended grapheme clusters.
+// - Family emoji, flags and combining characters count as a single character.
+// - Serializes to WithGraphemeLength()
+func TestString_WithGraphemeLength(t *testing.T) {
+	family := "\U0001F468\u200d\U0001F469\u200d\U0001F467\u200d\U0001F466" // 7 code points
+	flag := "\U0001F1FA\U0001F1F8"                                         // 2 code points
+	thumbs := "\U0001F44D\U0001F3FD"                                       // 2 code points
+	combining := "e\u0301"                                                 // 2 code points
+
+	ruleSet := rules.String().WithMinLen(2).WithMaxLen(2).WithGraphemeLength()
+
+	testhelpers.MustApply(t, ruleSet.Any(), family+flag)
+	testhelpers.MustApply(t, ruleSet.Any(), thumbs+combining)
+	testhelpers.MustNotApply(t, ruleSet.Any(), family, errors.CodeMin)
+	testhelpers.MustNotApply(t, ruleSet.Any(), flag+flag+flag, errors.CodeMax)
+
+	testhelpers.MustNotApply(t, ruleSet.WithRuneLength().Any(), family+flag, errors.CodeMax)
+
+	expected := "StringRuleSet.WithMinLen(2).WithMaxLen(2).WithGraphemeLength()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// stringerValue is a fmt.Stringer used to test string coercion.
+type stringerValue struct{}
+
+func (stringerValue) String() string {
+	return "stringer"
+}
+
+// Requirements:
+// - Strings and string pointers are accepted in both modes.
+// - []byte, json.RawMessage and fmt.Stringer are converted in the default mode.
+// - json.RawMessage must contain a JSON string.
+// - Strict mode rejects everything except strings and string pointers.
+// - Nil string pointers are rejected in both modes.
+func TestStringCoercionModes(t *testing.T) {
+	s := "hello"
+
+	accepted := []struct {
+		name     string
+		input    any
+		expected string
+	}{
+		{"string", "hello", "hello"},
+		{"*string", &s, "hello"},
+		{"[]byte", []byte("hello"), "hello"},
+		{"json.RawMessage", json.RawMessage(`"hello"`), "hello"},
+		{"fmt.Stringer", stringerValue{}, "stringer"},
+	}
+
+	for _, tc := range accepted {
+		var out string
+		if err := rules.String().Apply(context.Background(), tc.input, &out); err != nil {
+			t.Errorf("Expected %s to be accepted, got: %s", tc.name, err)
+		} else if out != tc.expected {
+			t.Errorf("Expected %s to convert to %q, got: %q", tc.name, tc.expected, out)
+		}
+
+		out = ""
+		err := rules.String().WithStrict().Apply(context.Background(), tc.input, &out)
+		if tc.name == "string" || tc.name == "*string" {
+			if err != nil {
+				t.Errorf("Expected %s to be accepted in strict mode, got: %s", tc.name, err)
+			} else if out != tc.expected {
+				t.Errorf("Expected %s to convert to %q in strict mode, got: %q", tc.name, tc.expected, out)
+			}
+		} else if err == nil || err.First().Code() != errors.CodeType {
+			t.Errorf("Expected %s to be a type error in strict mode, got: %v", tc.name, err)
+		}
+	}
+
+	var nilString *string
+	testhelpers.MustNotApply(t, rules.String().Any(), nilString, errors.CodeType)
+	testhelpers.MustNotApply(t, rules.String().WithStrict().Any(), nilString, errors.CodeType)
+	testhelpers.MustNotApply(t, rules.String().Any(), json.RawMessage(`123`), errors.CodeType)
+}
@@ -0,0 +1,55 @@
+package rules_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// customID is a custom ID type that implements fmt.Stringer.
+type customID int
+
+func (id customID) String() string {
+	return fmt.Sprintf("id-%d", id)
+}
+
+// textID is a custom ID type that implements encoding.TextMarshaler.
+type textID struct {
+	value string
+	err   error
+}
+
+func (id textID) MarshalText() ([]byte, error) {
+	return []byte(id.value), id.err
+}
+
+// Requirements:
+// - Stringer and TextMarshaler values are validated by their text representation.
+// - Text coercion applies to strict rule sets.
+// - MarshalText errors are type errors.
+// - Serializes to WithTextCoercion().
+func TestStringWithTextCoercion(t *testing.T) {
+	ruleSet := rules.String().WithStrict().WithTextCoercion().WithMinLen(4)
+
+	testhelpers.MustNotApply(t, rules.String().WithStrict().Any(), customID(7), errors.CodeType)
+	testhelpers.MustApplyMutation(t, ruleSet.Any(), customID(7), "id-7")
+	testhelpers.MustApplyMutation(t, ruleSet.Any(), textID{value: "abcd"}, "abcd")
+	testhelpers.MustNotApply(t, ruleSet.Any(), textID{value: "abc"}, errors.CodeMin)
+	testhelpers.MustNotApply(t, ruleSet.Any(), textID{err: fmt.Errorf("bad id")}, errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet.Any(), 1234, errors.CodeType)
+
+	// TextMarshaler is not accepted without text coercion
+	var out string
+	if err := rules.String().Apply(context.Background(), textID{value: "abcd"}, &out); err == nil {
+		t.Error("Expected TextMarshaler to be rejected without text coercion")
+	}
+
+	expected := "StringRuleSet.WithStrict().WithTextCoercion().WithMinLen(4)"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
@@ -0,0 +1,240 @@
+package time
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"proto.zip/studio/validate/internal/util"
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// DateRuleSet implements the RuleSet interface for calendar dates without a time of day.
+//
+// Strings must use the ISO 8601 date only layout (YYYY-MM-DD) and must be real calendar dates, so "2023-02-30"
+// is rejected. Dates are represented as a time.Time at midnight UTC.
+type DateRuleSet struct {
+	rules.NoConflict[time.Time]
+	required bool
+	withNil  bool
+	parent   *DateRuleSet
+	rule     rules.Rule[time.Time]
+	label    string
+}
+
+// baseDateRuleSet is the base date rule set. Since rule sets are immutable.
+var baseDateRuleSet DateRuleSet = DateRuleSet{
+	label: "DateRuleSet",
+}
+
+// Date returns the base date RuleSet.
+func Date() *DateRuleSet {
+	return &baseDateRuleSet
+}
+
+// dateOf returns the calendar date of t as midnight UTC.
+func dateOf(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// Required returns a boolean indicating if the value is allowed to be omitted when included in a nested object.
+func (ruleSet *DateRuleSet) Required() bool {
+	return ruleSet.required
+}
+
+// WithRequired returns a new rule set with the required flag set.
+// Use WithRequired when nesting a RuleSet and the a value is not allowed to be omitted.
+func (ruleSet *DateRuleSet) WithRequired() *DateRuleSet {
+	if ruleSet.required {
+		return ruleSet
+	}
+
+	return &DateRuleSet{
+		required: true,
+		withNil:  ruleSet.withNil,
+		parent:   ruleSet,
+		label:    "WithRequired()",
+	}
+}
+
+// WithNil returns a new child rule set that accepts a nil date, such as a JSON null or a nil *time.Time, and leaves
+// the output unchanged. Otherwise a nil input returns an errors.CodeType error.
+func (ruleSet *DateRuleSet) WithNil() *DateRuleSet {
+	if ruleSet.withNil {
+		return ruleSet
+	}
+
+	return &DateRuleSet{
+		required: ruleSet.required,
+		withNil:  true,
+		parent:   ruleSet,
+		label:    "WithNil()",
+	}
+}
+
+// Apply performs a validation of a RuleSet against a value and assigns the result to the output parameter.
+// It returns a ValidationErrorCollection if any validation errors occur.
+//
+// Input may be a YYYY-MM-DD string or a time.Time, in which case the time of day is dropped. The output may be
+// a time.Time, a string or an interface. Strings are formatted as YYYY-MM-DD.
+func (ruleSet *DateRuleSet) Apply(ctx context.Context, input any, output any) errors.ValidationErrorCollection {
+	// Nil is only allowed with WithNil
+	if ruleSet.withNil && util.IsNil(input) {
+		return nil
+	}
+
+	// Ensure output is a non-nil pointer
+	outputVal := reflect.ValueOf(output)
+	if outputVal.Kind() != reflect.Ptr || outputVal.IsNil() {
+		return errors.Collection(errors.Errorf(
+			errors.CodeInternal, ctx, "Output must be a non-nil pointer",
+		))
+	}
+
+	var t time.Time
+
+	switch x := input.(type) {
+	case time.Time:
+		t = dateOf(x)
+	case *time.Time:
+		if x == nil {
+			return errors.Collection(errors.NewCoercionError(ctx, "date", "nil"))
+		}
+		t = dateOf(*x)
+	case string:
+		var err error
+		t, err = time.Parse(time.DateOnly, x)
+		if err != nil {
+			return errors.Collection(errors.Errorf(errors.CodePattern, ctx, "value must be a valid date in the format YYYY-MM-DD"))
+		}
+	default:
+		return errors.Collection(errors.NewCoercionError(ctx, "date", reflect.ValueOf(input).Kind().String()))
+	}
+
+	errs := ruleSet.Evaluate(ctx, t)
+	if errs.HasErrors() {
+		return errs
+	}
+
+	outputElem := outputVal.Elem()
+
+	switch {
+	case outputElem.Kind() == reflect.Interface && outputElem.IsNil():
+		outputElem.Set(reflect.ValueOf(t))
+	case outputElem.Type().AssignableTo(reflect.TypeOf(t)):
+		outputElem.Set(reflect.ValueOf(t))
+	case outputElem.Kind() == reflect.String:
+		outputElem.SetString(t.Format(time.DateOnly))
+	default:
+		return errors.Collection(errors.Errorf(
+			errors.CodeInternal, ctx, "Cannot assign %T to %T", t, output,
+		))
+	}
+
+	return errs
+}
+
+// Evaluate performs a validation of a RuleSet against a time.Time value and returns a ValidationErrorCollection.
+// Only the calendar date of the value is considered.
+func (ruleSet *DateRuleSet) Evaluate(ctx context.Context, value time.Time) errors.ValidationErrorCollection {
+	allErrors := errors.Collection()
+
+	value = dateOf(value)
+	currentRuleSet := ruleSet
+	ctx = rulecontext.WithRuleSet(ctx, ruleSet)
+
+	for currentRuleSet != nil {
+		if currentRuleSet.rule != nil {
+			if errs := currentRuleSet.rule.Evaluate(ctx, value); errs != nil {
+				allErrors = append(allErrors, errs...)
+			}
+		}
+
+		currentRuleSet = currentRuleSet.parent
+	}
+
+	if len(allErrors) > 0 {
+		return allErrors
+	} else {
+		return nil
+	}
+}
+
+// noConflict returns the new array rule set with all conflicting rules removed.
+// Does not mutate the existing rule sets.
+func (ruleSet *DateRuleSet) noConflict(rule rules.Rule[time.Time]) *DateRuleSet {
+	if ruleSet.rule != nil {
+
+		// Conflicting rules, skip this and return the parent
+		if rule.Conflict(ruleSet.rule) {
+			return ruleSet.parent.noConflict(rule)
+		}
+
+	}
+
+	if ruleSet.parent == nil {
+		return ruleSet
+	}
+
+	newParent := ruleSet.parent.noConflict(rule)
+
+	if newParent == ruleSet.parent {
+		return ruleSet
+	}
+
+	return &DateRuleSet{
+		rule:     ruleSet.rule,
+		parent:   newParent,
+		required: ruleSet.required,
+		withNil:  ruleSet.withNil,
+		label:    ruleSet.label,
+	}
+}
+
+// WithRule returns a new child rule set with a rule added to the list of
+// rules to evaluate. WithRule takes an implementation of the Rule interface
+// for the time.Time type.
+//
+// Use this when implementing custom rules.
+func (ruleSet *DateRuleSet) WithRule(rule rules.Rule[time.Time]) *DateRuleSet {
+	return &DateRuleSet{
+		rule:     rule,
+		parent:   ruleSet.noConflict(rule),
+		required: ruleSet.required,
+		withNil:  ruleSet.withNil,
+	}
+}
+
+// WithRuleFunc returns a new child rule set with a rule added to the list of
+// rules to evaluate. WithRuleFunc takes an implementation of the Rule interface
+// for the time.Time type.
+//
+// Use this when implementing custom rules.
+func (v *DateRuleSet) WithRuleFunc(rule rules.RuleFunc[time.Time]) *DateRuleSet {
+	return v.WithRule(rule)
+}
+
+// Any returns a new RuleSet that wraps the date RuleSet in any Any rule set
+// which can then be used in nested validation.
+func (ruleSet *DateRuleSet) Any() rules.RuleSet[any] {
+	return rules.WrapAny[time.Time](ruleSet)
+}
+
+// String returns a string representation of the rule set suitable for debugging.
+func (ruleSet *DateRuleSet) String() string {
+	label := ruleSet.label
+
+	if label == "" {
+		if ruleSet.rule != nil {
+			label = ruleSet.rule.String()
+		}
+	}
+
+	if ruleSet.parent != nil {
+		return ruleSet.parent.String() + "." + label
+	}
+	return label
+}
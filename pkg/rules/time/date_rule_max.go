@@ -0,0 +1,82 @@
+package time
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// Implements the Rule interface for maximum date
+type maxDateRule struct {
+	max time.Time
+}
+
+// Evaluate takes a context and date value and returns an error if it is not on or before the specified date.
+func (rule *maxDateRule) Evaluate(ctx context.Context, value time.Time) errors.ValidationErrorCollection {
+	if value.After(rule.max) {
+		return errors.Collection(
+			errors.Errorf(errors.CodeMax, ctx, "field must be on or before %s", rule.max.Format(time.DateOnly)),
+		)
+	}
+
+	return nil
+}
+
+// Conflict returns true for any maximum date rule.
+func (rule *maxDateRule) Conflict(x rules.Rule[time.Time]) bool {
+	_, ok := x.(*maxDateRule)
+	return ok
+}
+
+// String returns the string representation of the maximum date rule.
+// Example: WithMaxDate(2023-12-31)
+func (rule *maxDateRule) String() string {
+	return fmt.Sprintf("WithMaxDate(%s)", rule.max.Format(time.DateOnly))
+}
+
+// WithMaxDate returns a new child RuleSet that is constrained to dates on or before the provided date.
+// The time of day of max is ignored.
+func (v *DateRuleSet) WithMaxDate(max time.Time) *DateRuleSet {
+	return v.WithRule(&maxDateRule{
+		dateOf(max),
+	})
+}
+
+// Implements the Rule interface for dates that are not in the future
+type notFutureDateRule struct{}
+
+// Evaluate takes a context and date value and returns an error if it is after the current date.
+// The current date is read from rulecontext.Now.
+func (rule *notFutureDateRule) Evaluate(ctx context.Context, value time.Time) errors.ValidationErrorCollection {
+	if value.After(dateOf(rulecontext.Now(ctx))) {
+		return errors.Collection(
+			errors.Errorf(errors.CodeMax, ctx, "field must not be in the future"),
+		)
+	}
+
+	return nil
+}
+
+// Conflict returns true for any not future rule.
+func (rule *notFutureDateRule) Conflict(x rules.Rule[time.Time]) bool {
+	_, ok := x.(*notFutureDateRule)
+	return ok
+}
+
+// String returns the string representation of the not future rule.
+func (rule *notFutureDateRule) String() string {
+	return "WithNotFuture()"
+}
+
+// WithNotFuture returns a new child RuleSet that only allows today or earlier.
+// This is useful for values such as birth dates.
+//
+// The current time is read from the context when the rule is evaluated so it can be set in tests
+// using rulecontext.WithNow.
+func (v *DateRuleSet) WithNotFuture() *DateRuleSet {
+	return v.WithRule(&notFutureDateRule{})
+}
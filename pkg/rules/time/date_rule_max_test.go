@@ -0,0 +1,59 @@
+package time_test
+
+import (
+	"context"
+	"testing"
+	internalTime "time"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+	"proto.zip/studio/validate/pkg/rules/time"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Dates after the maximum return CodeMax.
+// - The maximum date itself is allowed regardless of time of day.
+// - Most recent maximum is used.
+func TestDateRuleSet_WithMaxDate(t *testing.T) {
+	max := internalTime.Date(2000, internalTime.January, 1, 0, 0, 0, 0, internalTime.UTC)
+
+	ruleSet := time.Date().WithMaxDate(max)
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), "2000-01-02", errors.CodeMax)
+	testhelpers.MustApplyAny(t, ruleSet.Any(), "2000-01-01")
+	testhelpers.MustApplyAny(t, ruleSet.Any(), max.Add(23*internalTime.Hour))
+
+	ruleSet = ruleSet.WithMaxDate(max.AddDate(1, 0, 0))
+	testhelpers.MustApplyAny(t, ruleSet.Any(), "2000-01-02")
+
+	expected := "DateRuleSet.WithMaxDate(2001-01-01)"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Dates after today return CodeMax.
+// - Today and earlier are allowed.
+// - The current time is read from the context.
+func TestDateRuleSet_WithNotFuture(t *testing.T) {
+	now := internalTime.Date(2023, internalTime.September, 29, 0, 1, 0, 0, internalTime.UTC)
+	ctx := rulecontext.WithNow(context.Background(), func() internalTime.Time { return now })
+
+	ruleSet := time.Date().WithNotFuture()
+
+	var output internalTime.Time
+
+	if err := ruleSet.Apply(ctx, "2023-09-30", &output); err == nil {
+		t.Error("Expected error to not be nil")
+	} else if code := err.First().Code(); code != errors.CodeMax {
+		t.Errorf("Expected error code to be %s, got: %s", errors.CodeMax, code)
+	}
+
+	for _, input := range []string{"2023-09-29", "1990-01-01"} {
+		if err := ruleSet.Apply(ctx, input, &output); err != nil {
+			t.Errorf("Expected error to be nil for %s, got: %s", input, err)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package time
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+	"proto.zip/studio/validate/pkg/rules"
+)
+
+// Implements the Rule interface for minimum date
+type minDateRule struct {
+	min time.Time
+}
+
+// Evaluate takes a context and date value and returns an error if it is not on or after the specified date.
+func (rule *minDateRule) Evaluate(ctx context.Context, value time.Time) errors.ValidationErrorCollection {
+	if value.Before(rule.min) {
+		return errors.Collection(
+			errors.Errorf(errors.CodeMin, ctx, "field must be on or after %s", rule.min.Format(time.DateOnly)),
+		)
+	}
+
+	return nil
+}
+
+// Conflict returns true for any minimum date rule.
+func (rule *minDateRule) Conflict(x rules.Rule[time.Time]) bool {
+	_, ok := x.(*minDateRule)
+	return ok
+}
+
+// String returns the string representation of the minimum date rule.
+// Example: WithMinDate(2023-01-01)
+func (rule *minDateRule) String() string {
+	return fmt.Sprintf("WithMinDate(%s)", rule.min.Format(time.DateOnly))
+}
+
+// WithMinDate returns a new child RuleSet that is constrained to dates on or after the provided date.
+// The time of day of min is ignored.
+func (v *DateRuleSet) WithMinDate(min time.Time) *DateRuleSet {
+	return v.WithRule(&minDateRule{
+		dateOf(min),
+	})
+}
+
+// Implements the Rule interface for dates that are not in the past
+type notPastDateRule struct{}
+
+// Evaluate takes a context and date value and returns an error if it is before the current date.
+// The current date is read from rulecontext.Now.
+func (rule *notPastDateRule) Evaluate(ctx context.Context, value time.Time) errors.ValidationErrorCollection {
+	if value.Before(dateOf(rulecontext.Now(ctx))) {
+		return errors.Collection(
+			errors.Errorf(errors.CodeMin, ctx, "field must not be in the past"),
+		)
+	}
+
+	return nil
+}
+
+// Conflict returns true for any not past rule.
+func (rule *notPastDateRule) Conflict(x rules.Rule[time.Time]) bool {
+	_, ok := x.(*notPastDateRule)
+	return ok
+}
+
+// String returns the string representation of the not past rule.
+func (rule *notPastDateRule) String() string {
+	return "WithNotPast()"
+}
+
+// WithNotPast returns a new child RuleSet that only allows today or later.
+//
+// The current time is read from the context when the rule is evaluated so it can be set in tests
+// using rulecontext.WithNow.
+func (v *DateRuleSet) WithNotPast() *DateRuleSet {
+	return v.WithRule(&notPastDateRule{})
+}
@@ -0,0 +1,64 @@
+package time_test
+
+import (
+	"context"
+	"testing"
+	internalTime "time"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+	"proto.zip/studio/validate/pkg/rules/time"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Dates before the minimum return CodeMin.
+// - The minimum date itself is allowed regardless of time of day.
+// - Most recent minimum is used.
+func TestDateRuleSet_WithMinDate(t *testing.T) {
+	min := internalTime.Date(2000, internalTime.January, 1, 12, 30, 0, 0, internalTime.UTC)
+
+	ruleSet := time.Date().WithMinDate(min)
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), "1999-12-31", errors.CodeMin)
+	testhelpers.MustApplyAny(t, ruleSet.Any(), "2000-01-01")
+	testhelpers.MustApplyAny(t, ruleSet.Any(), "2000-01-02")
+
+	ruleSet = ruleSet.WithMinDate(min.AddDate(-1, 0, 0))
+	testhelpers.MustApplyAny(t, ruleSet.Any(), "1999-12-31")
+
+	expected := "DateRuleSet.WithMinDate(1999-01-01)"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Dates before today return CodeMin.
+// - Today and later are allowed.
+// - The current time is read from the context.
+func TestDateRuleSet_WithNotPast(t *testing.T) {
+	now := internalTime.Date(2023, internalTime.September, 29, 23, 59, 0, 0, internalTime.UTC)
+	ctx := rulecontext.WithNow(context.Background(), func() internalTime.Time { return now })
+
+	ruleSet := time.Date().WithNotPast()
+
+	var output internalTime.Time
+
+	if err := ruleSet.Apply(ctx, "2023-09-28", &output); err == nil {
+		t.Error("Expected error to not be nil")
+	} else if code := err.First().Code(); code != errors.CodeMin {
+		t.Errorf("Expected error code to be %s, got: %s", errors.CodeMin, code)
+	}
+
+	for _, input := range []string{"2023-09-29", "2023-09-30"} {
+		if err := ruleSet.Apply(ctx, input, &output); err != nil {
+			t.Errorf("Expected error to be nil for %s, got: %s", input, err)
+		}
+	}
+
+	expected := "DateRuleSet.WithNotPast()"
+	if s := ruleSet.WithNotPast().String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
@@ -0,0 +1,133 @@
+package time_test
+
+import (
+	"context"
+	"testing"
+	internalTime "time"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules/time"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// Requirements:
+// - Default configuration doesn't return errors on valid value.
+// - Implements interface.
+func TestDateRuleSet(t *testing.T) {
+	date := internalTime.Date(2023, internalTime.September, 29, 0, 0, 0, 0, internalTime.UTC)
+
+	var output internalTime.Time
+
+	err := time.Date().Apply(context.TODO(), "2023-09-29", &output)
+	if err != nil {
+		t.Fatalf("Expected errors to be empty, got: %s", err)
+	}
+
+	if !output.Equal(date) {
+		t.Fatalf("Expected output to be %s, got: %s", date, output)
+	}
+
+	ok := testhelpers.CheckRuleSetInterface[internalTime.Time](time.Date())
+	if !ok {
+		t.Fatal("Expected rule set to be implemented")
+	}
+
+	testhelpers.MustApplyTypes[internalTime.Time](t, time.Date(), date)
+}
+
+// Requirements:
+// - Invalid calendar dates return CodePattern.
+// - Other layouts return CodePattern.
+// - Non-date types return CodeType.
+func TestDateRuleSet_Format(t *testing.T) {
+	ruleSet := time.Date().Any()
+
+	testhelpers.MustApplyMutation(t, ruleSet, "2024-02-29", internalTime.Date(2024, internalTime.February, 29, 0, 0, 0, 0, internalTime.UTC))
+
+	testhelpers.MustNotApply(t, ruleSet, "2023-02-29", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet, "2023-02-30", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet, "2023-13-01", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet, "2023-9-29", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet, "09/29/2023", errors.CodePattern)
+	testhelpers.MustNotApply(t, ruleSet, "2023-09-29T00:00:00Z", errors.CodePattern)
+
+	testhelpers.MustNotApply(t, ruleSet, 20230929, errors.CodeType)
+	testhelpers.MustNotApply(t, ruleSet, (*internalTime.Time)(nil), errors.CodeType)
+}
+
+// Requirements:
+// - The time of day is dropped from time.Time input.
+// - String output uses YYYY-MM-DD.
+func TestDateRuleSet_Output(t *testing.T) {
+	tm := internalTime.Date(2023, internalTime.September, 29, 18, 57, 42, 0, internalTime.UTC)
+
+	testhelpers.MustApplyMutation(t, time.Date().Any(), tm, internalTime.Date(2023, internalTime.September, 29, 0, 0, 0, 0, internalTime.UTC))
+
+	var output string
+	if err := time.Date().Apply(context.TODO(), &tm, &output); err != nil {
+		t.Fatalf("Expected error to be nil, got: %s", err)
+	} else if output != "2023-09-29" {
+		t.Errorf("Expected output to be %q, got: %q", "2023-09-29", output)
+	}
+
+	var outputInt int
+	err := time.Date().Apply(context.TODO(), tm, &outputInt)
+	if err == nil {
+		t.Error("Expected error to not be nil")
+	} else if code := err.First().Code(); code != errors.CodeInternal {
+		t.Errorf("Expected error code to be %s, got: %s", errors.CodeInternal, code)
+	}
+}
+
+// Requirements:
+// - Required flag can be set.
+// - Required flag can be read.
+// - Required flag defaults to false.
+func TestDateRuleSet_WithRequired(t *testing.T) {
+	ruleSet := time.Date()
+
+	if ruleSet.Required() {
+		t.Error("Expected rule set to not be required")
+	}
+
+	ruleSet = ruleSet.WithRequired()
+
+	if !ruleSet.Required() {
+		t.Error("Expected rule set to be required")
+	}
+}
+
+// Requirements:
+// - Custom rules are evaluated.
+func TestDateRuleSet_WithRuleFunc(t *testing.T) {
+	ruleSet := time.Date().WithRuleFunc(testhelpers.NewMockRuleWithErrors[internalTime.Time](1).Function()).Any()
+	testhelpers.MustNotApply(t, ruleSet, "2023-09-29", errors.CodeUnknown)
+}
+
+// Requirements:
+// - Serializes to DateRuleSet.
+func TestDateRuleSet_String(t *testing.T) {
+	ruleSet := time.Date().WithRequired().WithNotFuture()
+
+	expected := "DateRuleSet.WithRequired().WithNotFuture()"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Rules that only return warnings do not prevent the output from being set.
+// - The warnings are returned.
+func TestDate_Warning(t *testing.T) {
+	warn := func(ctx context.Context, _ internalTime.Time) errors.ValidationErrorCollection {
+		return errors.Collection(errors.WithSeverity(errors.Errorf(errors.CodeUnknown, ctx, "just a warning"), errors.SeverityWarning))
+	}
+
+	var out string
+	errs := time.Date().WithRuleFunc(warn).Apply(context.Background(), "2024-01-02", &out)
+	if errs.HasErrors() || len(errs.Warnings()) != 1 {
+		t.Errorf("Expected 1 warning and no errors, got: %s", errs)
+	} else if out != "2024-01-02" {
+		t.Errorf("Expected output to be set, got: %v", out)
+	}
+}
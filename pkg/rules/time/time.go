@@ -15,6 +15,7 @@ import (
 type TimeRuleSet struct {
 	rules.NoConflict[time.Time]
 	required     bool
+	withNil      bool
 	layouts      []string
 	outputLayout string
 	parent       *TimeRuleSet
@@ -42,12 +43,29 @@ func (ruleSet *TimeRuleSet) Required() bool {
 func (ruleSet *TimeRuleSet) WithRequired() *TimeRuleSet {
 	return &TimeRuleSet{
 		required:     true,
+		withNil:      ruleSet.withNil,
 		parent:       ruleSet,
 		outputLayout: ruleSet.outputLayout,
 		label:        "WithRequired()",
 	}
 }
 
+// WithNil returns a new child rule set that accepts a nil input, such as a JSON null or a nil *time.Time. No layouts
+// are tried and the output is left unchanged. Without WithNil a nil input returns an errors.CodeType error.
+func (ruleSet *TimeRuleSet) WithNil() *TimeRuleSet {
+	if ruleSet.withNil {
+		return ruleSet
+	}
+
+	return &TimeRuleSet{
+		required:     ruleSet.required,
+		withNil:      true,
+		parent:       ruleSet,
+		outputLayout: ruleSet.outputLayout,
+		label:        "WithNil()",
+	}
+}
+
 // WithLayouts returns the a new rule set with the specified string layouts allowed for string coercion.
 // The validation function will attempt each format in the order they are provided and stop when a match
 // is found so it is recommended to list more specific layouts first.
@@ -68,6 +86,7 @@ func (ruleSet *TimeRuleSet) WithLayouts(first string, rest ...string) *TimeRuleS
 
 	return &TimeRuleSet{
 		required:     ruleSet.required,
+		withNil:      ruleSet.withNil,
 		layouts:      layouts,
 		parent:       ruleSet,
 		outputLayout: ruleSet.outputLayout,
@@ -87,6 +106,7 @@ func (ruleSet *TimeRuleSet) WithOutputLayout(layout string) *TimeRuleSet {
 
 	return &TimeRuleSet{
 		required:     ruleSet.required,
+		withNil:      ruleSet.withNil,
 		parent:       ruleSet,
 		outputLayout: layout,
 		label:        util.StringsToRuleOutput("WithOutputLayout", []string{layout}),
@@ -96,6 +116,14 @@ func (ruleSet *TimeRuleSet) WithOutputLayout(layout string) *TimeRuleSet {
 // Apply performs a validation of a RuleSet against a value and assigns the result to the output parameter.
 // It returns a ValidationErrorCollection if any validation errors occur.
 func (ruleSet *TimeRuleSet) Apply(ctx context.Context, input any, output any) errors.ValidationErrorCollection {
+	// Nil is only allowed with WithNil
+	if util.IsNil(input) {
+		if ruleSet.withNil {
+			return nil
+		}
+		return errors.Collection(errors.NewCoercionError(ctx, "date time", "nil"))
+	}
+
 	// Ensure output is a non-nil pointer
 	outputVal := reflect.ValueOf(output)
 	if outputVal.Kind() != reflect.Ptr || outputVal.IsNil() {
@@ -178,11 +206,15 @@ func (ruleSet *TimeRuleSet) Evaluate(ctx context.Context, value time.Time) error
 
 	currentRuleSet := ruleSet
 	ctx = rulecontext.WithRuleSet(ctx, ruleSet)
+	failFast := rulecontext.FailFast(ctx)
 
 	for currentRuleSet != nil {
 		if currentRuleSet.rule != nil {
 			if errs := currentRuleSet.rule.Evaluate(ctx, value); errs != nil {
 				allErrors = append(allErrors, errs...)
+				if failFast && errs.HasErrors() {
+					break
+				}
 			}
 		}
 
@@ -224,6 +256,7 @@ func (ruleSet *TimeRuleSet) noConflict(rule rules.Rule[time.Time]) *TimeRuleSet
 		outputLayout: ruleSet.outputLayout,
 		parent:       newParent,
 		required:     ruleSet.required,
+		withNil:      ruleSet.withNil,
 		label:        ruleSet.label,
 	}
 }
@@ -238,6 +271,7 @@ func (ruleSet *TimeRuleSet) WithRule(rule rules.Rule[time.Time]) *TimeRuleSet {
 		rule:     rule,
 		parent:   ruleSet.noConflict(rule),
 		required: ruleSet.required,
+		withNil:  ruleSet.withNil,
 	}
 }
 
@@ -7,6 +7,7 @@ import (
 	internalTime "time"
 
 	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
 	"proto.zip/studio/validate/pkg/rules"
 	"proto.zip/studio/validate/pkg/rules/time"
 	"proto.zip/studio/validate/pkg/testhelpers"
@@ -230,3 +231,31 @@ func TestTime_Apply_String(t *testing.T) {
 	}
 
 }
+
+// Requirements:
+// - Rules that only return warnings do not prevent the output from being set.
+// - The warnings are returned.
+// - Warnings do not stop the other rules in fail fast mode.
+func TestTime_Warning(t *testing.T) {
+	warn := func(ctx context.Context, _ internalTime.Time) errors.ValidationErrorCollection {
+		return errors.Collection(errors.WithSeverity(errors.Errorf(errors.CodeUnknown, ctx, "just a warning"), errors.SeverityWarning))
+	}
+
+	var out internalTime.Time
+	errs := time.Time().WithRuleFunc(warn).Apply(context.Background(), internalTime.Unix(0, 0), &out)
+	if errs.HasErrors() || len(errs.Warnings()) != 1 {
+		t.Errorf("Expected 1 warning and no errors, got: %s", errs)
+	} else if out.IsZero() {
+		t.Errorf("Expected output to be set, got: %v", out)
+	}
+
+	fail := func(ctx context.Context, _ internalTime.Time) errors.ValidationErrorCollection {
+		return errors.Collection(errors.Errorf(errors.CodeForbidden, ctx, "value is not allowed"))
+	}
+
+	ctx := rulecontext.WithFailFast(context.Background())
+	errs = time.Time().WithRuleFunc(fail).WithRuleFunc(warn).Evaluate(ctx, internalTime.Unix(0, 0))
+	if len(errs.Errors()) != 1 || len(errs.Warnings()) != 1 {
+		t.Errorf("Expected 1 error and 1 warning, got: %s", errs)
+	}
+}
@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"context"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// TransformFunc is used to modify an input value before it is coerced to the type of the rule set.
+//
+// If the function returns a ValidationError it is returned as is. Any other error is returned as a type error
+// at the path of the value.
+type TransformFunc func(ctx context.Context, value any) (any, error)
+
+// applyTransform calls the transform function and converts any error to a validation error.
+func applyTransform(ctx context.Context, fn TransformFunc, value any) (any, errors.ValidationError) {
+	out, err := fn(ctx, value)
+	if err == nil {
+		return out, nil
+	}
+
+	if validationErr, ok := err.(errors.ValidationError); ok {
+		return nil, validationErr
+	}
+
+	return nil, errors.Errorf(errors.CodeType, ctx, "%s", err.Error())
+}
+
+// TransformRuleSet implements RuleSet and wraps another rule set so that the input can be modified before it is
+// coerced and validated.
+type TransformRuleSet[T any] struct {
+	NoConflict[T]
+	inner RuleSet[T]
+	fn    TransformFunc
+}
+
+// WithTransform wraps a rule set so that the transform function is called with the raw input of Apply before
+// the wrapped rule set coerces it. This is useful for input that is sent in a format the rule set does not
+// accept, such as timestamps sent as epoch milliseconds.
+//
+// Evaluate is passed directly to the wrapped rule set since the value already has the correct type.
+func WithTransform[T any](ruleSet RuleSet[T], fn TransformFunc) *TransformRuleSet[T] {
+	return &TransformRuleSet[T]{
+		inner: ruleSet,
+		fn:    fn,
+	}
+}
+
+// Required returns the required flag of the wrapped rule set.
+func (v *TransformRuleSet[T]) Required() bool {
+	return v.inner.Required()
+}
+
+// Apply calls the transform function and then applies the wrapped rule set to the result.
+func (v *TransformRuleSet[T]) Apply(ctx context.Context, input, output any) errors.ValidationErrorCollection {
+	value, err := applyTransform(ctx, v.fn, input)
+	if err != nil {
+		return errors.Collection(err)
+	}
+
+	return v.inner.Apply(ctx, value, output)
+}
+
+// Evaluate performs a validation of the wrapped rule set against a value and returns any errors.
+func (v *TransformRuleSet[T]) Evaluate(ctx context.Context, value T) errors.ValidationErrorCollection {
+	return v.inner.Evaluate(ctx, value)
+}
+
+// Any returns a new RuleSet that wraps the transform RuleSet in any Any rule set
+// which can then be used in nested validation.
+func (v *TransformRuleSet[T]) Any() RuleSet[any] {
+	return WrapAny[T](v)
+}
+
+// String returns a string representation of the rule set suitable for debugging.
+func (v *TransformRuleSet[T]) String() string {
+	return v.inner.String() + ".WithTransform(<func>)"
+}
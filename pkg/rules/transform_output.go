@@ -0,0 +1,83 @@
+package rules
+
+import (
+	"context"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// TransformOutputRuleSet implements RuleSet and wraps another rule set so that the validated value can be converted
+// to a different type for the output.
+type TransformOutputRuleSet[TIn, TOut any] struct {
+	NoConflict[TOut]
+	inner   RuleSet[TIn]
+	convert func(TIn) (TOut, error)
+}
+
+// WithTransformOutput wraps a rule set so that the value is validated by the wrapped rule set and then converted
+// by the function before it is assigned to the output. This is useful for returning a parsed or typed value, such as
+// a *url.URL after validating a URI string.
+//
+// If the convert function returns a ValidationError it is returned as is. Any other error is returned as a type
+// error at the path of the value. The function is not called if validation fails.
+//
+// Since the output type can not be converted back, Evaluate only runs the wrapped rule set if the value is also
+// of the input type. Otherwise it returns an internal error.
+func WithTransformOutput[TIn, TOut any](ruleSet RuleSet[TIn], convert func(TIn) (TOut, error)) *TransformOutputRuleSet[TIn, TOut] {
+	return &TransformOutputRuleSet[TIn, TOut]{
+		inner:   ruleSet,
+		convert: convert,
+	}
+}
+
+// Required returns the required flag of the wrapped rule set.
+func (v *TransformOutputRuleSet[TIn, TOut]) Required() bool {
+	return v.inner.Required()
+}
+
+// Apply applies the wrapped rule set, converts the result and assigns it to the output.
+func (v *TransformOutputRuleSet[TIn, TOut]) Apply(ctx context.Context, input, output any) errors.ValidationErrorCollection {
+	var in TIn
+	errs := v.inner.Apply(ctx, input, &in)
+	if errs.HasErrors() {
+		return errs
+	}
+
+	out, err := v.convert(in)
+	if err != nil {
+		if validationErr, ok := err.(errors.ValidationError); ok {
+			return errors.Collection(validationErr)
+		}
+		return errors.Collection(errors.Errorf(errors.CodeType, ctx, "%s", err.Error()))
+	}
+
+	if typedOutput, ok := output.(*TOut); ok && typedOutput != nil {
+		*typedOutput = out
+	} else if assignErrs := assignValue(ctx, out, output); assignErrs != nil {
+		return assignErrs
+	}
+
+	return errs
+}
+
+// Evaluate runs the wrapped rule set if the value is of the input type.
+func (v *TransformOutputRuleSet[TIn, TOut]) Evaluate(ctx context.Context, value TOut) errors.ValidationErrorCollection {
+	in, ok := any(value).(TIn)
+	if !ok {
+		return errors.Collection(errors.Errorf(
+			errors.CodeInternal, ctx, "Cannot evaluate %T with a transformed output", value,
+		))
+	}
+	return v.inner.Evaluate(ctx, in)
+}
+
+// Any returns a new RuleSet that wraps the transform RuleSet in any Any rule set
+// which can then be used in nested validation.
+func (v *TransformOutputRuleSet[TIn, TOut]) Any() RuleSet[any] {
+	return WrapAny[TOut](v)
+}
+
+// String returns a string representation of the rule set suitable for debugging.
+func (v *TransformOutputRuleSet[TIn, TOut]) String() string {
+	return v.inner.String() + ".WithTransformOutput(<func>)"
+}
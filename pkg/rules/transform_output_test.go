@@ -0,0 +1,90 @@
+package rules_test
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+type testLevel int
+
+const (
+	testLevelLow testLevel = iota + 1
+	testLevelHigh
+)
+
+// Requirements:
+// - The value is validated by the wrapped rule set before it is converted.
+// - The converted value is assigned to typed and interface outputs.
+// - Conversion errors are type errors unless they are already validation errors.
+// - Serializes to WithTransformOutput(<func>)
+func TestWithTransformOutput(t *testing.T) {
+	levels := map[string]testLevel{"low": testLevelLow, "high": testLevelHigh}
+
+	ruleSet := rules.WithTransformOutput(rules.String().WithAllowedValues("low", "high", "unknown"), func(value string) (testLevel, error) {
+		level, ok := levels[value]
+		if !ok {
+			return 0, fmt.Errorf("unsupported level: %s", value)
+		}
+		return level, nil
+	})
+
+	var out testLevel
+	if err := ruleSet.Apply(context.Background(), "high", &out); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	} else if out != testLevelHigh {
+		t.Errorf("Expected output to be %d, got: %d", testLevelHigh, out)
+	}
+
+	testhelpers.MustApplyMutation(t, ruleSet.Any(), "low", testLevelLow)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "medium", errors.CodeNotAllowed)
+	testhelpers.MustNotApply(t, ruleSet.Any(), "unknown", errors.CodeType)
+
+	expected := `StringRuleSet.WithAllowedValues("high", "low", "unknown").WithTransformOutput(<func>)`
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Works when nested in an object.
+// - Validation errors from the convert function are returned as is.
+// - Evaluate is only supported if the output is also the input type.
+func TestWithTransformOutputNested(t *testing.T) {
+	ruleSet := rules.WithTransformOutput[string, *url.URL](rules.String().WithMinLen(1), func(value string) (*url.URL, error) {
+		u, err := url.Parse(value)
+		if err != nil {
+			return nil, errors.Errorf(errors.CodePattern, context.Background(), "invalid URL")
+		}
+		return u, nil
+	})
+
+	object := rules.StringMap[any]().WithKey("link", ruleSet.Any())
+
+	var out map[string]any
+	if err := object.Apply(context.Background(), map[string]any{"link": "https://example.com/a"}, &out); err != nil {
+		t.Fatalf("Expected errors to be nil, got: %s", err)
+	}
+	if u, ok := out["link"].(*url.URL); !ok || u.Host != "example.com" {
+		t.Errorf("Expected link to be a parsed URL, got: %v", out["link"])
+	}
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), "://bad", errors.CodePattern)
+
+	if errs := ruleSet.Evaluate(context.Background(), &url.URL{}); errs == nil || errs.First().Code() != errors.CodeInternal {
+		t.Errorf("Expected internal error from Evaluate, got: %v", errs)
+	}
+
+	same := rules.WithTransformOutput[string, string](rules.String().WithMinLen(3), func(value string) (string, error) {
+		return strconv.Quote(value), nil
+	})
+	if errs := same.Evaluate(context.Background(), "ab"); errs == nil {
+		t.Error("Expected Evaluate to run the wrapped rule set")
+	}
+}
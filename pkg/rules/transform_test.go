@@ -0,0 +1,199 @@
+package rules_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/text/unicode/norm"
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rulecontext"
+	"proto.zip/studio/validate/pkg/rules"
+	timeRules "proto.zip/studio/validate/pkg/rules/time"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+// epochMillis converts integer milliseconds to an RFC 3339 string.
+func epochMillis(ctx context.Context, value any) (any, error) {
+	if ms, ok := value.(int); ok {
+		return time.UnixMilli(int64(ms)).UTC().Format(time.RFC3339), nil
+	}
+	return value, nil
+}
+
+// Requirements:
+// - The transform is called on the raw input before coercion.
+// - Transforms run in the order they were added.
+// - Serializes to WithTransform(<func>)
+func TestString_WithTransform(t *testing.T) {
+	trim := func(ctx context.Context, value any) (any, error) {
+		if str, ok := value.(string); ok {
+			return strings.TrimSpace(str), nil
+		}
+		return value, nil
+	}
+	upper := func(ctx context.Context, value any) (any, error) {
+		return strings.ToUpper(fmt.Sprint(value)), nil
+	}
+
+	ruleSet := rules.String().WithStrict().WithTransform(upper).WithTransform(trim).WithMaxLen(3)
+
+	testhelpers.MustApplyMutation(t, ruleSet.Any(), "  abc  ", "ABC")
+
+	// Strict rule sets accept the transformed value
+	testhelpers.MustApplyMutation(t, ruleSet.Any(), 123, "123")
+
+	expected := "StringRuleSet.WithStrict().WithTransform(<func>).WithTransform(<func>).WithMaxLen(3)"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
+
+// Requirements:
+// - Transforms are kept when a conflicting rule is added after them.
+func TestString_WithTransformConflict(t *testing.T) {
+	called := false
+	trim := func(ctx context.Context, value any) (any, error) {
+		called = true
+		return strings.TrimSpace(fmt.Sprint(value)), nil
+	}
+
+	ruleSet := rules.String().WithMinLen(3).WithTransform(trim).WithMinLen(5)
+
+	testhelpers.MustApplyMutation(t, ruleSet.Any(), "  abcde  ", "abcde")
+	if !called {
+		t.Error("Expected transform to be called")
+	}
+	testhelpers.MustNotApply(t, ruleSet.Any(), "  abcd  ", errors.CodeMin)
+}
+
+// Requirements:
+// - Transforms are kept when any other method is called after them.
+// - Transforms are kept when the same method is called before and after them.
+func TestString_WithTransformChain(t *testing.T) {
+	exp := regexp.MustCompile("^[a-z]+$")
+	noop := func(ctx context.Context, value string) errors.ValidationErrorCollection { return nil }
+
+	methods := map[string]func(*rules.StringRuleSet) *rules.StringRuleSet{
+		"WithAllowedValues": func(r *rules.StringRuleSet) *rules.StringRuleSet { return r.WithAllowedValues("abc") },
+		"WithAllowedValuesFunc": func(r *rules.StringRuleSet) *rules.StringRuleSet {
+			return r.WithAllowedValuesFunc(func(ctx context.Context) []string { return []string{"abc"} })
+		},
+		"WithAlphanumeric":     (*rules.StringRuleSet).WithAlphanumeric,
+		"WithAnyPattern":       func(r *rules.StringRuleSet) *rules.StringRuleSet { return r.WithAnyPattern(exp) },
+		"WithAnyPatternString": func(r *rules.StringRuleSet) *rules.StringRuleSet { return r.WithAnyPatternString("^[a-z]+$") },
+		"WithASCII":            (*rules.StringRuleSet).WithASCII,
+		"WithByteLength":       (*rules.StringRuleSet).WithByteLength,
+		"WithCardNetwork":      func(r *rules.StringRuleSet) *rules.StringRuleSet { return r.WithCardNetwork(rules.CardNetworkVisa) },
+		"WithEmptyAsNil":       (*rules.StringRuleSet).WithEmptyAsNil,
+		"WithErrorCode":        func(r *rules.StringRuleSet) *rules.StringRuleSet { return r.WithErrorCode(errors.CodePattern) },
+		"WithErrorMessage":     func(r *rules.StringRuleSet) *rules.StringRuleSet { return r.WithErrorMessage("invalid") },
+		"WithErrorMessages": func(r *rules.StringRuleSet) *rules.StringRuleSet {
+			return r.WithErrorMessages(map[errors.ErrorCode]string{errors.CodeMin: "too short"})
+		},
+		"WithForbiddenValues": func(r *rules.StringRuleSet) *rules.StringRuleSet { return r.WithForbiddenValues("xyz") },
+		"WithGraphemeLength":  (*rules.StringRuleSet).WithGraphemeLength,
+		"WithHexColor":        (*rules.StringRuleSet).WithHexColor,
+		"WithJSONPath":        (*rules.StringRuleSet).WithJSONPath,
+		"WithJSONPointer":     (*rules.StringRuleSet).WithJSONPointer,
+		"WithLowercase":       (*rules.StringRuleSet).WithLowercase,
+		"WithLuhn":            (*rules.StringRuleSet).WithLuhn,
+		"WithMaxLen":          func(r *rules.StringRuleSet) *rules.StringRuleSet { return r.WithMaxLen(10) },
+		"WithMinLen":          func(r *rules.StringRuleSet) *rules.StringRuleSet { return r.WithMinLen(1) },
+		"WithNFC":             (*rules.StringRuleSet).WithNFC,
+		"WithNFKC":            (*rules.StringRuleSet).WithNFKC,
+		"WithNil":             (*rules.StringRuleSet).WithNil,
+		"WithNormalize":       func(r *rules.StringRuleSet) *rules.StringRuleSet { return r.WithNormalize(norm.NFD) },
+		"WithRegexp":          func(r *rules.StringRuleSet) *rules.StringRuleSet { return r.WithRegexp(exp, "") },
+		"WithRegexpCapture":   func(r *rules.StringRuleSet) *rules.StringRuleSet { return r.WithRegexpCapture(exp, nil) },
+		"WithRegexpString":    func(r *rules.StringRuleSet) *rules.StringRuleSet { return r.WithRegexpString("^[a-z]+$", "") },
+		"WithRejectedValues":  func(r *rules.StringRuleSet) *rules.StringRuleSet { return r.WithRejectedValues("xyz") },
+		"WithRequired":        (*rules.StringRuleSet).WithRequired,
+		"WithRule":            func(r *rules.StringRuleSet) *rules.StringRuleSet { return r.WithRule(rules.RuleFunc[string](noop)) },
+		"WithRuleFunc":        func(r *rules.StringRuleSet) *rules.StringRuleSet { return r.WithRuleFunc(noop) },
+		"WithRuneLength":      (*rules.StringRuleSet).WithRuneLength,
+		"WithSensitive":       (*rules.StringRuleSet).WithSensitive,
+		"WithSeverity":        func(r *rules.StringRuleSet) *rules.StringRuleSet { return r.WithSeverity(errors.SeverityWarning) },
+		"WithSlug":            (*rules.StringRuleSet).WithSlug,
+		"WithStrict":          (*rules.StringRuleSet).WithStrict,
+		"WithTextCoercion":    (*rules.StringRuleSet).WithTextCoercion,
+		"WithTransform": func(r *rules.StringRuleSet) *rules.StringRuleSet {
+			return r.WithTransform(func(ctx context.Context, value any) (any, error) { return value, nil })
+		},
+	}
+
+	for name, method := range methods {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			transform := func(ctx context.Context, value any) (any, error) {
+				called = true
+				return value, nil
+			}
+
+			ruleSet := method(method(rules.String()).WithTransform(transform))
+
+			var out string
+			_ = ruleSet.Apply(context.Background(), "abc", &out)
+			if !called {
+				t.Errorf("Expected transform to be called for %s", ruleSet)
+			}
+		})
+	}
+}
+
+// Requirements:
+// - Errors are returned as type errors at the field path.
+// - Validation errors are returned as is.
+func TestString_WithTransformError(t *testing.T) {
+	ruleSet := rules.String().WithTransform(func(ctx context.Context, value any) (any, error) {
+		if value == "forbidden" {
+			return nil, errors.Errorf(errors.CodeForbidden, ctx, "value is forbidden")
+		}
+		return nil, fmt.Errorf("cannot transform")
+	})
+
+	testhelpers.MustNotApply(t, ruleSet.Any(), "forbidden", errors.CodeForbidden)
+
+	ctx := rulecontext.WithPathString(context.Background(), "field")
+	var out string
+	err := ruleSet.Apply(ctx, "abc", &out)
+	if err == nil {
+		t.Errorf("Expected errors to not be nil")
+	} else if code := err.First().Code(); code != errors.CodeType {
+		t.Errorf("Expected error code to be %s, got: %s", errors.CodeType, code)
+	} else if path := err.First().Path(); path != "/field" {
+		t.Errorf("Expected error path to be /field, got: %s", path)
+	}
+}
+
+// Requirements:
+// - Any rule set can be wrapped.
+// - The wrapped rule set can be used as an object key.
+// - Serializes the wrapped rule set followed by WithTransform(<func>)
+func TestWithTransform(t *testing.T) {
+	timeRuleSet := rules.WithTransform[time.Time](timeRules.Time().WithLayouts(time.RFC3339), epochMillis)
+
+	ruleSet := rules.StringMap[any]().WithKey("ts", timeRuleSet.Any())
+
+	o, err := testhelpers.MustApplyFunc(t, ruleSet.Any(), map[string]any{"ts": 1700000000000}, nil, func(a, b any) error {
+		return nil
+	})
+	if err == nil {
+		expected := time.UnixMilli(1700000000000).UTC()
+		if ts := o.(map[string]any)["ts"].(time.Time); !ts.Equal(expected) {
+			t.Errorf("Expected ts to be %s, got: %s", expected, ts)
+		}
+	}
+
+	if timeRuleSet.Required() {
+		t.Errorf("Expected required to be false")
+	}
+
+	expected := "TimeRuleSet.WithLayouts(\"2006-01-02T15:04:05Z07:00\").WithTransform(<func>)"
+	if s := timeRuleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"proto.zip/studio/validate/pkg/errors"
+)
+
+// WhenRuleSet implements RuleSet and wraps another rule set so that it is only applied when a condition is met.
+type WhenRuleSet[T any] struct {
+	NoConflict[T]
+	cond  func(ctx context.Context) bool
+	inner RuleSet[T]
+}
+
+// When wraps a rule set so that it is only applied when the condition returns true. This can be used to add
+// conditional logic to rule sets that are not objects, such as requiring a pattern only when a flag is set on
+// the context.
+//
+// When the condition returns false the input is assigned to the output without any coercion or validation.
+// This means the input must already be assignable to the output.
+func When[T any](cond func(ctx context.Context) bool, inner RuleSet[T]) *WhenRuleSet[T] {
+	return &WhenRuleSet[T]{
+		cond:  cond,
+		inner: inner,
+	}
+}
+
+// Required returns the required flag of the wrapped rule set.
+func (v *WhenRuleSet[T]) Required() bool {
+	return v.inner.Required()
+}
+
+// Apply applies the wrapped rule set if the condition is met. Otherwise the input is assigned to the output.
+func (v *WhenRuleSet[T]) Apply(ctx context.Context, input, output any) errors.ValidationErrorCollection {
+	if v.cond(ctx) {
+		return v.inner.Apply(ctx, input, output)
+	}
+
+	return assignValue(ctx, input, output)
+}
+
+// assignValue assigns the value to the output pointer without any coercion.
+// A nil value sets the output to its zero value.
+func assignValue(ctx context.Context, value, output any) errors.ValidationErrorCollection {
+	// Ensure output is a non-nil pointer
+	rv := reflect.ValueOf(output)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.Collection(
+			errors.Errorf(errors.CodeInternal, ctx, "Output must be a non-nil pointer"),
+		)
+	}
+
+	elem := rv.Elem()
+	valueOf := reflect.ValueOf(value)
+
+	// A nil interface has no type so the output is set to its zero value.
+	if !valueOf.IsValid() {
+		elem.Set(reflect.Zero(elem.Type()))
+		return nil
+	}
+
+	if valueOf.Type().AssignableTo(elem.Type()) {
+		elem.Set(valueOf)
+		return nil
+	}
+
+	return errors.Collection(
+		errors.Errorf(errors.CodeInternal, ctx, "Cannot assign %T to %T", value, output),
+	)
+}
+
+// Evaluate evaluates the wrapped rule set if the condition is met.
+func (v *WhenRuleSet[T]) Evaluate(ctx context.Context, value T) errors.ValidationErrorCollection {
+	if v.cond(ctx) {
+		return v.inner.Evaluate(ctx, value)
+	}
+	return nil
+}
+
+// Any returns a new RuleSet that wraps the conditional RuleSet in any Any rule set
+// which can then be used in nested validation.
+func (v *WhenRuleSet[T]) Any() RuleSet[any] {
+	return WrapAny[T](v)
+}
+
+// String returns a string representation of the rule set suitable for debugging.
+func (v *WhenRuleSet[T]) String() string {
+	return fmt.Sprintf("When(<func>, %s)", v.inner)
+}
@@ -0,0 +1,85 @@
+package rules_test
+
+import (
+	"context"
+	"testing"
+
+	"proto.zip/studio/validate/pkg/errors"
+	"proto.zip/studio/validate/pkg/rules"
+	"proto.zip/studio/validate/pkg/testhelpers"
+)
+
+type whenContextKey struct{}
+
+func whenFlagSet(ctx context.Context) bool {
+	flag, _ := ctx.Value(whenContextKey{}).(bool)
+	return flag
+}
+
+// Requirements:
+// - The wrapped rule set is applied when the condition is met.
+// - The input is assigned to the output when the condition is not met.
+// - Evaluate follows the same condition.
+func TestWhen(t *testing.T) {
+	ruleSet := rules.When(whenFlagSet, rules.String().WithRegexpString(`^[a-z]+$`, "lowercase only"))
+
+	testhelpers.MustApply(t, ruleSet.Any(), "ABC")
+
+	ctx := context.WithValue(context.Background(), whenContextKey{}, true)
+
+	var out string
+	if err := ruleSet.Apply(ctx, "ABC", &out); err == nil {
+		t.Errorf("Expected errors to not be nil")
+	} else if err[0].Code() != errors.CodePattern {
+		t.Errorf("Expected error code to be %s, got: %s", errors.CodePattern, err[0].Code())
+	}
+
+	if err := ruleSet.Apply(ctx, "abc", &out); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	} else if out != "abc" {
+		t.Errorf("Expected output to be abc, got: %s", out)
+	}
+
+	if err := ruleSet.Evaluate(context.Background(), "ABC"); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	}
+	if err := ruleSet.Evaluate(ctx, "ABC"); err == nil {
+		t.Errorf("Expected errors to not be nil")
+	}
+}
+
+// Requirements:
+// - Input that cannot be assigned returns an error when the condition is not met.
+// - Output must be a non-nil pointer.
+func TestWhen_Assign(t *testing.T) {
+	ruleSet := rules.When(whenFlagSet, rules.Int())
+
+	var out int
+	if err := ruleSet.Apply(context.Background(), "123", &out); err == nil {
+		t.Errorf("Expected errors to not be nil")
+	}
+
+	if err := ruleSet.Apply(context.Background(), 123, out); err == nil {
+		t.Errorf("Expected errors to not be nil")
+	}
+
+	if err := ruleSet.Apply(context.Background(), nil, &out); err != nil {
+		t.Errorf("Expected errors to be nil, got: %s", err)
+	}
+}
+
+// Requirements:
+// - Required is passed through from the wrapped rule set.
+// - Serializes to string.
+func TestWhen_String(t *testing.T) {
+	ruleSet := rules.When(whenFlagSet, rules.Int().WithRequired())
+
+	if !ruleSet.Required() {
+		t.Errorf("Expected rule set to be required")
+	}
+
+	expected := "When(<func>, IntRuleSet[int].WithRequired())"
+	if s := ruleSet.String(); s != expected {
+		t.Errorf("Expected rule set to be %s, got %s", expected, s)
+	}
+}
@@ -63,7 +63,7 @@ func (v *WrapAnyRuleSet[T]) evaluateRules(ctx context.Context, value any) errors
 	for currentRuleSet != nil {
 		if currentRuleSet.rule != nil {
 			if errs := currentRuleSet.rule.Evaluate(ctx, value); errs != nil {
-				allErrors = append(allErrors, errs...)
+				allErrors = appendErrors(allErrors, errs)
 			}
 		}
 
@@ -81,7 +81,7 @@ func (v *WrapAnyRuleSet[T]) Apply(ctx context.Context, input, output any) errors
 	allErrors := v.evaluateRules(ctx, output)
 
 	if innerErrors != nil {
-		allErrors = append(allErrors, innerErrors...)
+		allErrors = appendErrors(allErrors, innerErrors)
 	}
 
 	if len(allErrors) > 0 {
@@ -100,7 +100,7 @@ func (ruleSet *WrapAnyRuleSet[T]) Evaluate(ctx context.Context, value any) error
 		allErrors := ruleSet.evaluateRules(ctx, value)
 
 		if innerErrors != nil {
-			allErrors = append(allErrors, innerErrors...)
+			allErrors = appendErrors(allErrors, innerErrors)
 		}
 
 		if len(allErrors) != 0 {
@@ -163,3 +163,8 @@ func (ruleSet *WrapAnyRuleSet[T]) String() string {
 
 	return ruleSet.inner.String() + ".Any()"
 }
+
+// outputsSlice returns true if the wrapped rule set produces a slice or array.
+func (v *WrapAnyRuleSet[T]) outputsSlice() bool {
+	return expectsSlice(v.inner)
+}
@@ -112,6 +112,29 @@ func MustNotApply(t testing.TB, ruleSet rules.RuleSet[any], input any, errorCode
 	return err
 }
 
+// MustImplementWithNil is a test helper that expects a RuleSet to accept a nil input and a nil pointer without
+// errors and to leave the output nil. Use it to check rule sets created with WithNil or rules.Nilable.
+//
+// If any input returns an error or sets the output, a testing error is printed and the error is returned.
+func MustImplementWithNil(t testing.TB, ruleSet rules.RuleSet[any]) error {
+	t.Helper()
+
+	for _, input := range []any{nil, (*string)(nil)} {
+		var output any
+		if err := ruleSet.Apply(context.TODO(), input, &output); err != nil {
+			t.Errorf("Expected nil input %T to be accepted, got: %s", input, err)
+			return err
+		}
+		if output != nil {
+			err := fmt.Errorf("expected output to be nil for input %T, got: %v", input, output)
+			t.Error(err)
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MustApplyTypes checks to make sure apply supports the various output types expected all rule sets.
 // It is recommended all RuleSet implementations pass this assertion.
 //
@@ -331,3 +331,24 @@ func TestMustNotEvaluate(t *testing.T) {
 		t.Errorf("Expected error count to be 1, got: %d", mockT.errorCount)
 	}
 }
+
+// Requirements:
+// - Passes for rule sets that accept nil.
+// - Fails for rule sets that reject nil.
+func TestMustImplementWithNil(t *testing.T) {
+	mockT := &MockT{}
+	if err := testhelpers.MustImplementWithNil(mockT, rules.Nilable[int](rules.Int()).Any()); err != nil {
+		t.Errorf("Expected error to be nil, got: %s", err)
+	}
+	if mockT.errorCount != 0 {
+		t.Errorf("Expected error count to be 0, got: %d", mockT.errorCount)
+	}
+
+	mockT = &MockT{}
+	if err := testhelpers.MustImplementWithNil(mockT, rules.Int().Any()); err == nil {
+		t.Error("Expected error to not be nil")
+	}
+	if mockT.errorCount != 1 {
+		t.Errorf("Expected error count to be 1, got: %d", mockT.errorCount)
+	}
+}